@@ -209,6 +209,9 @@ func main() {
 			api_gateway_handler.NewSupplierHandler,
 			api_gateway_handler.NewS3Handler,
 			api_gateway_handler.NewDelivererHandler,
+			api_gateway_handler.NewShippingRateHandler,
+			api_gateway_handler.NewExchangeRateHandler,
+			api_gateway_handler.NewGuestCartHandler,
 			// service
 			api_gateway_service.NewAdminAddressTypeService,
 			api_gateway_service.NewAuthenticationService,
@@ -228,6 +231,9 @@ func main() {
 			api_gateway_service.NewSupplierService,
 			api_gateway_service.NewS3Service,
 			api_gateway_service.NewDelivererService,
+			api_gateway_service.NewShippingRateService,
+			api_gateway_service.NewExchangeRateService,
+			api_gateway_service.NewGuestCartService,
 			// repository
 			api_gateway_repository.NewAddressTypeRepository,
 			api_gateway_repository.NewUserRepository,
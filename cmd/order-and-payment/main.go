@@ -7,17 +7,23 @@ import (
 	"github.com/TienMinh25/ecommerce-platform/internal/db/postgres"
 	"github.com/TienMinh25/ecommerce-platform/internal/env"
 	"github.com/TienMinh25/ecommerce-platform/internal/httpclient"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/adaptor"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/handler"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/repository"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/saga"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/tax"
 	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/kafka"
+	"github.com/TienMinh25/ecommerce-platform/third_party/s3"
 	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
 	"log"
 	"net"
+	"time"
 )
 
 func NewDatabase(lifecycle fx.Lifecycle, manager *env.EnvManager, tracer pkg.Tracer) (pkg.Database, error) {
@@ -90,40 +96,187 @@ func NewGrpcSupplierAndProductClient(env *env.EnvManager) partner_proto_gen.Part
 	return client
 }
 
-//func NewMessageBroker(lifecycle fx.Lifecycle, config *env.EnvManager, tracer pkg.Tracer, service notification_service.INotificationService) (pkg.MessageQueue, error) {
-//	messageBroker, err := kafka.NewQueue(config, config.NotificationServerConfig.ConsumeGroup, tracer)
-//
-//	if err != nil {
-//		return nil, err
-//	}
-//
-//	// consume message
-//	lifecycle.Append(fx.Hook{
-//		OnStart: func(ctx context.Context) error {
-//			log.Println("Starting message broker for notification service...")
-//
-//			// subscribes all topic needed for notification service in here
-//			// topic verify otp
-//			messageBroker.Subscribe(&pkg.SubscriptionInfo{
-//				Topic:    config.TopicVerifyOTP,
-//				Callback: service.SendOTPByEmail,
-//			})
-//
-//			return nil
-//		},
-//		OnStop: func(ctx context.Context) error {
-//			log.Printf("Stopping message broker for notification service...")
-//
-//			if errClose := messageBroker.Close(); errClose != nil {
-//				return errClose
-//			}
-//
-//			return nil
-//		},
-//	})
-//
-//	return messageBroker, nil
-//}
+func NewMessageBroker(lifecycle fx.Lifecycle, config *env.EnvManager, tracer pkg.Tracer) (pkg.MessageQueue, error) {
+	messageBroker, err := kafka.NewQueue(config, config.OrderAndPaymentServerConfig.ConsumeGroup, tracer)
+
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Println("Starting message broker for order and payment service...")
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Printf("Stopping message broker for order and payment service...")
+
+			if errClose := messageBroker.Close(); errClose != nil {
+				return errClose
+			}
+
+			return nil
+		},
+	})
+
+	return messageBroker, nil
+}
+
+// StartPaymentExpirer expires unpaid orders of every payment gateway periodically
+func StartPaymentExpirer(lifecycle fx.Lifecycle, env *env.EnvManager, paymentService service.IPaymentService) {
+	ticker := time.NewTicker(time.Duration(env.PaymentConfig.PaymentExpireInterval) * time.Minute)
+	done := make(chan struct{})
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Println("Starting payment expirer for order and payment service...")
+
+			go func() {
+				for {
+					select {
+					case <-done:
+						return
+					case <-ticker.C:
+						if err := paymentService.ExpireUnpaidOrders(context.Background()); err != nil {
+							log.Printf("Failed to expire unpaid orders: %v", err)
+						}
+					}
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Println("Stopping payment expirer for order and payment service...")
+			ticker.Stop()
+			close(done)
+			return nil
+		},
+	})
+}
+
+// StartCouponCampaignGenerator generates codes of pending coupon campaigns periodically
+func StartCouponCampaignGenerator(lifecycle fx.Lifecycle, env *env.EnvManager, couponCampaignService service.ICouponCampaignService) {
+	ticker := time.NewTicker(time.Duration(env.OrderAndPaymentServerConfig.CouponCampaignGenerateInterval) * time.Second)
+	done := make(chan struct{})
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Println("Starting coupon campaign generator for order and payment service...")
+
+			go func() {
+				for {
+					select {
+					case <-done:
+						return
+					case <-ticker.C:
+						if err := couponCampaignService.GenerateCouponCampaignCodes(context.Background()); err != nil {
+							log.Printf("Failed to generate codes of coupon campaigns: %v", err)
+						}
+					}
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Println("Stopping coupon campaign generator for order and payment service...")
+			ticker.Stop()
+			close(done)
+			return nil
+		},
+	})
+}
+
+// StartOutboxRelay publishes events in outbox to kafka periodically, published events are deleted hourly after retention
+func StartOutboxRelay(lifecycle fx.Lifecycle, env *env.EnvManager, outboxService service.IOutboxService) {
+	relayTicker := time.NewTicker(time.Duration(env.OrderAndPaymentServerConfig.OutboxRelayInterval) * time.Second)
+	cleanupTicker := time.NewTicker(time.Hour)
+	done := make(chan struct{})
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Println("Starting outbox relay for order and payment service...")
+
+			go func() {
+				for {
+					select {
+					case <-done:
+						return
+					case <-relayTicker.C:
+						if err := outboxService.RelayOutboxEvents(context.Background()); err != nil {
+							log.Printf("Failed to relay outbox events: %v", err)
+						}
+					case <-cleanupTicker.C:
+						if err := outboxService.CleanupOutboxEvents(context.Background()); err != nil {
+							log.Printf("Failed to cleanup outbox events: %v", err)
+						}
+					}
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Println("Stopping outbox relay for order and payment service...")
+			relayTicker.Stop()
+			cleanupTicker.Stop()
+			close(done)
+			return nil
+		},
+	})
+}
+
+// StartSagaRecoverer resumes sagas which are left unfinished by crash or wait for retry periodically
+func StartSagaRecoverer(lifecycle fx.Lifecycle, env *env.EnvManager, sagaCoordinator saga.ICoordinator) {
+	ticker := time.NewTicker(time.Duration(env.OrderAndPaymentServerConfig.SagaRecoverInterval) * time.Second)
+	done := make(chan struct{})
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Println("Starting saga recoverer for order and payment service...")
+
+			go func() {
+				for {
+					select {
+					case <-done:
+						return
+					case <-ticker.C:
+						if err := sagaCoordinator.ResumeSagas(context.Background()); err != nil {
+							log.Printf("Failed to resume sagas: %v", err)
+						}
+					}
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Println("Stopping saga recoverer for order and payment service...")
+			ticker.Stop()
+			close(done)
+			return nil
+		},
+	})
+}
+
+// LoadExchangeRates saves exchange rates of file on start, so rates can be managed without admin api
+func LoadExchangeRates(lifecycle fx.Lifecycle, env *env.EnvManager, exchangeRateService service.IExchangeRateService) {
+	path := env.OrderAndPaymentServerConfig.ExchangeRateFile
+
+	if path == "" {
+		return
+	}
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Printf("Loading exchange rates from %s...", path)
+
+			return exchangeRateService.LoadExchangeRatesFromFile(ctx, path)
+		},
+	})
+}
 
 func main() {
 	app := fx.New(
@@ -132,6 +285,8 @@ func main() {
 			env.NewEnvManager,
 			// database,
 			NewDatabase,
+			// message broker
+			NewMessageBroker,
 			// router and handler
 			handler.NewOrderHandler,
 			// service
@@ -140,22 +295,48 @@ func main() {
 			service.NewPaymentService,
 			service.NewOrderService,
 			service.NewDelivererService,
+			service.NewRefundService,
+			service.NewUserPaymentMethodService,
+			service.NewCouponCampaignService,
+			service.NewShippingRateService,
+			service.NewOutboxService,
+			service.NewExchangeRateService,
+			service.NewWishlistService,
 			// repository
 			repository.NewCartRepository,
 			repository.NewCouponRepository,
 			repository.NewPaymentRepository,
 			repository.NewOrderRepository,
 			repository.NewDelivererRepository,
+			repository.NewRefundRepository,
+			repository.NewUserPaymentMethodRepository,
+			repository.NewCouponCampaignRepository,
+			repository.NewShippingRateRepository,
+			repository.NewCheckoutIdempotencyRepository,
+			repository.NewOutboxRepository,
+			repository.NewSagaRepository,
+			repository.NewExchangeRateRepository,
+			repository.NewWishlistRepository,
 			// tracer
 			NewTracerOrderAndPaymentService,
 			// infrastructure,
 			infrastructure.NewRedisCache,
+			s3.NewStorage,
 			// adapter
 			NewGrpcSupplierAndProductClient,
 			httpclient.NewHTTPClient,
+			adaptor.NewPaymentGatewayRegistry,
+			// tax
+			tax.NewTaxCalculator,
+			// saga
+			saga.NewCoordinator,
 		),
 		fx.Invoke(StartServer),
-		//fx.Invoke(func(messageBroker pkg.MessageQueue) {}),
+		fx.Invoke(StartPaymentExpirer),
+		fx.Invoke(StartCouponCampaignGenerator),
+		fx.Invoke(StartOutboxRelay),
+		fx.Invoke(StartSagaRecoverer),
+		fx.Invoke(LoadExchangeRates),
 	)
 
 	app.Run()
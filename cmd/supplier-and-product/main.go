@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc"
 	"log"
 	"net"
+	"time"
 )
 
 func NewDatabase(lifecycle fx.Lifecycle, manager *env.EnvManager, tracer pkg.Tracer) (pkg.Database, error) {
@@ -72,6 +73,39 @@ func StartServer(lifecycle fx.Lifecycle, env *env.EnvManager, partnerHandler *ha
 	})
 }
 
+// StartReservationExpirer releases inventory held by orders which were not paid in time
+func StartReservationExpirer(lifecycle fx.Lifecycle, env *env.EnvManager, inventoryService service.IInventoryService) {
+	ticker := time.NewTicker(time.Duration(env.SupplierAndProductServerConfig.ReservationExpireInterval) * time.Second)
+	done := make(chan struct{})
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			log.Println("Starting reservation expirer for supplier and product service...")
+
+			go func() {
+				for {
+					select {
+					case <-done:
+						return
+					case <-ticker.C:
+						if err := inventoryService.ReleaseExpiredReservations(context.Background()); err != nil {
+							log.Printf("Failed to release expired reservations: %v", err)
+						}
+					}
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			log.Println("Stopping reservation expirer for supplier and product service...")
+			ticker.Stop()
+			close(done)
+			return nil
+		},
+	})
+}
+
 //func NewMessageBroker(lifecycle fx.Lifecycle, config *env.EnvManager, tracer pkg.Tracer, service notification_service.INotificationService) (pkg.MessageQueue, error) {
 //	messageBroker, err := kafka.NewQueue(config, config.NotificationServerConfig.ConsumeGroup, tracer)
 //
@@ -120,10 +154,12 @@ func main() {
 			service.NewCategoryService,
 			service.NewProductService,
 			service.NewSupplierService,
+			service.NewInventoryService,
 			// repository
 			repository.NewCategoryRepository,
 			repository.NewProductRepository,
 			repository.NewSupplierProfileRepository,
+			repository.NewInventoryRepository,
 			// tracer
 			NewTracerSupplierAndProductService,
 			// kafka,
@@ -132,6 +168,7 @@ func main() {
 			httpclient.NewHTTPClient,
 		),
 		fx.Invoke(StartServer),
+		fx.Invoke(StartReservationExpirer),
 		//fx.Invoke(func(messageBroker pkg.MessageQueue) {}),
 	)
 
@@ -1008,6 +1008,253 @@ const docTemplate = `{
                 }
             }
         },
+        "/coupon-campaigns": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get coupon campaigns with generating progress and usage of codes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "coupon-campaigns"
+                ],
+                "summary": "Get coupon campaigns",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "completed"
+                        ],
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetCouponCampaignsResponseDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create campaign of single-use codes, codes are generated in background",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "coupon-campaigns"
+                ],
+                "summary": "Create coupon campaign",
+                "parameters": [
+                    {
+                        "description": "data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.CreateCouponCampaignRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.CreateCouponCampaignResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/coupon-campaigns/{campaignID}/export": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Export codes of completed coupon campaign into csv file and return download url",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "coupon-campaigns"
+                ],
+                "summary": "Export codes of coupon campaign",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "campaignID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ExportCouponCampaignCodesResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/coupon-campaigns/{campaignID}/revoke": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revoke unused codes of coupon campaign, every unused code is revoked when coupon_ids is empty",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "coupon-campaigns"
+                ],
+                "summary": "Revoke codes of coupon campaign",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "campaignID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.RevokeCouponCampaignCodesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.RevokeCouponCampaignCodesResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
         "/coupons": {
             "get": {
                 "security": [
@@ -1027,6 +1274,12 @@ const docTemplate = `{
                 ],
                 "summary": "Get coupons by admin",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "CampaignID lists codes of campaign, coupons which are not in any campaign are listed when it is empty",
+                        "name": "campaign_id",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "name": "code",
@@ -1063,6 +1316,16 @@ const docTemplate = `{
                         "name": "page",
                         "in": "query"
                     },
+                    {
+                        "enum": [
+                            "order",
+                            "product",
+                            "category"
+                        ],
+                        "type": "string",
+                        "name": "scope",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "name": "start_date",
@@ -1406,14 +1669,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/modules": {
+        "/exchange-rates": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a paginated list of modules",
+                "description": "Get exchange rates to base currency, rate is amount of base currency for one unit of currency",
                 "consumes": [
                     "application/json"
                 ],
@@ -1421,40 +1684,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "modules"
-                ],
-                "summary": "Get module list",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Page number (required if getAll is false)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size (required if getAll is false)",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Get all modules without pagination",
-                        "name": "getAll",
-                        "in": "query"
-                    }
+                    "exchange-rates"
                 ],
+                "summary": "Get exchange rates",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetListModuleResponseDocs"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                            "$ref": "#/definitions/api_gateway_dto.GetExchangeRatesResponseDocs"
                         }
                     },
                     "401": {
@@ -1471,13 +1708,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new module with a given name",
+                "description": "Create or update rates of currencies, rates of currencies not in request are kept",
                 "consumes": [
                     "application/json"
                 ],
@@ -1485,25 +1722,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "modules"
+                    "exchange-rates"
                 ],
-                "summary": "Create a new module",
+                "summary": "Update exchange rates",
                 "parameters": [
                     {
-                        "description": "Module Data",
+                        "description": "data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.CreateModuleRequest"
+                            "$ref": "#/definitions/api_gateway_dto.UpdateExchangeRatesRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.CreateModuleResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.UpdateExchangeRatesResponseDocs"
                         }
                     },
                     "400": {
@@ -1518,12 +1755,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1533,14 +1764,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/modules/{moduleID}": {
+        "/guest-carts": {
             "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get module details by module ID",
+                "description": "Get items of guest cart, token is read from header X-Guest-Cart-Token or cookie guest_cart_token",
                 "consumes": [
                     "application/json"
                 ],
@@ -1548,23 +1774,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "modules"
+                    "guest-carts"
                 ],
-                "summary": "Get module by ID",
+                "summary": "Get guest cart items",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Module ID",
-                        "name": "moduleID",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "guest cart token",
+                        "name": "X-Guest-Cart-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency is currency of prices in response, prices are converted with exchange rates, default is VND",
+                        "name": "currency",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetModuleResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.GetCartItemsResponseDocs"
                         }
                     },
                     "400": {
@@ -1573,12 +1804,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1586,14 +1811,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Delete a module using its ID",
+            }
+        },
+        "/guest-carts/items": {
+            "post": {
+                "description": "Add item to guest cart, new guest cart is started when token is missing or invalid, token is returned and set into cookie",
                 "consumes": [
                     "application/json"
                 ],
@@ -1601,23 +1823,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "modules"
+                    "guest-carts"
                 ],
-                "summary": "Delete module by ID",
+                "summary": "Add item to guest cart",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Module ID",
-                        "name": "moduleID",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "guest cart token",
+                        "name": "X-Guest-Cart-Token",
+                        "in": "header"
+                    },
+                    {
+                        "description": "info cart item",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.AddItemToCartRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.DeletePermissionByPermissionIDURIResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.AddGuestCartItemResponseDocs"
                         }
                     },
                     "400": {
@@ -1626,8 +1856,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
@@ -1640,13 +1870,8 @@ const docTemplate = `{
                     }
                 }
             },
-            "patch": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Update the module name using module ID",
+            "delete": {
+                "description": "Delete items of guest cart by product variant ids",
                 "consumes": [
                     "application/json"
                 ],
@@ -1654,24 +1879,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "modules"
+                    "guest-carts"
                 ],
-                "summary": "Update module by ID",
+                "summary": "Delete guest cart items",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Module ID",
-                        "name": "moduleID",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "guest cart token",
+                        "name": "X-Guest-Cart-Token",
+                        "in": "header"
                     },
                     {
-                        "description": "Module Data",
-                        "name": "data",
+                        "description": "product variant ids to delete",
+                        "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateModuleByModuleIDRequest"
+                            "$ref": "#/definitions/api_gateway_dto.DeleteGuestCartItemsRequest"
                         }
                     }
                 ],
@@ -1679,7 +1903,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateModuleByModuleIDResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.DeleteGuestCartItemsResponseDocs"
                         }
                     },
                     "400": {
@@ -1688,18 +1912,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1709,14 +1921,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/payments/checkout": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "create order",
+        "/guest-carts/items/{productVariantID}": {
+            "patch": {
+                "description": "Update quantity of guest cart item, quantity 0 removes item",
                 "consumes": [
                     "application/json"
                 ],
@@ -1724,14 +1931,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "guest-carts"
+                ],
+                "summary": "Update guest cart item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "guest cart token",
+                        "name": "X-Guest-Cart-Token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "name": "productVariantID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "quantity",
+                        "name": "req2",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateGuestCartItemRequest"
+                        }
+                    }
                 ],
-                "summary": "create order",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.CheckoutResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.UpdateGuestCartItemResponseDocs"
                         }
                     },
                     "400": {
@@ -1740,8 +1970,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
@@ -1755,14 +1985,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/payments/payment-methods": {
+        "/modules": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get payment methods",
+                "description": "Get a paginated list of modules",
                 "consumes": [
                     "application/json"
                 ],
@@ -1770,14 +2000,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "modules"
+                ],
+                "summary": "Get module list",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (required if getAll is false)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (required if getAll is false)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Get all modules without pagination",
+                        "name": "getAll",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get payment methods",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetPaymentMethodsResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.GetListModuleResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
                     "401": {
@@ -1793,16 +2049,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/payments/webhook/momo": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "update order status (receive event from momo)",
+                "description": "Create a new module with a given name",
                 "consumes": [
                     "application/json"
                 ],
@@ -1810,25 +2064,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "modules"
                 ],
-                "summary": "update order status (receive event from momo)",
+                "summary": "Create a new module",
                 "parameters": [
                     {
-                        "description": "data",
+                        "description": "Module Data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateOrderIPNMomoRequest"
+                            "$ref": "#/definitions/api_gateway_dto.CreateModuleRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateOrderIPNMomoResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.CreateModuleResponseDocs"
                         }
                     },
                     "400": {
@@ -1837,6 +2091,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1846,14 +2112,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/permissions": {
+        "/modules/{moduleID}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a paginated list of permissions or all permissions",
+                "description": "Get module details by module ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1861,34 +2127,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permissions"
+                    "modules"
                 ],
-                "summary": "Get a list of permissions",
+                "summary": "Get module by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Page number (required if getAll is false)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size (required if getAll is false)",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Get all permissions without pagination",
-                        "name": "getAll",
-                        "in": "query"
+                        "description": "Module ID",
+                        "name": "moduleID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetPermissionResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.GetModuleResponseDocs"
                         }
                     },
                     "400": {
@@ -1911,13 +2166,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Add a new permission with a given name",
+                "description": "Delete a module using its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1925,25 +2180,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permissions"
+                    "modules"
                 ],
-                "summary": "Create a new permission",
+                "summary": "Delete module by ID",
                 "parameters": [
                     {
-                        "description": "Permission Data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.CreateModuleRequest"
-                        }
+                        "type": "integer",
+                        "description": "Module ID",
+                        "name": "moduleID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.CreatePermissionResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.DeletePermissionByPermissionIDURIResponseDocs"
                         }
                     },
                     "400": {
@@ -1965,16 +2218,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/permissions/{permissionID}": {
-            "get": {
+            },
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a specific permission by its ID",
+                "description": "Update the module name using module ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1982,23 +2233,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permissions"
+                    "modules"
                 ],
-                "summary": "Get a permission by ID",
+                "summary": "Update module by ID",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Permission ID",
-                        "name": "permissionID",
+                        "type": "integer",
+                        "description": "Module ID",
+                        "name": "moduleID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Module Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateModuleByModuleIDRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetPermissionResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.UpdateModuleByModuleIDResponseDocs"
                         }
                     },
                     "400": {
@@ -2013,6 +2273,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2020,14 +2286,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/payments/checkout": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Remove a specific permission from the system",
+                "description": "create order, retry with same Idempotency-Key returns response of first checkout instead of\ncreating other order, same key with other payload returns 409",
                 "consumes": [
                     "application/json"
                 ],
@@ -2035,23 +2303,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permissions"
+                    "payments"
                 ],
-                "summary": "Delete a permission by ID",
+                "summary": "create order",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Permission ID",
-                        "name": "permissionID",
-                        "in": "path",
-                        "required": true
+                        "description": "idempotency key of checkout",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.DeletePermissionByPermissionIDURIResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.CheckoutResponseDocs"
                         }
                     },
                     "400": {
@@ -2066,6 +2333,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2073,14 +2346,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/payments/checkout/quote": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Modify an existing permission's action",
+                "description": "calculate price, discount, tax and shipping fee of checkout per item and per supplier,\nproblems (out of stock, invalid coupon, ...) are returned instead of error, nothing is created",
                 "consumes": [
                     "application/json"
                 ],
@@ -2088,24 +2363,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "permissions"
+                    "payments"
                 ],
-                "summary": "Update a permission by ID",
+                "summary": "quote checkout",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Permission ID",
-                        "name": "permissionID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated Data",
+                        "description": "data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdatePermissionByPermissionIDRequest"
+                            "$ref": "#/definitions/api_gateway_dto.CheckoutRequest"
                         }
                     }
                 ],
@@ -2113,7 +2381,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdatePermissionByIDResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.QuoteCheckoutResponseDocs"
                         }
                     },
                     "400": {
@@ -2137,14 +2405,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/products": {
+        "/payments/payment-methods": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get products",
+                "description": "Get payment methods",
                 "consumes": [
                     "application/json"
                 ],
@@ -2152,54 +2420,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "products"
-                ],
-                "summary": "Get products",
-                "parameters": [
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        },
-                        "collectionFormat": "csv",
-                        "name": "category_ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "keyword",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "min_rating",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    }
+                    "payments"
                 ],
+                "summary": "Get payment methods",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetProductsResponseDocs"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                            "$ref": "#/definitions/api_gateway_dto.GetPaymentMethodsResponseDocs"
                         }
                     },
                     "401": {
@@ -2217,14 +2445,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/products/{productID}": {
+        "/payments/refunds": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get product detail by id",
+                "description": "get refunds of payment",
                 "consumes": [
                     "application/json"
                 ],
@@ -2232,15 +2460,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "payments"
                 ],
-                "summary": "Get product detail by id",
+                "summary": "get refunds of payment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "ProductID",
-                        "name": "productID",
-                        "in": "path",
+                        "name": "payment_history_id",
+                        "in": "query",
                         "required": true
                     }
                 ],
@@ -2248,7 +2475,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetProductDetailResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.GetRefundsResponseDocs"
                         }
                     },
                     "400": {
@@ -2263,6 +2490,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2270,16 +2503,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/products/{productID}/reviews": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get product reviews by product id",
+                "description": "create refund (full or partial) for payment and send it to payment gateway",
                 "consumes": [
                     "application/json"
                 ],
@@ -2287,35 +2518,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "products"
+                    "payments"
                 ],
-                "summary": "Get product reviews by product id",
+                "summary": "create refund for payment",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ProductID",
-                        "name": "productID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query"
+                        "description": "data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.CreateRefundRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetProductReviewsResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.RefundResponseDocs"
                         }
                     },
                     "400": {
@@ -2330,6 +2551,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2339,14 +2572,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/roles": {
-            "get": {
+        "/payments/refunds/{refundID}/process": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get list roles pagination with some filter and sorting",
+                "description": "send pending or failed refund to payment gateway again",
                 "consumes": [
                     "application/json"
                 ],
@@ -2354,64 +2587,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "roles"
+                    "payments"
                 ],
-                "summary": "Get list roles pagination with some filter and sorting",
+                "summary": "process refund",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Limit number of records returned",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "name"
-                        ],
-                        "type": "string",
-                        "description": "Provide for search by name",
-                        "name": "searchBy",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Value for search",
-                        "name": "searchValue",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "name"
-                        ],
-                        "type": "string",
-                        "description": "Sort by some attributes",
-                        "name": "sortBy",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
                         "type": "string",
-                        "description": "Sort order asc or desc",
-                        "name": "sortOrder",
-                        "in": "query"
+                        "name": "refundID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetRoleResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.RefundResponseDocs"
                         }
                     },
                     "400": {
@@ -2426,6 +2617,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2433,14 +2636,1937 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "security": [
-                    {
+            }
+        },
+        "/payments/webhook/{methodCode}": {
+            "get": {
+                "description": "update order status, body (or query string when body is empty, ex: vnpay) is forwarded as it is\nto payment gateway of method code (momo, vnpay)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "update order status (receive callback from payment gateway)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "method code",
+                        "name": "methodCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "data",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "acknowledgement of payment gateway, ex vnpay: RspCode and Message",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "204": {
+                        "description": "acknowledgement of payment gateway which only reads status code, ex: momo"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "update order status, body (or query string when body is empty, ex: vnpay) is forwarded as it is\nto payment gateway of method code (momo, vnpay)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "update order status (receive callback from payment gateway)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "method code",
+                        "name": "methodCode",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "data",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "acknowledgement of payment gateway, ex vnpay: RspCode and Message",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "204": {
+                        "description": "acknowledgement of payment gateway which only reads status code, ex: momo"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/permissions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a paginated list of permissions or all permissions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permissions"
+                ],
+                "summary": "Get a list of permissions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number (required if getAll is false)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (required if getAll is false)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Get all permissions without pagination",
+                        "name": "getAll",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetPermissionResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a new permission with a given name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permissions"
+                ],
+                "summary": "Create a new permission",
+                "parameters": [
+                    {
+                        "description": "Permission Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.CreateModuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.CreatePermissionResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/permissions/{permissionID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a specific permission by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permissions"
+                ],
+                "summary": "Get a permission by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Permission ID",
+                        "name": "permissionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetPermissionResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a specific permission from the system",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permissions"
+                ],
+                "summary": "Delete a permission by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Permission ID",
+                        "name": "permissionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.DeletePermissionByPermissionIDURIResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Modify an existing permission's action",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "permissions"
+                ],
+                "summary": "Update a permission by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Permission ID",
+                        "name": "permissionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated Data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdatePermissionByPermissionIDRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdatePermissionByIDResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/products": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get products",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get products",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "category_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency is currency of prices in response, prices are converted with exchange rates, default is VND",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "min_rating",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetProductsResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{productID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get product detail by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get product detail by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ProductID",
+                        "name": "productID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency is currency of prices in response, prices are converted with exchange rates, default is VND",
+                        "name": "currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetProductDetailResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/products/{productID}/reviews": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get product reviews by product id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "products"
+                ],
+                "summary": "Get product reviews by product id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ProductID",
+                        "name": "productID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetProductReviewsResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list roles pagination with some filter and sorting",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Get list roles pagination with some filter and sorting",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Limit number of records returned",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "name"
+                        ],
+                        "type": "string",
+                        "description": "Provide for search by name",
+                        "name": "searchBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Value for search",
+                        "name": "searchValue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "name"
+                        ],
+                        "type": "string",
+                        "description": "Sort by some attributes",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order asc or desc",
+                        "name": "sortOrder",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetRoleResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create new role based on modules and permissions",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Create new role based on modules and permissions",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.CreateRoleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.CreateRoleResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/roles/{roleID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete role by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Delete role by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Role id for delete",
+                        "name": "roleID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.DeleteRolesResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update permissions on modules for role",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "roles"
+                ],
+                "summary": "Update permissions on modules for role",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateRoleRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Role id to update",
+                        "name": "roleID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateRolesResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/s3/presigned_url": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "lấy presigned url để upload ảnh",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "s3"
+                ],
+                "summary": "lấy presigned url để upload ảnh",
+                "parameters": [
+                    {
+                        "description": "Thông tin file",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetPresignedURLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetPresignedURLResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/shipping-rates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get shipping rates of shipping classes for each zone",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipping-rates"
+                ],
+                "summary": "Get shipping rates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "shipping_class",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetShippingRatesResponseDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create rate of shipping class for zone, fee = base_fee + fee_per_extra_kg * extra kg over base_weight_grams",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipping-rates"
+                ],
+                "summary": "Create shipping rate",
+                "parameters": [
+                    {
+                        "description": "data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.CreateShippingRateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.CreateShippingRateResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/shipping-rates/{shippingRateID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete shipping rate, shipping class without rate of zone uses rate of default class",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipping-rates"
+                ],
+                "summary": "Delete shipping rate",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "shippingRateID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.DeleteShippingRateResponseDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update fees of shipping rate",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shipping-rates"
+                ],
+                "summary": "Update shipping rate",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "shippingRateID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateShippingRateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateShippingRateResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/suppliers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "get suppliers by admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suppliers"
+                ],
+                "summary": "get suppliers by admin",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "company_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "contact_phone",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "active",
+                            "suspended"
+                        ],
+                        "type": "string",
+                        "x-enum-varnames": [
+                            "SupplierProfileStatusPending",
+                            "SupplierProfileStatusActive",
+                            "SupplierProfileStatusSuspended"
+                        ],
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "tax_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetSuppliersResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/suppliers/me": {
+            "get": {
+                "description": "get supplier orders",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suppliers"
+                ],
+                "summary": "get supplier orders",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending_payment",
+                            "pending",
+                            "confirmed",
+                            "processing",
+                            "ready_to_ship",
+                            "in_transit",
+                            "out_for_delivery",
+                            "delivered",
+                            "cancelled",
+                            "payment_failed",
+                            "refunded"
+                        ],
+                        "type": "string",
+                        "x-enum-comments": {
+                            "Confirmed": "Supplier đã xác nhận",
+                            "Delivered": "Đã giao thành công",
+                            "InTransit": "Đang vận chuyển (đang ship)",
+                            "OutForDelivery": "Sắp giao (shipper đang trên đường)",
+                            "Pending": "Chờ supplier xác nhận",
+                            "PendingPayment": "Chờ thanh toán",
+                            "Processing": "Đang chuẩn bị hàng",
+                            "ReadyToShip": "Sẵn sàng giao hàng"
+                        },
+                        "x-enum-varnames": [
+                            "PendingPayment",
+                            "Pending",
+                            "Confirmed",
+                            "Processing",
+                            "ReadyToShip",
+                            "InTransit",
+                            "OutForDelivery",
+                            "Delivered",
+                            "Cancelled",
+                            "PaymentFailed",
+                            "Refunded"
+                        ],
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetSupplierOrdersResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/suppliers/orders/{orderItemID}": {
+            "post": {
+                "description": "update order item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suppliers"
+                ],
+                "summary": "update order item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "orderItemID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Thông tin cần cập nhật",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateOrderItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateOrderItemResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/suppliers/orders/{orderItemID}/timeline": {
+            "get": {
+                "description": "get status events of supplier order item, merged with pickup and delivery time of deliverer",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suppliers"
+                ],
+                "summary": "get timeline of order item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "orderItemID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetOrderItemTimelineResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/suppliers/register": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "customer register supplier",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suppliers"
+                ],
+                "summary": "customer register supplier",
+                "parameters": [
+                    {
+                        "description": "data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.RegisterSupplierRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.RegisterSupplierResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/suppliers/uprole": {
+            "post": {
+                "description": "up role supplier for user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suppliers"
+                ],
+                "summary": "up role supplier for user",
+                "parameters": [
+                    {
+                        "description": "Thông tin cần cập nhật",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateRoleForUserRegisterSupplierRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateRoleForUserRegisterSupplierResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/suppliers/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "get supplier detail",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suppliers"
+                ],
+                "summary": "get supplier detail",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetSupplierByIDResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Quản trị viên cho phép supplier hoạt động hoặc không",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suppliers"
+                ],
+                "summary": "Quản trị viên cho phép supplier hoạt động hoặc không",
+                "parameters": [
+                    {
+                        "description": "Thông tin cần cập nhật",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateSupplierRequest"
+                        }
+                    },
+                    {
+                        "type": "integer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateSupplierResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/suppliers/{id}/documents/{documentID}": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "approve or reject supplier document",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suppliers"
+                ],
+                "summary": "approve or reject supplier document",
+                "parameters": [
+                    {
+                        "description": "Thông tin cần cập nhật",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateSupplierDocumentVerificationStatusRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "name": "documentID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateSupplierDocumentVerificationStatusResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get list users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get list users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Limit number of records returned",
+                        "name": "limit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "email",
+                            "phone",
+                            "fullname"
+                        ],
+                        "type": "string",
+                        "description": "Provide for search by email, phone or fullname",
+                        "name": "searchBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Value for search",
+                        "name": "searchValue",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "fullname",
+                            "email",
+                            "birthdate",
+                            "updated_at",
+                            "phone"
+                        ],
+                        "type": "string",
+                        "description": "Sort by some attributes",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "Sort order asc or desc",
+                        "name": "sortOrder",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            true,
+                            false
+                        ],
+                        "type": "boolean",
+                        "description": "Filter by email verify",
+                        "name": "emailVerify",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            true,
+                            false
+                        ],
+                        "type": "boolean",
+                        "description": "Filter by phone verify",
+                        "name": "phoneVerify",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "active",
+                            "inactive"
+                        ],
+                        "type": "string",
+                        "description": "Filter by status of user",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start time for last update",
+                        "name": "updatedAtStartFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End time for last update",
+                        "name": "updatedAtEndFrom",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            1,
+                            2,
+                            3,
+                            4
+                        ],
+                        "type": "integer",
+                        "description": "Filter by role id",
+                        "name": "roleID",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.GetUserByAdminResponseDocs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create new role based on modules and permissions",
+                "description": "create new user by admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -2448,9 +4574,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "roles"
+                    "users"
                 ],
-                "summary": "Create new role based on modules and permissions",
+                "summary": "create new user by admin",
                 "parameters": [
                     {
                         "description": "Request body",
@@ -2458,15 +4584,15 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.CreateRoleRequest"
+                            "$ref": "#/definitions/api_gateway_dto.CreateUserByAdminRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.CreateRoleResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.CreateUserByAdminResponseDocs"
                         }
                     },
                     "400": {
@@ -2490,14 +4616,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/roles/{roleID}": {
-            "delete": {
+        "/users/me": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete role by id",
+                "description": "Lấy thông tin người dùng hiện tại dựa vào access token",
                 "consumes": [
                     "application/json"
                 ],
@@ -2505,23 +4631,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "roles"
-                ],
-                "summary": "Delete role by id",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Role id for delete",
-                        "name": "roleID",
-                        "in": "path",
-                        "required": true
-                    }
+                    "me"
                 ],
+                "summary": "Lấy thông tin người dùng hiện tại",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.DeleteRolesResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.GetCurrentUserResponseDocs"
                         }
                     },
                     "400": {
@@ -2550,7 +4667,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update permissions on modules for role",
+                "description": "Cập nhật thông tin cá nhân của người dùng hiện tại",
                 "consumes": [
                     "application/json"
                 ],
@@ -2558,32 +4675,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "roles"
+                    "me"
                 ],
-                "summary": "Update permissions on modules for role",
+                "summary": "Cập nhật thông tin cá nhân",
                 "parameters": [
                     {
-                        "description": "Request body",
+                        "description": "Thông tin cần cập nhật",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateRoleRequest"
+                            "$ref": "#/definitions/api_gateway_dto.UpdateCurrentUserRequest"
                         }
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Role id to update",
-                        "name": "roleID",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateRolesResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.UpdateCurrentUserResponseDocs"
                         }
                     },
                     "400": {
@@ -2607,14 +4717,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/s3/presigned_url": {
-            "post": {
+        "/users/me/addresses": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "lấy presigned url để upload ảnh",
+                "description": "Lấy danh sách địa chỉ của người dùng",
                 "consumes": [
                     "application/json"
                 ],
@@ -2622,25 +4732,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "s3"
+                    "me"
                 ],
-                "summary": "lấy presigned url để upload ảnh",
+                "summary": "Lấy danh sách địa chỉ của người dùng",
                 "parameters": [
                     {
-                        "description": "Thông tin file",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetPresignedURLRequest"
-                        }
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetPresignedURLResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.GetListCurrentAddressResponseDocs"
                         }
                     },
                     "400": {
@@ -2662,16 +4775,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/suppliers": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "get suppliers by admin",
+                "description": "Tạo thêm 1 địa chỉ",
                 "consumes": [
                     "application/json"
                 ],
@@ -2679,58 +4790,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "suppliers"
+                    "me"
                 ],
-                "summary": "get suppliers by admin",
+                "summary": "Tạo thêm 1 địa chỉ",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "company_name",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "contact_phone",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "pending",
-                            "active",
-                            "suspended"
-                        ],
-                        "type": "string",
-                        "x-enum-varnames": [
-                            "SupplierProfileStatusPending",
-                            "SupplierProfileStatusActive",
-                            "SupplierProfileStatusSuspended"
-                        ],
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "tax_id",
-                        "in": "query"
+                        "description": "Body gui len",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.CreateAddressRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetSuppliersResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.CreateAddressResponseDocs"
                         }
                     },
                     "400": {
@@ -2754,9 +4832,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/suppliers/me": {
-            "get": {
-                "description": "get supplier orders",
+        "/users/me/addresses/{addressID}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Xoá địa chỉ",
                 "consumes": [
                     "application/json"
                 ],
@@ -2764,69 +4847,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "suppliers"
+                    "me"
                 ],
-                "summary": "get supplier orders",
+                "summary": "Xoá địa chỉ",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 1,
                         "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "pending_payment",
-                            "pending",
-                            "confirmed",
-                            "processing",
-                            "ready_to_ship",
-                            "in_transit",
-                            "out_for_delivery",
-                            "delivered",
-                            "cancelled",
-                            "payment_failed",
-                            "refunded"
-                        ],
-                        "type": "string",
-                        "x-enum-comments": {
-                            "Confirmed": "Supplier đã xác nhận",
-                            "Delivered": "Đã giao thành công",
-                            "InTransit": "Đang vận chuyển (đang ship)",
-                            "OutForDelivery": "Sắp giao (shipper đang trên đường)",
-                            "Pending": "Chờ supplier xác nhận",
-                            "PendingPayment": "Chờ thanh toán",
-                            "Processing": "Đang chuẩn bị hàng",
-                            "ReadyToShip": "Sẵn sàng giao hàng"
-                        },
-                        "x-enum-varnames": [
-                            "PendingPayment",
-                            "Pending",
-                            "Confirmed",
-                            "Processing",
-                            "ReadyToShip",
-                            "InTransit",
-                            "OutForDelivery",
-                            "Delivered",
-                            "Cancelled",
-                            "PaymentFailed",
-                            "Refunded"
-                        ],
-                        "name": "status",
-                        "in": "query"
+                        "description": "address id on path",
+                        "name": "addressID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetSupplierOrdersResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.DeleteAddressResponseDocs"
                         }
                     },
                     "400": {
@@ -2848,11 +4885,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/suppliers/orders/{orderItemID}": {
-            "post": {
-                "description": "update order item",
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cập nhật địa chỉ bằng id của địa chỉ",
                 "consumes": [
                     "application/json"
                 ],
@@ -2860,23 +4900,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "suppliers"
+                    "me"
                 ],
-                "summary": "update order item",
+                "summary": "Cập nhật địa chỉ bằng id của địa chỉ",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "orderItemID",
+                        "type": "integer",
+                        "description": "address id on path",
+                        "name": "addressID",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Thông tin cần cập nhật",
-                        "name": "request",
+                        "description": "body of data",
+                        "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateOrderItemRequest"
+                            "$ref": "#/definitions/api_gateway_dto.UpdateAddressRequest"
                         }
                     }
                 ],
@@ -2884,7 +4925,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateOrderItemResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.UpdateAddressResponseDocs"
                         }
                     },
                     "400": {
@@ -2908,14 +4949,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/suppliers/register": {
-            "post": {
+        "/users/me/addresses/{addressID}/default": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "customer register supplier",
+                "description": "Cập nhật địa chỉ mặc định",
                 "consumes": [
                     "application/json"
                 ],
@@ -2923,25 +4964,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "suppliers"
+                    "me"
                 ],
-                "summary": "customer register supplier",
+                "summary": "Cập nhật địa chỉ mặc định",
                 "parameters": [
                     {
-                        "description": "data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.RegisterSupplierRequest"
-                        }
+                        "type": "integer",
+                        "description": "address id on path",
+                        "name": "addressID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.RegisterSupplierResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.SetDefaultAddressResponseDocs"
                         }
                     },
                     "400": {
@@ -2965,9 +5004,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/suppliers/uprole": {
+        "/users/me/avatars/get-presigned-url": {
             "post": {
-                "description": "up role supplier for user",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "lấy presigned url để upload ảnh",
                 "consumes": [
                     "application/json"
                 ],
@@ -2975,17 +5019,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "suppliers"
+                    "me"
                 ],
-                "summary": "up role supplier for user",
+                "summary": "lấy presigned url để upload ảnh",
                 "parameters": [
                     {
-                        "description": "Thông tin cần cập nhật",
+                        "description": "Thông tin file",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateRoleForUserRegisterSupplierRequest"
+                            "$ref": "#/definitions/api_gateway_dto.GetAvatarPresignedURLRequest"
                         }
                     }
                 ],
@@ -2993,7 +5037,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateRoleForUserRegisterSupplierResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.GetAvatarPresignedURLResponseDocs"
                         }
                     },
                     "400": {
@@ -3017,14 +5061,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/suppliers/{id}": {
+        "/users/me/carts": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "get supplier detail",
+                "description": "update cart item",
                 "consumes": [
                     "application/json"
                 ],
@@ -3032,22 +5076,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "suppliers"
+                    "me"
                 ],
-                "summary": "get supplier detail",
+                "summary": "update cart item",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "Currency is currency of prices in response, prices are converted with exchange rates, default is VND",
+                        "name": "currency",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetSupplierByIDResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.GetCartItemsResponseDocs"
                         }
                     },
                     "400": {
@@ -3070,13 +5114,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "patch": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Quản trị viên cho phép supplier hoạt động hoặc không",
+                "description": "update cart item",
                 "consumes": [
                     "application/json"
                 ],
@@ -3084,31 +5128,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "suppliers"
+                    "me"
                 ],
-                "summary": "Quản trị viên cho phép supplier hoạt động hoặc không",
+                "summary": "update cart item",
                 "parameters": [
                     {
-                        "description": "Thông tin cần cập nhật",
-                        "name": "request",
+                        "description": "info cart item",
+                        "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateSupplierRequest"
+                            "$ref": "#/definitions/api_gateway_dto.AddItemToCartRequest"
                         }
-                    },
-                    {
-                        "type": "integer",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateSupplierResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.AddItemToCartResponseDocs"
                         }
                     },
                     "400": {
@@ -3130,16 +5168,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/suppliers/{id}/documents/{documentID}": {
-            "patch": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "approve or reject supplier document",
+                "description": "update cart item",
                 "consumes": [
                     "application/json"
                 ],
@@ -3147,37 +5183,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "suppliers"
+                    "me"
                 ],
-                "summary": "approve or reject supplier document",
+                "summary": "update cart item",
                 "parameters": [
                     {
-                        "description": "Thông tin cần cập nhật",
-                        "name": "request",
+                        "description": "info cart item ids to delete",
+                        "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateSupplierDocumentVerificationStatusRequest"
+                            "$ref": "#/definitions/api_gateway_dto.DeleteCartItemRequest"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "name": "documentID",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateSupplierDocumentVerificationStatusResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.DeleteCartItemResponseDocs"
                         }
                     },
                     "400": {
@@ -3201,14 +5225,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
-            "get": {
+        "/users/me/carts/acknowledge-changes": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get list users",
+                "description": "current prices become last prices seen, so price_changed warnings of cart items are cleared",
                 "consumes": [
                     "application/json"
                 ],
@@ -3216,124 +5240,94 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "me"
                 ],
-                "summary": "Get list users",
+                "summary": "acknowledge changes of cart items",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Limit number of records returned",
-                        "name": "limit",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "page",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "enum": [
-                            "email",
-                            "phone",
-                            "fullname"
-                        ],
-                        "type": "string",
-                        "description": "Provide for search by email, phone or fullname",
-                        "name": "searchBy",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Value for search",
-                        "name": "searchValue",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "fullname",
-                            "email",
-                            "birthdate",
-                            "updated_at",
-                            "phone"
-                        ],
-                        "type": "string",
-                        "description": "Sort by some attributes",
-                        "name": "sortBy",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
-                        "type": "string",
-                        "description": "Sort order asc or desc",
-                        "name": "sortOrder",
-                        "in": "query"
+                        "description": "cart item ids to acknowledge",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.AcknowledgeCartItemChangesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.AcknowledgeCartItemChangesResponseDocs"
+                        }
                     },
-                    {
-                        "enum": [
-                            true,
-                            false
-                        ],
-                        "type": "boolean",
-                        "description": "Filter by email verify",
-                        "name": "emailVerify",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
                     },
-                    {
-                        "enum": [
-                            true,
-                            false
-                        ],
-                        "type": "boolean",
-                        "description": "Filter by phone verify",
-                        "name": "phoneVerify",
-                        "in": "query"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
                     },
-                    {
-                        "enum": [
-                            "active",
-                            "inactive"
-                        ],
-                        "type": "string",
-                        "description": "Filter by status of user",
-                        "name": "status",
-                        "in": "query"
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/carts/{cartItemID}": {
+            "patch": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "Start time for last update",
-                        "name": "updatedAtStartFrom",
-                        "in": "query"
-                    },
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "update cart item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "update cart item",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "End time for last update",
-                        "name": "updatedAtEndFrom",
-                        "in": "query"
+                        "name": "cartItemID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "enum": [
-                            1,
-                            2,
-                            3,
-                            4
-                        ],
-                        "type": "integer",
-                        "description": "Filter by role id",
-                        "name": "roleID",
-                        "in": "query"
+                        "description": "info of prod",
+                        "name": "req2",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.UpdateCartItemRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetUserByAdminResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.UpdateCartItemResponseDocs"
                         }
                     },
                     "400": {
@@ -3355,14 +5349,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/users/me/carts/{cartItemID}/move-to-wishlist": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "create new user by admin",
+                "description": "save cart item into wishlist with current price and remove it from cart",
                 "consumes": [
                     "application/json"
                 ],
@@ -3370,25 +5366,22 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "me"
                 ],
-                "summary": "create new user by admin",
+                "summary": "move cart item to wishlist",
                 "parameters": [
                     {
-                        "description": "Request body",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.CreateUserByAdminRequest"
-                        }
+                        "type": "string",
+                        "name": "cartItemID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.CreateUserByAdminResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.MoveCartItemToWishlistResponseDocs"
                         }
                     },
                     "400": {
@@ -3403,6 +5396,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3412,14 +5411,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me": {
+        "/users/me/notification-settings": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Lấy thông tin người dùng hiện tại dựa vào access token",
+                "description": "Lấy cài đặt thông báo",
                 "consumes": [
                     "application/json"
                 ],
@@ -3429,12 +5428,12 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "Lấy thông tin người dùng hiện tại",
+                "summary": "Lấy cài đặt thông báo",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetCurrentUserResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.GetNotificationSettingsResponseDocs"
                         }
                     },
                     "400": {
@@ -3457,13 +5456,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "patch": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cập nhật thông tin cá nhân của người dùng hiện tại",
+                "description": "Cập nhật cài đặt thông báo",
                 "consumes": [
                     "application/json"
                 ],
@@ -3473,15 +5472,15 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "Cập nhật thông tin cá nhân",
+                "summary": "Cập nhật cài đặt thông báo",
                 "parameters": [
                     {
-                        "description": "Thông tin cần cập nhật",
+                        "description": "Dữ liệu cập nhật",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateCurrentUserRequest"
+                            "$ref": "#/definitions/api_gateway_dto.UpdateNotificationSettingsRequest"
                         }
                     }
                 ],
@@ -3489,7 +5488,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateCurrentUserResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.UpdateNotificationSettingsResponseDocs"
                         }
                     },
                     "400": {
@@ -3513,14 +5512,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/addresses": {
+        "/users/me/notifications": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Lấy danh sách địa chỉ của người dùng",
+                "description": "Lấy danh sách lịch sử thông báo",
                 "consumes": [
                     "application/json"
                 ],
@@ -3530,7 +5529,7 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "Lấy danh sách địa chỉ của người dùng",
+                "summary": "Lấy danh sách lịch sử thông báo",
                 "parameters": [
                     {
                         "minimum": 1,
@@ -3549,7 +5548,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetListCurrentAddressResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.GetListNotificationsHistoryResponse"
                         }
                     },
                     "400": {
@@ -3571,14 +5570,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/users/me/notifications/mark-all-read": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Tạo thêm 1 địa chỉ",
+                "description": "Đánh dấu tất cả thông báo đã được đọc",
                 "consumes": [
                     "application/json"
                 ],
@@ -3588,23 +5589,12 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "Tạo thêm 1 địa chỉ",
-                "parameters": [
-                    {
-                        "description": "Body gui len",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.CreateAddressRequest"
-                        }
-                    }
-                ],
+                "summary": "Đánh dấu tất cả thông báo đã được đọc",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.CreateAddressResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.MarkNotificationResponseDocs"
                         }
                     },
                     "400": {
@@ -3628,14 +5618,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/addresses/{addressID}": {
-            "delete": {
+        "/users/me/notifications/{notificationID}/mark-read": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Xoá địa chỉ",
+                "description": "Đánh dấu một thông báo đã được đọc",
                 "consumes": [
                     "application/json"
                 ],
@@ -3645,12 +5635,12 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "Xoá địa chỉ",
+                "summary": "Đánh dấu một thông báo đã được đọc",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "address id on path",
-                        "name": "addressID",
+                        "type": "string",
+                        "description": "notification id",
+                        "name": "notificationID",
                         "in": "path",
                         "required": true
                     }
@@ -3659,7 +5649,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.DeleteAddressResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.MarkNotificationResponseDocs"
                         }
                     },
                     "400": {
@@ -3681,14 +5671,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/users/me/orders": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cập nhật địa chỉ bằng id của địa chỉ",
+                "description": "update cart item",
                 "consumes": [
                     "application/json"
                 ],
@@ -3698,30 +5690,73 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "Cập nhật địa chỉ bằng id của địa chỉ",
+                "summary": "update cart item",
                 "parameters": [
                     {
+                        "minLength": 1,
+                        "type": "string",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "address id on path",
-                        "name": "addressID",
-                        "in": "path",
-                        "required": true
+                        "name": "limit",
+                        "in": "query"
                     },
                     {
-                        "description": "body of data",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateAddressRequest"
-                        }
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending_payment",
+                            "pending",
+                            "confirmed",
+                            "processing",
+                            "ready_to_ship",
+                            "in_transit",
+                            "out_for_delivery",
+                            "delivered",
+                            "cancelled",
+                            "payment_failed",
+                            "refunded"
+                        ],
+                        "type": "string",
+                        "x-enum-comments": {
+                            "Confirmed": "Supplier đã xác nhận",
+                            "Delivered": "Đã giao thành công",
+                            "InTransit": "Đang vận chuyển (đang ship)",
+                            "OutForDelivery": "Sắp giao (shipper đang trên đường)",
+                            "Pending": "Chờ supplier xác nhận",
+                            "PendingPayment": "Chờ thanh toán",
+                            "Processing": "Đang chuẩn bị hàng",
+                            "ReadyToShip": "Sẵn sàng giao hàng"
+                        },
+                        "x-enum-varnames": [
+                            "PendingPayment",
+                            "Pending",
+                            "Confirmed",
+                            "Processing",
+                            "ReadyToShip",
+                            "InTransit",
+                            "OutForDelivery",
+                            "Delivered",
+                            "Cancelled",
+                            "PaymentFailed",
+                            "Refunded"
+                        ],
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateAddressResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.UpdateCartItemResponseDocs"
                         }
                     },
                     "400": {
@@ -3745,14 +5780,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/addresses/{addressID}/default": {
-            "patch": {
+        "/users/me/orders/{orderID}/reorder": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cập nhật địa chỉ mặc định",
+                "description": "add items of own order to cart, items which are not available are reported instead of added",
                 "consumes": [
                     "application/json"
                 ],
@@ -3762,12 +5797,12 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "Cập nhật địa chỉ mặc định",
+                "summary": "buy again",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "address id on path",
-                        "name": "addressID",
+                        "type": "string",
+                        "description": "order id",
+                        "name": "orderID",
                         "in": "path",
                         "required": true
                     }
@@ -3776,7 +5811,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.SetDefaultAddressResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.ReorderToCartResponseDocs"
                         }
                     },
                     "400": {
@@ -3791,6 +5826,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3800,14 +5841,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/avatars/get-presigned-url": {
+        "/users/me/orders/{orderItemID}/cancel": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "lấy presigned url để upload ảnh",
+                "description": "customer cancel order item before it is processed by supplier",
                 "consumes": [
                     "application/json"
                 ],
@@ -3817,15 +5858,21 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "lấy presigned url để upload ảnh",
+                "summary": "cancel order item",
                 "parameters": [
                     {
-                        "description": "Thông tin file",
-                        "name": "request",
+                        "type": "string",
+                        "name": "orderItemID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "reason cancel order item",
+                        "name": "req2",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetAvatarPresignedURLRequest"
+                            "$ref": "#/definitions/api_gateway_dto.CancelOrderItemRequest"
                         }
                     }
                 ],
@@ -3833,7 +5880,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetAvatarPresignedURLResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.CancelOrderItemResponseDocs"
                         }
                     },
                     "400": {
@@ -3848,6 +5895,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3857,14 +5910,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/carts": {
+        "/users/me/orders/{orderItemID}/timeline": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "update cart item",
+                "description": "get status events of own order item, merged with pickup and delivery time of deliverer",
                 "consumes": [
                     "application/json"
                 ],
@@ -3874,12 +5927,20 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "update cart item",
+                "summary": "get timeline of order item",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "orderItemID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetCartItemsResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.GetOrderItemTimelineResponseDocs"
                         }
                     },
                     "400": {
@@ -3894,6 +5955,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3901,14 +5968,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/users/me/payment-methods": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "update cart item",
+                "description": "get saved payment methods of current user, card data is returned only as last 4 digits",
                 "consumes": [
                     "application/json"
                 ],
@@ -3918,29 +5987,12 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "update cart item",
-                "parameters": [
-                    {
-                        "description": "info cart item",
-                        "name": "req",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.AddItemToCartRequest"
-                        }
-                    }
-                ],
+                "summary": "get saved payment methods",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.AddItemToCartResponseDocs"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                            "$ref": "#/definitions/api_gateway_dto.GetUserPaymentMethodsResponseDocs"
                         }
                     },
                     "401": {
@@ -3957,13 +6009,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "update cart item",
+                "description": "save payment method by provider token (with last 4 digits) or card number, card number is encrypted",
                 "consumes": [
                     "application/json"
                 ],
@@ -3973,23 +6025,23 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "update cart item",
+                "summary": "save payment method",
                 "parameters": [
                     {
-                        "description": "info cart item ids to delete",
+                        "description": "payment method",
                         "name": "req",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.DeleteCartItemRequest"
+                            "$ref": "#/definitions/api_gateway_dto.CreateUserPaymentMethodRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.DeleteCartItemResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.CreateUserPaymentMethodResponseDocs"
                         }
                     },
                     "400": {
@@ -4013,14 +6065,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/carts/{cartItemID}": {
-            "patch": {
+        "/users/me/payment-methods/{userPaymentMethodID}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "update cart item",
+                "description": "delete saved payment method of current user, next latest method becomes default one",
                 "consumes": [
                     "application/json"
                 ],
@@ -4030,29 +6082,21 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "update cart item",
+                "summary": "delete saved payment method",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "cartItemID",
+                        "type": "integer",
+                        "description": "saved payment method id on path",
+                        "name": "userPaymentMethodID",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "info of prod",
-                        "name": "req2",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateCartItemRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateCartItemResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.DeleteUserPaymentMethodResponseDocs"
                         }
                     },
                     "400": {
@@ -4067,48 +6111,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
-                        }
-                    }
-                }
-            }
-        },
-        "/users/me/notification-settings": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Lấy cài đặt thông báo",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "me"
-                ],
-                "summary": "Lấy cài đặt thông báo",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetNotificationSettingsResponseDocs"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
@@ -4120,14 +6124,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/users/me/payment-methods/{userPaymentMethodID}/default": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cập nhật cài đặt thông báo",
+                "description": "set default saved payment method of current user",
                 "consumes": [
                     "application/json"
                 ],
@@ -4137,23 +6143,21 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "Cập nhật cài đặt thông báo",
+                "summary": "set default payment method",
                 "parameters": [
                     {
-                        "description": "Dữ liệu cập nhật",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateNotificationSettingsRequest"
-                        }
+                        "type": "integer",
+                        "description": "saved payment method id on path",
+                        "name": "userPaymentMethodID",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateNotificationSettingsResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.SetDefaultUserPaymentMethodResponseDocs"
                         }
                     },
                     "400": {
@@ -4168,6 +6172,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4177,14 +6187,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/notifications": {
+        "/users/me/wishlist": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Lấy danh sách lịch sử thông báo",
+                "description": "get wishlist items of current user, price_dropped is true when current price is lower than saved price",
                 "consumes": [
                     "application/json"
                 ],
@@ -4194,18 +6204,12 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "Lấy danh sách lịch sử thông báo",
+                "summary": "get wishlist items",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "page",
+                        "type": "string",
+                        "description": "Currency is currency of prices in response, prices are converted with exchange rates, default is VND",
+                        "name": "currency",
                         "in": "query"
                     }
                 ],
@@ -4213,7 +6217,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.GetListNotificationsHistoryResponse"
+                            "$ref": "#/definitions/api_gateway_dto.GetWishlistItemsResponseDocs"
                         }
                     },
                     "400": {
@@ -4235,16 +6239,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/me/notifications/mark-all-read": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Đánh dấu tất cả thông báo đã được đọc",
+                "description": "save product into wishlist with its current price, item which is already saved keeps its saved price",
                 "consumes": [
                     "application/json"
                 ],
@@ -4254,12 +6256,23 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "Đánh dấu tất cả thông báo đã được đọc",
+                "summary": "add item to wishlist",
+                "parameters": [
+                    {
+                        "description": "product to save",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.AddWishlistItemRequest"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.MarkNotificationResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.AddWishlistItemResponseDocs"
                         }
                     },
                     "400": {
@@ -4274,6 +6287,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4281,16 +6300,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/users/me/notifications/{notificationID}/mark-read": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Đánh dấu một thông báo đã được đọc",
+                "description": "delete wishlist items of current user",
                 "consumes": [
                     "application/json"
                 ],
@@ -4300,21 +6317,23 @@ const docTemplate = `{
                 "tags": [
                     "me"
                 ],
-                "summary": "Đánh dấu một thông báo đã được đọc",
+                "summary": "delete wishlist items",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "notification id",
-                        "name": "notificationID",
-                        "in": "path",
-                        "required": true
+                        "description": "wishlist item ids to delete",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.DeleteWishlistItemsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.MarkNotificationResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.DeleteWishlistItemsResponseDocs"
                         }
                     },
                     "400": {
@@ -4338,90 +6357,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/orders": {
-            "get": {
+        "/users/me/wishlist/{wishlistItemID}/move-to-cart": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "update cart item",
+                "description": "add wishlist item to cart with quantity and remove it from wishlist, item is kept when inventory is not enough",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "me"
-                ],
-                "summary": "update cart item",
-                "parameters": [
-                    {
-                        "minLength": 1,
-                        "type": "string",
-                        "name": "keyword",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "move wishlist item to cart",
+                "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "wishlist item id",
+                        "name": "wishlistItemID",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "enum": [
-                            "pending_payment",
-                            "pending",
-                            "confirmed",
-                            "processing",
-                            "ready_to_ship",
-                            "in_transit",
-                            "out_for_delivery",
-                            "delivered",
-                            "cancelled",
-                            "payment_failed",
-                            "refunded"
-                        ],
-                        "type": "string",
-                        "x-enum-comments": {
-                            "Confirmed": "Supplier đã xác nhận",
-                            "Delivered": "Đã giao thành công",
-                            "InTransit": "Đang vận chuyển (đang ship)",
-                            "OutForDelivery": "Sắp giao (shipper đang trên đường)",
-                            "Pending": "Chờ supplier xác nhận",
-                            "PendingPayment": "Chờ thanh toán",
-                            "Processing": "Đang chuẩn bị hàng",
-                            "ReadyToShip": "Sẵn sàng giao hàng"
-                        },
-                        "x-enum-varnames": [
-                            "PendingPayment",
-                            "Pending",
-                            "Confirmed",
-                            "Processing",
-                            "ReadyToShip",
-                            "InTransit",
-                            "OutForDelivery",
-                            "Delivered",
-                            "Cancelled",
-                            "PaymentFailed",
-                            "Refunded"
-                        ],
-                        "name": "status",
-                        "in": "query"
+                        "description": "quantity to add to cart",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.MoveWishlistItemToCartRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_gateway_dto.UpdateCartItemResponseDocs"
+                            "$ref": "#/definitions/api_gateway_dto.MoveWishlistItemToCartResponseDocs"
                         }
                     },
                     "400": {
@@ -4436,6 +6412,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/api_gateway_dto.ResponseErrorDocs"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4564,6 +6546,63 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "api_gateway_dto.AcknowledgeCartItemChangesRequest": {
+            "type": "object",
+            "required": [
+                "cart_item_ids"
+            ],
+            "properties": {
+                "cart_item_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "api_gateway_dto.AcknowledgeCartItemChangesResponse": {
+            "type": "object",
+            "properties": {
+                "cart_item_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "api_gateway_dto.AcknowledgeCartItemChangesResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.AcknowledgeCartItemChangesResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
+        "api_gateway_dto.AddGuestCartItemResponse": {
+            "type": "object",
+            "properties": {
+                "guest_cart_token": {
+                    "description": "GuestCartToken identifies guest cart, it is also set into cookie guest_cart_token",
+                    "type": "string"
+                }
+            }
+        },
+        "api_gateway_dto.AddGuestCartItemResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.AddGuestCartItemResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.AddItemToCartRequest": {
             "type": "object",
             "required": [
@@ -4598,6 +6637,40 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.AddWishlistItemRequest": {
+            "type": "object",
+            "required": [
+                "product_id",
+                "product_variant_id"
+            ],
+            "properties": {
+                "product_id": {
+                    "type": "string"
+                },
+                "product_variant_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_gateway_dto.AddWishlistItemResponse": {
+            "type": "object",
+            "properties": {
+                "wishlist_item_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_gateway_dto.AddWishlistItemResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.AddWishlistItemResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.AttributeOptionValue": {
             "type": "object",
             "properties": {
@@ -4609,6 +6682,53 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.CancelOrderItemRequest": {
+            "type": "object",
+            "required": [
+                "cancelled_reason"
+            ],
+            "properties": {
+                "cancelled_reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_gateway_dto.CancelOrderItemResponse": {
+            "type": "object"
+        },
+        "api_gateway_dto.CancelOrderItemResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.CancelOrderItemResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
+        "api_gateway_dto.CartItemWarningResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "max_quantity": {
+                    "description": "MaxQuantity is quantity which can be bought, it is set for insufficient_stock",
+                    "type": "integer"
+                },
+                "new_price": {
+                    "type": "number"
+                },
+                "old_price": {
+                    "description": "OldPrice is last price seen by buyer and NewPrice is current price, they are set for price_changed",
+                    "type": "number"
+                },
+                "type": {
+                    "$ref": "#/definitions/common.CartWarningType"
+                }
+            }
+        },
         "api_gateway_dto.ChangePasswordRequest": {
             "type": "object",
             "required": [
@@ -4670,6 +6790,122 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.CheckoutItemRequest": {
+            "type": "object",
+            "required": [
+                "estimated_delivery_date",
+                "product_id",
+                "product_name",
+                "product_variant_id",
+                "product_variant_image_url",
+                "product_variant_name",
+                "quantity"
+            ],
+            "properties": {
+                "coupon_id": {
+                    "type": "string"
+                },
+                "estimated_delivery_date": {
+                    "type": "string"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "product_name": {
+                    "type": "string"
+                },
+                "product_variant_id": {
+                    "type": "string"
+                },
+                "product_variant_image_url": {
+                    "type": "string"
+                },
+                "product_variant_name": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api_gateway_dto.CheckoutProblemResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "coupon_id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "product_variant_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_gateway_dto.CheckoutRequest": {
+            "type": "object",
+            "required": [
+                "items",
+                "method_type",
+                "recipient_name",
+                "recipient_phone",
+                "shipping_address",
+                "shipping_district",
+                "shipping_province",
+                "shipping_ward"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.CheckoutItemRequest"
+                    }
+                },
+                "method_type": {
+                    "enum": [
+                        "momo",
+                        "vnpay",
+                        "cod"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/common.MethodType"
+                        }
+                    ]
+                },
+                "order_coupon_id": {
+                    "description": "OrderCouponID is coupon of whole order, coupon_id of item is only for coupon of product or category",
+                    "type": "string"
+                },
+                "recipient_name": {
+                    "type": "string"
+                },
+                "recipient_phone": {
+                    "type": "string"
+                },
+                "shipping_address": {
+                    "type": "string"
+                },
+                "shipping_district": {
+                    "type": "string"
+                },
+                "shipping_province": {
+                    "description": "ShippingProvince, ShippingDistrict and ShippingWard are names of administrative divisions of shipping address,\nshipping fee is calculated from them",
+                    "type": "string"
+                },
+                "shipping_ward": {
+                    "type": "string"
+                },
+                "user_payment_method_id": {
+                    "description": "UserPaymentMethodID is saved payment method of buyer, it must be saved for method_type",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
         "api_gateway_dto.CheckoutResponse": {
             "type": "object",
             "properties": {
@@ -4785,6 +7021,103 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.CreateCouponCampaignRequest": {
+            "type": "object",
+            "required": [
+                "currency",
+                "discount_type",
+                "discount_value",
+                "end_date",
+                "maximum_discount_amount",
+                "minimum_order_amount",
+                "name",
+                "scope",
+                "start_date",
+                "total_codes"
+            ],
+            "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "code_prefix": {
+                    "type": "string",
+                    "maxLength": 12
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "discount_type": {
+                    "type": "string",
+                    "enum": [
+                        "percentage",
+                        "fixed_amount"
+                    ]
+                },
+                "discount_value": {
+                    "type": "number"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "maximum_discount_amount": {
+                    "type": "number"
+                },
+                "minimum_order_amount": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string"
+                },
+                "scope": {
+                    "type": "string",
+                    "enum": [
+                        "order",
+                        "product",
+                        "category"
+                    ]
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "total_codes": {
+                    "type": "integer"
+                },
+                "user_ids": {
+                    "description": "UserIDs assigns code i to user_ids[i], its length must be same as total_codes",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "api_gateway_dto.CreateCouponCampaignResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_gateway_dto.CreateCouponCampaignResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.CreateCouponCampaignResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.CreateCouponRequest": {
             "type": "object",
             "required": [
@@ -4795,10 +7128,15 @@ const docTemplate = `{
                 "maximum_discount_amount",
                 "minimum_order_amount",
                 "name",
+                "scope",
                 "start_date",
                 "usage_limit"
             ],
             "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
                 "currency": {
                     "type": "string"
                 },
@@ -4828,11 +7166,23 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "scope": {
+                    "type": "string",
+                    "enum": [
+                        "order",
+                        "product",
+                        "category"
+                    ]
+                },
                 "start_date": {
                     "type": "string"
                 },
                 "usage_limit": {
                     "type": "integer"
+                },
+                "usage_limit_per_user": {
+                    "type": "integer",
+                    "minimum": 1
                 }
             }
         },
@@ -4891,6 +7241,21 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.CreateRefundRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "payment_history_id"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "payment_history_id": {
+                    "type": "string"
+                }
+            }
+        },
         "api_gateway_dto.CreateRoleRequest": {
             "type": "object",
             "required": [
@@ -4927,6 +7292,59 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.CreateShippingRateRequest": {
+            "type": "object",
+            "required": [
+                "shipping_class",
+                "zone"
+            ],
+            "properties": {
+                "base_fee": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "base_weight_grams": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "fee_per_extra_kg": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "shipping_class": {
+                    "description": "ShippingClass is same as shipping_class of product variants, rates of 'default' are used for classes without rates",
+                    "type": "string"
+                },
+                "zone": {
+                    "description": "Zone is intra_district (same district), intra_province (same province) or inter_province",
+                    "type": "string",
+                    "enum": [
+                        "intra_district",
+                        "intra_province",
+                        "inter_province"
+                    ]
+                }
+            }
+        },
+        "api_gateway_dto.CreateShippingRateResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api_gateway_dto.CreateShippingRateResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.CreateShippingRateResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.CreateUserByAdminRequest": {
             "type": "object",
             "required": [
@@ -4991,6 +7409,64 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.CreateUserPaymentMethodRequest": {
+            "type": "object",
+            "required": [
+                "card_expiry_month",
+                "card_expiry_year",
+                "card_holder_name",
+                "payment_method_code"
+            ],
+            "properties": {
+                "card_brand": {
+                    "type": "string"
+                },
+                "card_expiry_month": {
+                    "type": "integer",
+                    "maximum": 12,
+                    "minimum": 1
+                },
+                "card_expiry_year": {
+                    "type": "integer",
+                    "minimum": 2000
+                },
+                "card_holder_name": {
+                    "type": "string"
+                },
+                "card_last4": {
+                    "type": "string"
+                },
+                "card_number": {
+                    "type": "string",
+                    "maxLength": 23,
+                    "minLength": 12
+                },
+                "is_default": {
+                    "type": "boolean"
+                },
+                "payment_method_code": {
+                    "type": "string",
+                    "enum": [
+                        "momo",
+                        "vnpay"
+                    ]
+                },
+                "provider_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_gateway_dto.CreateUserPaymentMethodResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.UserPaymentMethodResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.DeleteAddressResponse": {
             "type": "object"
         },
@@ -5069,6 +7545,35 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.DeleteGuestCartItemsRequest": {
+            "type": "object",
+            "required": [
+                "product_variant_ids"
+            ],
+            "properties": {
+                "product_variant_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "api_gateway_dto.DeleteGuestCartItemsResponse": {
+            "type": "object"
+        },
+        "api_gateway_dto.DeleteGuestCartItemsResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.DeleteGuestCartItemsResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.DeletePermissionByPermissionIDURIResponse": {
             "type": "object"
         },
@@ -5097,14 +7602,79 @@ const docTemplate = `{
                 }
             }
         },
-        "api_gateway_dto.DeleteUserByAdminResponse": {
-            "type": "object"
-        },
-        "api_gateway_dto.DeleteUserByAdminResponseDocs": {
+        "api_gateway_dto.DeleteShippingRateResponse": {
+            "type": "object"
+        },
+        "api_gateway_dto.DeleteShippingRateResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.DeleteShippingRateResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
+        "api_gateway_dto.DeleteUserByAdminResponse": {
+            "type": "object"
+        },
+        "api_gateway_dto.DeleteUserByAdminResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.DeleteUserByAdminResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
+        "api_gateway_dto.DeleteUserPaymentMethodResponse": {
+            "type": "object"
+        },
+        "api_gateway_dto.DeleteUserPaymentMethodResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.DeleteUserPaymentMethodResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
+        "api_gateway_dto.DeleteWishlistItemsRequest": {
+            "type": "object",
+            "required": [
+                "wishlist_item_ids"
+            ],
+            "properties": {
+                "wishlist_item_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "api_gateway_dto.DeleteWishlistItemsResponse": {
+            "type": "object",
+            "properties": {
+                "wishlist_item_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "api_gateway_dto.DeleteWishlistItemsResponseDocs": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/api_gateway_dto.DeleteUserByAdminResponse"
+                    "$ref": "#/definitions/api_gateway_dto.DeleteWishlistItemsResponse"
                 },
                 "metadata": {
                     "$ref": "#/definitions/api_gateway_dto.Metadata"
@@ -5136,6 +7706,54 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.ExchangeRateRequest": {
+            "type": "object",
+            "required": [
+                "currency"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                }
+            }
+        },
+        "api_gateway_dto.ExchangeRateResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_gateway_dto.ExportCouponCampaignCodesResponse": {
+            "type": "object",
+            "properties": {
+                "download_url": {
+                    "description": "DownloadURL is presigned url of csv file, it expires after 1 hour",
+                    "type": "string"
+                }
+            }
+        },
+        "api_gateway_dto.ExportCouponCampaignCodesResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.ExportCouponCampaignCodesResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.ForgotPasswordRequest": {
             "type": "object",
             "required": [
@@ -5295,6 +7913,13 @@ const docTemplate = `{
                 },
                 "variant_name": {
                     "type": "string"
+                },
+                "warnings": {
+                    "description": "Warnings are changes of item since buyer last saw it",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.CartItemWarningResponse"
+                    }
                 }
             }
         },
@@ -5343,9 +7968,85 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.GetCouponCampaignsResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "integer"
+                },
+                "code_prefix": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "discount_type": {
+                    "type": "string"
+                },
+                "discount_value": {
+                    "type": "number"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "generated_codes": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "maximum_discount_amount": {
+                    "type": "number"
+                },
+                "minimum_order_amount": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "revoked_codes": {
+                    "type": "integer"
+                },
+                "scope": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "total_codes": {
+                    "type": "integer"
+                },
+                "used_codes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api_gateway_dto.GetCouponCampaignsResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.GetCouponCampaignsResponse"
+                    }
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.MetadataWithPagination"
+                }
+            }
+        },
         "api_gateway_dto.GetCouponsResponse": {
             "type": "object",
             "properties": {
+                "category_id": {
+                    "type": "integer"
+                },
                 "code": {
                     "type": "string"
                 },
@@ -5376,6 +8077,9 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "scope": {
+                    "type": "string"
+                },
                 "start_date": {
                     "type": "string"
                 },
@@ -5384,6 +8088,12 @@ const docTemplate = `{
                 },
                 "usage_limit": {
                     "type": "integer"
+                },
+                "usage_limit_per_user": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
@@ -5438,6 +8148,9 @@ const docTemplate = `{
         "api_gateway_dto.GetDetailCouponResponse": {
             "type": "object",
             "properties": {
+                "category_id": {
+                    "type": "integer"
+                },
                 "code": {
                     "type": "string"
                 },
@@ -5474,6 +8187,9 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "scope": {
+                    "type": "string"
+                },
                 "start_date": {
                     "type": "string"
                 },
@@ -5485,6 +8201,9 @@ const docTemplate = `{
                 },
                 "usage_limit": {
                     "type": "integer"
+                },
+                "usage_limit_per_user": {
+                    "type": "integer"
                 }
             }
         },
@@ -5499,6 +8218,32 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.GetExchangeRatesResponse": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
+                    "description": "BaseCurrency is currency of product prices, rates are amount of it for one unit of other currencies",
+                    "type": "string"
+                },
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.ExchangeRateResponse"
+                    }
+                }
+            }
+        },
+        "api_gateway_dto.GetExchangeRatesResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.GetExchangeRatesResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.GetListCurrentAddressResponseDocs": {
             "type": "object",
             "properties": {
@@ -5623,6 +8368,20 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.GetOrderItemTimelineResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.OrderItemTimelineResponse"
+                    }
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.GetPaymentMethodsResponse": {
             "type": "object",
             "properties": {
@@ -5924,6 +8683,20 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.GetRefundsResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.RefundResponse"
+                    }
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.GetRoleResponse": {
             "type": "object",
             "properties": {
@@ -5961,6 +8734,20 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.GetShippingRatesResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.ShippingRateResponse"
+                    }
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.GetSupplierByIDResponse": {
             "type": "object",
             "properties": {
@@ -6042,6 +8829,9 @@ const docTemplate = `{
                 "cancelled_reason": {
                     "type": "string"
                 },
+                "currency": {
+                    "type": "string"
+                },
                 "discount_amount": {
                     "description": "discount amount",
                     "type": "number"
@@ -6232,58 +9022,133 @@ const docTemplate = `{
                 }
             }
         },
-        "api_gateway_dto.GetUserByAdminResponse": {
+        "api_gateway_dto.GetUserByAdminResponse": {
+            "type": "object",
+            "properties": {
+                "avatar_url": {
+                    "type": "string"
+                },
+                "birth_date": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "email_verify": {
+                    "type": "boolean"
+                },
+                "fullname": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "phone_verify": {
+                    "type": "boolean"
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.RoleLoginResponse"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_gateway_dto.GetUserByAdminResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.GetUserByAdminResponse"
+                    }
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.MetadataWithPagination"
+                }
+            }
+        },
+        "api_gateway_dto.GetUserPaymentMethodsResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.UserPaymentMethodResponse"
+                    }
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
+        "api_gateway_dto.GetWishlistItemsResponse": {
             "type": "object",
             "properties": {
-                "avatar_url": {
+                "added_at": {
                     "type": "string"
                 },
-                "birth_date": {
-                    "type": "string"
+                "available": {
+                    "description": "Available is false when product is not sold anymore, current prices are not set then",
+                    "type": "boolean"
                 },
-                "email": {
+                "currency": {
                     "type": "string"
                 },
-                "email_verify": {
+                "discount_price": {
+                    "type": "number"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "price_dropped": {
+                    "description": "PriceDropped is true when current price is lower than saved price",
                     "type": "boolean"
                 },
-                "fullname": {
+                "product_id": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "product_name": {
+                    "type": "string"
                 },
-                "phone": {
+                "product_variant_id": {
                     "type": "string"
                 },
-                "phone_verify": {
-                    "type": "boolean"
+                "product_variant_thumbnail": {
+                    "type": "string"
                 },
-                "roles": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/api_gateway_dto.RoleLoginResponse"
-                    }
+                "saved_price": {
+                    "description": "SavedPrice is price when item is saved, prices are in currency",
+                    "type": "number"
                 },
-                "status": {
+                "variant_name": {
                     "type": "string"
                 },
-                "updated_at": {
+                "wishlist_item_id": {
                     "type": "string"
                 }
             }
         },
-        "api_gateway_dto.GetUserByAdminResponseDocs": {
+        "api_gateway_dto.GetWishlistItemsResponseDocs": {
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/api_gateway_dto.GetUserByAdminResponse"
+                        "$ref": "#/definitions/api_gateway_dto.GetWishlistItemsResponse"
                     }
                 },
                 "metadata": {
-                    "$ref": "#/definitions/api_gateway_dto.MetadataWithPagination"
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
                 }
             }
         },
@@ -6443,6 +9308,68 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.MoveCartItemToWishlistResponse": {
+            "type": "object",
+            "properties": {
+                "wishlist_item_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_gateway_dto.MoveCartItemToWishlistResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.MoveCartItemToWishlistResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
+        "api_gateway_dto.MoveWishlistItemToCartRequest": {
+            "type": "object",
+            "required": [
+                "quantity"
+            ],
+            "properties": {
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "api_gateway_dto.MoveWishlistItemToCartResponse": {
+            "type": "object"
+        },
+        "api_gateway_dto.MoveWishlistItemToCartResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.MoveWishlistItemToCartResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
+        "api_gateway_dto.OrderItemTimelineResponse": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
         "api_gateway_dto.Pagination": {
             "type": "object",
             "properties": {
@@ -6508,6 +9435,121 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.QuoteCheckoutItemResponse": {
+            "type": "object",
+            "properties": {
+                "coupon_id": {
+                    "type": "string"
+                },
+                "discount_amount": {
+                    "type": "number"
+                },
+                "product_variant_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "shipping_fee": {
+                    "type": "number"
+                },
+                "supplier_id": {
+                    "type": "integer"
+                },
+                "tax_amount": {
+                    "type": "number"
+                },
+                "total_amount": {
+                    "type": "number"
+                },
+                "total_price": {
+                    "type": "number"
+                },
+                "unit_price": {
+                    "type": "number"
+                }
+            }
+        },
+        "api_gateway_dto.QuoteCheckoutResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "description": "Currency is currency of payment method, amounts are converted into it with current exchange rates",
+                    "type": "string"
+                },
+                "discount_amount": {
+                    "type": "number"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.QuoteCheckoutItemResponse"
+                    }
+                },
+                "prices_include_tax": {
+                    "description": "PricesIncludeTax is true when tax_amount is already in sub_total",
+                    "type": "boolean"
+                },
+                "problems": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.CheckoutProblemResponse"
+                    }
+                },
+                "shipping_fee": {
+                    "type": "number"
+                },
+                "sub_total": {
+                    "type": "number"
+                },
+                "suppliers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.QuoteCheckoutSupplierResponse"
+                    }
+                },
+                "tax_amount": {
+                    "type": "number"
+                },
+                "total_amount": {
+                    "type": "number"
+                }
+            }
+        },
+        "api_gateway_dto.QuoteCheckoutResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.QuoteCheckoutResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
+        "api_gateway_dto.QuoteCheckoutSupplierResponse": {
+            "type": "object",
+            "properties": {
+                "discount_amount": {
+                    "type": "number"
+                },
+                "shipping_fee": {
+                    "type": "number"
+                },
+                "sub_total": {
+                    "type": "number"
+                },
+                "supplier_id": {
+                    "type": "integer"
+                },
+                "tax_amount": {
+                    "type": "number"
+                },
+                "total_amount": {
+                    "type": "number"
+                }
+            }
+        },
         "api_gateway_dto.RefreshTokenResponse": {
             "type": "object",
             "properties": {
@@ -6530,6 +9572,43 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.RefundResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "payment_history_id": {
+                    "type": "string"
+                },
+                "processed_by": {
+                    "type": "integer"
+                },
+                "refunded_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "transaction_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_gateway_dto.RefundResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.RefundResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.RegisterDelivererRequest": {
             "type": "object",
             "required": [
@@ -6656,25 +9735,93 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
-                "documents": {
-                    "$ref": "#/definitions/api_gateway_dto.SupplierDocument"
+                "documents": {
+                    "$ref": "#/definitions/api_gateway_dto.SupplierDocument"
+                },
+                "logo_company_url": {
+                    "type": "string"
+                },
+                "tax_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_gateway_dto.RegisterSupplierResponse": {
+            "type": "object"
+        },
+        "api_gateway_dto.RegisterSupplierResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.RegisterSupplierResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
+        "api_gateway_dto.ReorderItemResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "new_unit_price": {
+                    "type": "number"
+                },
+                "old_unit_price": {
+                    "type": "number"
+                },
+                "product_id": {
+                    "type": "string"
+                },
+                "product_name": {
+                    "type": "string"
+                },
+                "product_variant_id": {
+                    "type": "string"
                 },
-                "logo_company_url": {
+                "product_variant_name": {
                     "type": "string"
                 },
-                "tax_id": {
+                "quantity": {
+                    "type": "integer"
+                },
+                "reason": {
                     "type": "string"
                 }
             }
         },
-        "api_gateway_dto.RegisterSupplierResponse": {
-            "type": "object"
+        "api_gateway_dto.ReorderToCartResponse": {
+            "type": "object",
+            "properties": {
+                "added_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.ReorderItemResponse"
+                    }
+                },
+                "price_changed_items": {
+                    "description": "PriceChangedItems are added items whose current price is different from price in order",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.ReorderItemResponse"
+                    }
+                },
+                "unavailable_items": {
+                    "description": "UnavailableItems are not sold anymore or have not enough inventory, they are not added to cart",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.ReorderItemResponse"
+                    }
+                }
+            }
         },
-        "api_gateway_dto.RegisterSupplierResponseDocs": {
+        "api_gateway_dto.ReorderToCartResponseDocs": {
             "type": "object",
             "properties": {
                 "data": {
-                    "$ref": "#/definitions/api_gateway_dto.RegisterSupplierResponse"
+                    "$ref": "#/definitions/api_gateway_dto.ReorderToCartResponse"
                 },
                 "metadata": {
                     "$ref": "#/definitions/api_gateway_dto.Metadata"
@@ -6750,6 +9897,37 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.RevokeCouponCampaignCodesRequest": {
+            "type": "object",
+            "properties": {
+                "coupon_ids": {
+                    "description": "CouponIDs are codes of campaign to revoke, every unused code of campaign is revoked when it is empty",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "api_gateway_dto.RevokeCouponCampaignCodesResponse": {
+            "type": "object",
+            "properties": {
+                "revoked_codes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api_gateway_dto.RevokeCouponCampaignCodesResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.RevokeCouponCampaignCodesResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.RoleLoginResponse": {
             "type": "object",
             "properties": {
@@ -6775,6 +9953,20 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.SetDefaultUserPaymentMethodResponse": {
+            "type": "object"
+        },
+        "api_gateway_dto.SetDefaultUserPaymentMethodResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.SetDefaultUserPaymentMethodResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.SettingsResponse": {
             "type": "object",
             "properties": {
@@ -6792,6 +9984,35 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.ShippingRateResponse": {
+            "type": "object",
+            "properties": {
+                "base_fee": {
+                    "type": "number"
+                },
+                "base_weight_grams": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "fee_per_extra_kg": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "shipping_class": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "zone": {
+                    "type": "string"
+                }
+            }
+        },
         "api_gateway_dto.SupplierDocument": {
             "type": "object",
             "required": [
@@ -6944,7 +10165,14 @@ const docTemplate = `{
         },
         "api_gateway_dto.UpdateCouponRequest": {
             "type": "object",
+            "required": [
+                "scope"
+            ],
             "properties": {
+                "category_id": {
+                    "type": "integer",
+                    "minimum": 1
+                },
                 "description": {
                     "type": "string"
                 },
@@ -6973,11 +10201,23 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "scope": {
+                    "type": "string",
+                    "enum": [
+                        "order",
+                        "product",
+                        "category"
+                    ]
+                },
                 "start_date": {
                     "type": "string"
                 },
                 "usage_limit": {
                     "type": "integer"
+                },
+                "usage_limit_per_user": {
+                    "type": "integer",
+                    "minimum": 1
                 }
             }
         },
@@ -7077,6 +10317,67 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.UpdateExchangeRatesRequest": {
+            "type": "object",
+            "required": [
+                "rates"
+            ],
+            "properties": {
+                "rates": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/api_gateway_dto.ExchangeRateRequest"
+                    }
+                }
+            }
+        },
+        "api_gateway_dto.UpdateExchangeRatesResponse": {
+            "type": "object"
+        },
+        "api_gateway_dto.UpdateExchangeRatesResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.UpdateExchangeRatesResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
+        "api_gateway_dto.UpdateGuestCartItemRequest": {
+            "type": "object",
+            "properties": {
+                "quantity": {
+                    "description": "Quantity 0 removes item from guest cart",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "api_gateway_dto.UpdateGuestCartItemResponse": {
+            "type": "object",
+            "properties": {
+                "product_variant_id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api_gateway_dto.UpdateGuestCartItemResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.UpdateGuestCartItemResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.UpdateInAppSettingRequest": {
             "type": "object",
             "required": [
@@ -7164,80 +10465,18 @@ const docTemplate = `{
                 }
             }
         },
-        "api_gateway_dto.UpdateOrderIPNMomoRequest": {
+        "api_gateway_dto.UpdateOrderItemRequest": {
             "type": "object",
-            "required": [
-                "amount",
-                "orderId",
-                "partnerCode",
-                "requestId",
-                "signature",
-                "transId"
-            ],
             "properties": {
-                "amount": {
-                    "type": "integer"
-                },
-                "extraData": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
-                },
-                "orderId": {
-                    "type": "string"
-                },
-                "orderInfo": {
-                    "type": "string"
-                },
-                "orderType": {
-                    "type": "string"
-                },
-                "partnerCode": {
-                    "type": "string"
-                },
-                "payType": {
-                    "type": "string"
-                },
-                "requestId": {
-                    "type": "string"
-                },
-                "responseTime": {
-                    "type": "integer"
-                },
-                "resultCode": {
-                    "type": "integer",
-                    "minimum": 0
-                },
-                "signature": {
+                "notes": {
                     "type": "string"
                 },
-                "transId": {
-                    "type": "integer"
-                }
-            }
-        },
-        "api_gateway_dto.UpdateOrderIPNMomoResponse": {
-            "type": "object"
-        },
-        "api_gateway_dto.UpdateOrderIPNMomoResponseDocs": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/api_gateway_dto.UpdateOrderIPNMomoResponse"
-                },
-                "metadata": {
-                    "$ref": "#/definitions/api_gateway_dto.Metadata"
-                }
-            }
-        },
-        "api_gateway_dto.UpdateOrderItemRequest": {
-            "type": "object",
-            "properties": {
                 "status": {
                     "enum": [
                         "confirmed",
-                        "cancelled"
+                        "cancelled",
+                        "processing",
+                        "ready_to_ship"
                     ],
                     "allOf": [
                         {
@@ -7349,6 +10588,37 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.UpdateShippingRateRequest": {
+            "type": "object",
+            "properties": {
+                "base_fee": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "base_weight_grams": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "fee_per_extra_kg": {
+                    "type": "number",
+                    "minimum": 0
+                }
+            }
+        },
+        "api_gateway_dto.UpdateShippingRateResponse": {
+            "type": "object"
+        },
+        "api_gateway_dto.UpdateShippingRateResponseDocs": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/api_gateway_dto.UpdateShippingRateResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/api_gateway_dto.Metadata"
+                }
+            }
+        },
         "api_gateway_dto.UpdateSupplierDocumentVerificationStatusRequest": {
             "type": "object",
             "properties": {
@@ -7438,6 +10708,41 @@ const docTemplate = `{
                 }
             }
         },
+        "api_gateway_dto.UserPaymentMethodResponse": {
+            "type": "object",
+            "properties": {
+                "card_brand": {
+                    "type": "string"
+                },
+                "card_expiry_month": {
+                    "type": "integer"
+                },
+                "card_expiry_year": {
+                    "type": "integer"
+                },
+                "card_holder_name": {
+                    "type": "string"
+                },
+                "card_last4": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_default": {
+                    "type": "boolean"
+                },
+                "payment_method_code": {
+                    "type": "string"
+                },
+                "payment_method_name": {
+                    "type": "string"
+                }
+            }
+        },
         "api_gateway_dto.VariantAttributePair": {
             "type": "object",
             "properties": {
@@ -7541,14 +10846,34 @@ const docTemplate = `{
                 "BucketSuppliers"
             ]
         },
+        "common.CartWarningType": {
+            "type": "string",
+            "enum": [
+                "unavailable",
+                "insufficient_stock",
+                "price_changed"
+            ],
+            "x-enum-comments": {
+                "CartWarningInsufficientStock": "inventory is less than quantity in cart",
+                "CartWarningPriceChanged": "price is different from last price seen by buyer",
+                "CartWarningUnavailable": "variant is deactivated"
+            },
+            "x-enum-varnames": [
+                "CartWarningUnavailable",
+                "CartWarningInsufficientStock",
+                "CartWarningPriceChanged"
+            ]
+        },
         "common.MethodType": {
             "type": "string",
             "enum": [
                 "momo",
+                "vnpay",
                 "cod"
             ],
             "x-enum-varnames": [
                 "Momo",
+                "Vnpay",
                 "Cod"
             ]
         },
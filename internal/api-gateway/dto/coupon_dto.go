@@ -1,6 +1,9 @@
 package api_gateway_dto
 
-import "time"
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"time"
+)
 
 type GetCouponsRequest struct {
 	Limit        int64      `form:"limit,default=10" binding:"omitempty,gte=1"`
@@ -10,22 +13,29 @@ type GetCouponsRequest struct {
 	StartDate    *time.Time `form:"start_date"`
 	EndDate      *time.Time `form:"end_date"`
 	IsActive     *bool      `form:"is_active"`
+	Scope        *string    `form:"scope" binding:"omitempty,oneof=order product category"`
+	// CampaignID lists codes of campaign, coupons which are not in any campaign are listed when it is empty
+	CampaignID *string `form:"campaign_id" binding:"omitempty,uuid"`
 }
 
 type GetCouponsResponse struct {
-	ID                    string    `json:"id"`
-	Code                  string    `json:"code"`
-	Name                  string    `json:"name"`
-	DiscountType          string    `json:"discount_type"`
-	DiscountValue         float64   `json:"discount_value"`
-	MinimumOrderAmount    float64   `json:"minimum_order_amount"`
-	MaximumDiscountAmount float64   `json:"maximum_discount_amount"`
-	UsageLimit            int64     `json:"usage_limit"`
-	UsageCount            int64     `json:"usage_count"`
-	Currency              string    `json:"currency"`
-	StartDate             time.Time `json:"start_date"`
-	EndDate               time.Time `json:"end_date"`
-	IsActive              bool      `json:"is_active"`
+	ID                    string      `json:"id"`
+	Code                  string      `json:"code"`
+	Name                  string      `json:"name"`
+	DiscountType          string      `json:"discount_type"`
+	DiscountValue         money.Money `json:"discount_value" swaggertype:"number"`
+	MinimumOrderAmount    money.Money `json:"minimum_order_amount" swaggertype:"number"`
+	MaximumDiscountAmount money.Money `json:"maximum_discount_amount" swaggertype:"number"`
+	UsageLimit            int64       `json:"usage_limit"`
+	UsageCount            int64       `json:"usage_count"`
+	Currency              string      `json:"currency"`
+	StartDate             time.Time   `json:"start_date"`
+	EndDate               time.Time   `json:"end_date"`
+	IsActive              bool        `json:"is_active"`
+	Scope                 string      `json:"scope"`
+	CategoryID            *int64      `json:"category_id"`
+	UsageLimitPerUser     *int64      `json:"usage_limit_per_user"`
+	UserID                *int64      `json:"user_id"`
 }
 
 type GetCouponsByClientRequest struct {
@@ -39,35 +49,41 @@ type GetDetailCouponRequest struct {
 }
 
 type GetDetailCouponResponse struct {
-	ID                    string    `json:"id"`
-	Code                  string    `json:"code"`
-	Name                  string    `json:"name"`
-	Description           string    `json:"description"`
-	DiscountType          string    `json:"discount_type"`
-	DiscountValue         float64   `json:"discount_value"`
-	MaximumDiscountAmount float64   `json:"maximum_discount_amount"`
-	MinimumOrderAmount    float64   `json:"minimum_order_amount"`
-	Currency              string    `json:"currency"`
-	StartDate             time.Time `json:"start_date"`
-	EndDate               time.Time `json:"end_date"`
-	UsageLimit            int64     `json:"usage_limit"`
-	UsageCount            int64     `json:"usage_count"`
-	IsActive              bool      `json:"is_active"`
-	CreatedAt             time.Time `json:"created_at"`
-	UpdatedAt             time.Time `json:"updated_at"`
+	ID                    string      `json:"id"`
+	Code                  string      `json:"code"`
+	Name                  string      `json:"name"`
+	Description           string      `json:"description"`
+	DiscountType          string      `json:"discount_type"`
+	DiscountValue         money.Money `json:"discount_value" swaggertype:"number"`
+	MaximumDiscountAmount money.Money `json:"maximum_discount_amount" swaggertype:"number"`
+	MinimumOrderAmount    money.Money `json:"minimum_order_amount" swaggertype:"number"`
+	Currency              string      `json:"currency"`
+	StartDate             time.Time   `json:"start_date"`
+	EndDate               time.Time   `json:"end_date"`
+	UsageLimit            int64       `json:"usage_limit"`
+	UsageCount            int64       `json:"usage_count"`
+	IsActive              bool        `json:"is_active"`
+	Scope                 string      `json:"scope"`
+	CategoryID            *int64      `json:"category_id"`
+	UsageLimitPerUser     *int64      `json:"usage_limit_per_user"`
+	CreatedAt             time.Time   `json:"created_at"`
+	UpdatedAt             time.Time   `json:"updated_at"`
 }
 
 type UpdateCouponRequest struct {
-	Name                  string    `json:"name" binding:"omitempty"`
-	Description           string    `json:"description" binding:"omitempty"`
-	DiscountType          string    `json:"discount_type" binding:"omitempty,oneof=percentage fixed_amount"`
-	DiscountValue         float64   `json:"discount_value" binding:"omitempty"`
-	MaximumDiscountAmount float64   `json:"maximum_discount_amount" binding:"omitempty"`
-	MinimumOrderAmount    float64   `json:"minimum_order_amount" binding:"omitempty"`
-	StartDate             time.Time `json:"start_date" binding:"omitempty"`
-	EndDate               time.Time `json:"end_date" binding:"omitempty"`
-	UsageLimit            int64     `json:"usage_limit" binding:"omitempty"`
-	IsActive              bool      `json:"is_active" binding:"omitempty"`
+	Name                  string      `json:"name" binding:"omitempty"`
+	Description           string      `json:"description" binding:"omitempty"`
+	DiscountType          string      `json:"discount_type" binding:"omitempty,oneof=percentage fixed_amount"`
+	DiscountValue         money.Money `json:"discount_value" binding:"omitempty" swaggertype:"number"`
+	MaximumDiscountAmount money.Money `json:"maximum_discount_amount" binding:"omitempty" swaggertype:"number"`
+	MinimumOrderAmount    money.Money `json:"minimum_order_amount" binding:"omitempty" swaggertype:"number"`
+	StartDate             time.Time   `json:"start_date" binding:"omitempty"`
+	EndDate               time.Time   `json:"end_date" binding:"omitempty"`
+	UsageLimit            int64       `json:"usage_limit" binding:"omitempty"`
+	IsActive              bool        `json:"is_active" binding:"omitempty"`
+	Scope                 string      `json:"scope" binding:"required,oneof=order product category"`
+	CategoryID            *int64      `json:"category_id" binding:"required_if=Scope category,omitempty,gte=1"`
+	UsageLimitPerUser     *int64      `json:"usage_limit_per_user" binding:"omitempty,gte=1"`
 }
 
 type UpdateCouponUriRequest struct {
@@ -83,16 +99,87 @@ type DeleteCouponRequest struct {
 type DeleteCouponResponse struct{}
 
 type CreateCouponRequest struct {
-	Name                  string    `json:"name" binding:"required"`
-	Description           string    `json:"description" binding:"omitempty"`
-	DiscountType          string    `json:"discount_type" binding:"required,oneof=percentage fixed_amount"`
-	DiscountValue         float64   `json:"discount_value" binding:"required,gt=0"`
-	MaximumDiscountAmount float64   `json:"maximum_discount_amount" binding:"required,gt=0"`
-	MinimumOrderAmount    float64   `json:"minimum_order_amount" binding:"required,gte=0"`
-	Currency              string    `json:"currency" binding:"required"`
-	StartDate             time.Time `json:"start_date" binding:"required"`
-	EndDate               time.Time `json:"end_date" binding:"required"`
-	UsageLimit            int64     `json:"usage_limit" binding:"required,gt=0"`
+	Name                  string      `json:"name" binding:"required"`
+	Description           string      `json:"description" binding:"omitempty"`
+	DiscountType          string      `json:"discount_type" binding:"required,oneof=percentage fixed_amount"`
+	DiscountValue         money.Money `json:"discount_value" binding:"required,gt=0" swaggertype:"number"`
+	MaximumDiscountAmount money.Money `json:"maximum_discount_amount" binding:"required,gt=0" swaggertype:"number"`
+	MinimumOrderAmount    money.Money `json:"minimum_order_amount" binding:"required,gte=0" swaggertype:"number"`
+	Currency              string      `json:"currency" binding:"required"`
+	StartDate             time.Time   `json:"start_date" binding:"required"`
+	EndDate               time.Time   `json:"end_date" binding:"required"`
+	UsageLimit            int64       `json:"usage_limit" binding:"required,gt=0"`
+	Scope                 string      `json:"scope" binding:"required,oneof=order product category"`
+	CategoryID            *int64      `json:"category_id" binding:"required_if=Scope category,omitempty,gte=1"`
+	UsageLimitPerUser     *int64      `json:"usage_limit_per_user" binding:"omitempty,gte=1"`
 }
 
 type CreateCouponResponse struct{}
+
+type CreateCouponCampaignRequest struct {
+	Name                  string      `json:"name" binding:"required"`
+	Description           string      `json:"description" binding:"omitempty"`
+	DiscountType          string      `json:"discount_type" binding:"required,oneof=percentage fixed_amount"`
+	DiscountValue         money.Money `json:"discount_value" binding:"required,gt=0" swaggertype:"number"`
+	MaximumDiscountAmount money.Money `json:"maximum_discount_amount" binding:"required,gt=0" swaggertype:"number"`
+	MinimumOrderAmount    money.Money `json:"minimum_order_amount" binding:"required,gte=0" swaggertype:"number"`
+	Currency              string      `json:"currency" binding:"required"`
+	StartDate             time.Time   `json:"start_date" binding:"required"`
+	EndDate               time.Time   `json:"end_date" binding:"required"`
+	Scope                 string      `json:"scope" binding:"required,oneof=order product category"`
+	CategoryID            *int64      `json:"category_id" binding:"required_if=Scope category,omitempty,gte=1"`
+	CodePrefix            string      `json:"code_prefix" binding:"omitempty,max=12,alphanum,uppercase"`
+	TotalCodes            int64       `json:"total_codes" binding:"required,gt=0"`
+	// UserIDs assigns code i to user_ids[i], its length must be same as total_codes
+	UserIDs []int64 `json:"user_ids" binding:"omitempty,dive,gte=1"`
+}
+
+type CreateCouponCampaignResponse struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+type GetCouponCampaignsRequest struct {
+	Limit  int64   `form:"limit,default=10" binding:"omitempty,gte=1"`
+	Page   int64   `form:"page,default=1" binding:"omitempty,gte=1"`
+	Status *string `form:"status" binding:"omitempty,oneof=pending completed"`
+}
+
+type GetCouponCampaignsResponse struct {
+	ID                    string      `json:"id"`
+	Name                  string      `json:"name"`
+	DiscountType          string      `json:"discount_type"`
+	DiscountValue         money.Money `json:"discount_value" swaggertype:"number"`
+	MaximumDiscountAmount money.Money `json:"maximum_discount_amount" swaggertype:"number"`
+	MinimumOrderAmount    money.Money `json:"minimum_order_amount" swaggertype:"number"`
+	Currency              string      `json:"currency"`
+	StartDate             time.Time   `json:"start_date"`
+	EndDate               time.Time   `json:"end_date"`
+	Scope                 string      `json:"scope"`
+	CategoryID            *int64      `json:"category_id"`
+	CodePrefix            string      `json:"code_prefix"`
+	TotalCodes            int64       `json:"total_codes"`
+	GeneratedCodes        int64       `json:"generated_codes"`
+	UsedCodes             int64       `json:"used_codes"`
+	RevokedCodes          int64       `json:"revoked_codes"`
+	Status                string      `json:"status"`
+	CreatedAt             time.Time   `json:"created_at"`
+}
+
+type CouponCampaignURIRequest struct {
+	ID string `uri:"campaignID" binding:"required,uuid"`
+}
+
+type ExportCouponCampaignCodesResponse struct {
+	// DownloadURL is presigned url of csv file, it expires after 1 hour
+	DownloadURL string `json:"download_url"`
+}
+
+type RevokeCouponCampaignCodesRequest struct {
+	// CouponIDs are codes of campaign to revoke, every unused code of campaign is revoked when it is empty
+	CouponIDs []string `json:"coupon_ids" binding:"omitempty,dive,uuid"`
+}
+
+type RevokeCouponCampaignCodesResponse struct {
+	RevokedCodes int64 `json:"revoked_codes"`
+}
@@ -89,15 +89,20 @@ type UpdateCartItemResponseDocs = ResponseSuccessDocs[UpdateCartItemResponse]
 type DeleteCartItemResponseDocs = ResponseSuccessDocs[DeleteCartItemResponse]
 type AddItemToCartResponseDocs = ResponseSuccessDocs[AddItemToCartResponse]
 type GetCartItemsResponseDocs = ResponseSuccessDocs[GetCartItemsResponse]
+type AcknowledgeCartItemChangesResponseDocs = ResponseSuccessDocs[AcknowledgeCartItemChangesResponse]
 type GetCouponsResponseDocs = ResponseSuccessPaginationDocs[[]GetCouponsResponse]
 type CreateCouponResponseDocs = ResponseSuccessDocs[CreateCouponResponse]
 type GetDetailCouponResponseDocs = ResponseSuccessDocs[GetDetailCouponResponse]
 type UpdateCouponResponseDocs = ResponseSuccessDocs[UpdateCouponResponse]
 type DeleteCouponResponseDocs = ResponseSuccessDocs[DeleteCouponResponse]
+type CreateCouponCampaignResponseDocs = ResponseSuccessDocs[CreateCouponCampaignResponse]
+type GetCouponCampaignsResponseDocs = ResponseSuccessPaginationDocs[[]GetCouponCampaignsResponse]
+type ExportCouponCampaignCodesResponseDocs = ResponseSuccessDocs[ExportCouponCampaignCodesResponse]
+type RevokeCouponCampaignCodesResponseDocs = ResponseSuccessDocs[RevokeCouponCampaignCodesResponse]
 type GetPaymentMethodsResponseDocs = ResponseSuccessDocs[[]GetPaymentMethodsResponse]
 type CheckoutResponseDocs = ResponseSuccessDocs[CheckoutResponse]
+type QuoteCheckoutResponseDocs = ResponseSuccessDocs[QuoteCheckoutResponse]
 type GetMyOrdersResponseDocs = ResponseSuccessPaginationDocs[[]GetMyOrdersResponse]
-type UpdateOrderIPNMomoResponseDocs = ResponseSuccessDocs[UpdateOrderIPNMomoResponse]
 type RegisterSupplierResponseDocs = ResponseSuccessDocs[RegisterSupplierResponse]
 type GetPresignedURLResponseDocs = ResponseSuccessDocs[GetPresignedURLResponse]
 type GetSuppliersResponseDocs = ResponseSuccessPaginationDocs[[]GetSuppliersResponse]
@@ -108,3 +113,26 @@ type UpdateRoleForUserRegisterSupplierResponseDocs = ResponseSuccessDocs[UpdateR
 type RegisterDelivererResponseDocs = ResponseSuccessDocs[RegisterDelivererResponse]
 type GetSupplierOrdersResponseDocs = ResponseSuccessPaginationDocs[[]GetSupplierOrdersResponse]
 type UpdateOrderItemResponseDocs = ResponseSuccessDocs[UpdateOrderItemResponse]
+type CancelOrderItemResponseDocs = ResponseSuccessDocs[CancelOrderItemResponse]
+type RefundResponseDocs = ResponseSuccessDocs[RefundResponse]
+type GetRefundsResponseDocs = ResponseSuccessDocs[[]RefundResponse]
+type GetShippingRatesResponseDocs = ResponseSuccessDocs[[]ShippingRateResponse]
+type CreateShippingRateResponseDocs = ResponseSuccessDocs[CreateShippingRateResponse]
+type UpdateShippingRateResponseDocs = ResponseSuccessDocs[UpdateShippingRateResponse]
+type DeleteShippingRateResponseDocs = ResponseSuccessDocs[DeleteShippingRateResponse]
+type GetExchangeRatesResponseDocs = ResponseSuccessDocs[GetExchangeRatesResponse]
+type UpdateExchangeRatesResponseDocs = ResponseSuccessDocs[UpdateExchangeRatesResponse]
+type GetOrderItemTimelineResponseDocs = ResponseSuccessDocs[[]OrderItemTimelineResponse]
+type ReorderToCartResponseDocs = ResponseSuccessDocs[ReorderToCartResponse]
+type AddGuestCartItemResponseDocs = ResponseSuccessDocs[AddGuestCartItemResponse]
+type UpdateGuestCartItemResponseDocs = ResponseSuccessDocs[UpdateGuestCartItemResponse]
+type DeleteGuestCartItemsResponseDocs = ResponseSuccessDocs[DeleteGuestCartItemsResponse]
+type AddWishlistItemResponseDocs = ResponseSuccessDocs[AddWishlistItemResponse]
+type GetWishlistItemsResponseDocs = ResponseSuccessDocs[[]GetWishlistItemsResponse]
+type DeleteWishlistItemsResponseDocs = ResponseSuccessDocs[DeleteWishlistItemsResponse]
+type MoveWishlistItemToCartResponseDocs = ResponseSuccessDocs[MoveWishlistItemToCartResponse]
+type MoveCartItemToWishlistResponseDocs = ResponseSuccessDocs[MoveCartItemToWishlistResponse]
+type GetUserPaymentMethodsResponseDocs = ResponseSuccessDocs[[]UserPaymentMethodResponse]
+type CreateUserPaymentMethodResponseDocs = ResponseSuccessDocs[UserPaymentMethodResponse]
+type SetDefaultUserPaymentMethodResponseDocs = ResponseSuccessDocs[SetDefaultUserPaymentMethodResponse]
+type DeleteUserPaymentMethodResponseDocs = ResponseSuccessDocs[DeleteUserPaymentMethodResponse]
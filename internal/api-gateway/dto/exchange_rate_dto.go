@@ -0,0 +1,29 @@
+package api_gateway_dto
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"time"
+)
+
+type GetExchangeRatesResponse struct {
+	// BaseCurrency is currency of product prices, rates are amount of it for one unit of other currencies
+	BaseCurrency string                 `json:"base_currency"`
+	Rates        []ExchangeRateResponse `json:"rates"`
+}
+
+type ExchangeRateResponse struct {
+	Currency  string     `json:"currency"`
+	Rate      money.Rate `json:"rate" swaggertype:"number"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type UpdateExchangeRatesRequest struct {
+	Rates []ExchangeRateRequest `json:"rates" binding:"required,min=1,dive"`
+}
+
+type ExchangeRateRequest struct {
+	Currency string     `json:"currency" binding:"required,iso4217"`
+	Rate     money.Rate `json:"rate" swaggertype:"number"`
+}
+
+type UpdateExchangeRatesResponse struct{}
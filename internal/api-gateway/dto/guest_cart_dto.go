@@ -0,0 +1,26 @@
+package api_gateway_dto
+
+type AddGuestCartItemResponse struct {
+	// GuestCartToken identifies guest cart, it is also set into cookie guest_cart_token
+	GuestCartToken string `json:"guest_cart_token"`
+}
+
+type GuestCartItemURIRequest struct {
+	ProductVariantID string `uri:"productVariantID" binding:"required,uuid"`
+}
+
+type UpdateGuestCartItemRequest struct {
+	// Quantity 0 removes item from guest cart
+	Quantity int64 `json:"quantity" binding:"omitempty,gte=0"`
+}
+
+type UpdateGuestCartItemResponse struct {
+	ProductVariantID string `json:"product_variant_id"`
+	Quantity         int64  `json:"quantity"`
+}
+
+type DeleteGuestCartItemsRequest struct {
+	ProductVariantIDs []string `json:"product_variant_ids" binding:"required,min=1"`
+}
+
+type DeleteGuestCartItemsResponse struct{}
@@ -2,15 +2,28 @@ package api_gateway_dto
 
 import (
 	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
 	"time"
 )
 
 type CheckoutRequest struct {
 	Items           []CheckoutItemRequest `json:"items" binding:"required"`
-	MethodType      common.MethodType     `json:"method_type" binding:"required,oneof=momo cod"`
+	MethodType      common.MethodType     `json:"method_type" binding:"required,oneof=momo vnpay cod"`
 	ShippingAddress string                `json:"shipping_address" binding:"required"`
 	RecipientName   string                `json:"recipient_name" binding:"required"`
 	RecipientPhone  string                `json:"recipient_phone" binding:"required"`
+	// UserPaymentMethodID is saved payment method of buyer, it must be saved for method_type
+	UserPaymentMethodID *int64 `json:"user_payment_method_id" binding:"omitempty,gte=1"`
+	// OrderCouponID is coupon of whole order, coupon_id of item is only for coupon of product or category
+	OrderCouponID *string `json:"order_coupon_id" binding:"omitempty,uuid"`
+	// ShippingProvince, ShippingDistrict and ShippingWard are names of administrative divisions of shipping address,
+	// shipping fee is calculated from them
+	ShippingProvince string `json:"shipping_province" binding:"required"`
+	ShippingDistrict string `json:"shipping_district" binding:"required"`
+	ShippingWard     string `json:"shipping_ward" binding:"required"`
+	ClientIP         string `json:"-"`
+	// IdempotencyKey is from Idempotency-Key header, retry with same key returns response of first checkout
+	IdempotencyKey *string `json:"-"`
 }
 
 type CheckoutItemRequest struct {
@@ -21,7 +34,6 @@ type CheckoutItemRequest struct {
 	ProductVariantImageURL string    `json:"product_variant_image_url" binding:"required"`
 	Quantity               int64     `json:"quantity" binding:"required,gt=0"`
 	EstimatedDeliveryDate  time.Time `json:"estimated_delivery_date" binding:"required"`
-	ShippingFee            float64   `json:"shipping_fee" binding:"required,gt=0"`
 	CouponID               *string   `json:"coupon_id" binding:"omitempty"`
 }
 
@@ -37,20 +49,84 @@ type CheckoutResponse struct {
 	PaymentURL *string `json:"payment_url"`
 }
 
-type UpdateOrderIPNMomoRequest struct {
-	PartnerCode  string `json:"partnerCode" binding:"required"`
-	OrderID      string `json:"orderId" binding:"required"`
-	RequestID    string `json:"requestId" binding:"required"`
-	Amount       int64  `json:"amount" binding:"required"`
-	OrderInfo    string `json:"orderInfo"`
-	OrderType    string `json:"orderType"`
-	TransId      int64  `json:"transId" binding:"required"`
-	ResultCode   int64  `json:"resultCode" binding:"omitempty,gte=0"`
-	Message      string `json:"message" binding:"omitempty"`
-	PayType      string `json:"payType"`
-	ResponseTime int64  `json:"responseTime"`
-	ExtraData    string `json:"extraData" binding:"omitempty"`
-	Signature    string `json:"signature" binding:"required"`
+// QuoteCheckoutResponse is money of checkout which is calculated without creating order,
+// checkout can be placed when problems is empty
+type QuoteCheckoutResponse struct {
+	Items          []QuoteCheckoutItemResponse     `json:"items"`
+	Suppliers      []QuoteCheckoutSupplierResponse `json:"suppliers"`
+	SubTotal       money.Money                     `json:"sub_total" swaggertype:"number"`
+	DiscountAmount money.Money                     `json:"discount_amount" swaggertype:"number"`
+	TaxAmount      money.Money                     `json:"tax_amount" swaggertype:"number"`
+	ShippingFee    money.Money                     `json:"shipping_fee" swaggertype:"number"`
+	TotalAmount    money.Money                     `json:"total_amount" swaggertype:"number"`
+	// Currency is currency of payment method, amounts are converted into it with current exchange rates
+	Currency string `json:"currency"`
+	// PricesIncludeTax is true when tax_amount is already in sub_total
+	PricesIncludeTax bool                      `json:"prices_include_tax"`
+	Problems         []CheckoutProblemResponse `json:"problems"`
 }
 
-type UpdateOrderIPNMomoResponse struct{}
+type QuoteCheckoutItemResponse struct {
+	ProductVariantID string      `json:"product_variant_id"`
+	SupplierID       int64       `json:"supplier_id"`
+	Quantity         int64       `json:"quantity"`
+	UnitPrice        money.Money `json:"unit_price" swaggertype:"number"`
+	TotalPrice       money.Money `json:"total_price" swaggertype:"number"`
+	DiscountAmount   money.Money `json:"discount_amount" swaggertype:"number"`
+	TaxAmount        money.Money `json:"tax_amount" swaggertype:"number"`
+	ShippingFee      money.Money `json:"shipping_fee" swaggertype:"number"`
+	TotalAmount      money.Money `json:"total_amount" swaggertype:"number"`
+	CouponID         *string     `json:"coupon_id"`
+}
+
+type QuoteCheckoutSupplierResponse struct {
+	SupplierID     int64       `json:"supplier_id"`
+	SubTotal       money.Money `json:"sub_total" swaggertype:"number"`
+	DiscountAmount money.Money `json:"discount_amount" swaggertype:"number"`
+	TaxAmount      money.Money `json:"tax_amount" swaggertype:"number"`
+	ShippingFee    money.Money `json:"shipping_fee" swaggertype:"number"`
+	TotalAmount    money.Money `json:"total_amount" swaggertype:"number"`
+}
+
+// CheckoutProblemResponse is why checkout would fail, ex: out_of_stock, coupon_below_minimum
+type CheckoutProblemResponse struct {
+	Code             string  `json:"code"`
+	Message          string  `json:"message"`
+	ProductVariantID *string `json:"product_variant_id"`
+	CouponID         *string `json:"coupon_id"`
+}
+
+type HandlePaymentCallbackURIRequest struct {
+	MethodCode string `uri:"methodCode" binding:"required"`
+}
+
+// HandlePaymentCallbackResponse is acknowledgement which payment gateway of method expects for callback,
+// it is returned as it is. Body is empty when payment gateway only reads status code
+type HandlePaymentCallbackResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+type CreateRefundRequest struct {
+	PaymentHistoryID string      `json:"payment_history_id" binding:"required,uuid"`
+	Amount           money.Money `json:"amount" binding:"required,gt=0" swaggertype:"number"`
+}
+
+type GetRefundsRequest struct {
+	PaymentHistoryID string `form:"payment_history_id" binding:"required,uuid"`
+}
+
+type ProcessRefundURIRequest struct {
+	RefundID string `uri:"refundID" binding:"required,uuid"`
+}
+
+type RefundResponse struct {
+	ID               string      `json:"id"`
+	PaymentHistoryID string      `json:"payment_history_id"`
+	Amount           money.Money `json:"amount" swaggertype:"number"`
+	Status           string      `json:"status"`
+	TransactionID    *string     `json:"transaction_id"`
+	ProcessedBy      int64       `json:"processed_by"`
+	RefundedAt       *time.Time  `json:"refunded_at"`
+}
@@ -1,6 +1,9 @@
 package api_gateway_dto
 
-import "time"
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"time"
+)
 
 type GetProductsRequest struct {
 	Limit       int64   `form:"limit,default=20" binding:"omitempty,gte=1"`
@@ -8,19 +11,26 @@ type GetProductsRequest struct {
 	Keyword     *string `form:"keyword" binding:"omitempty"`
 	CategoryIDs []int64 `form:"category_ids" binding:"omitempty"`
 	MinRating   *int64  `form:"min_rating" binding:"omitempty,gte=1"`
+	// Currency is currency of prices in response, prices are converted with exchange rates, default is VND
+	Currency string `form:"currency" binding:"omitempty,iso4217"`
+}
+
+type DisplayCurrencyRequest struct {
+	// Currency is currency of prices in response, prices are converted with exchange rates, default is VND
+	Currency string `form:"currency" binding:"omitempty,iso4217"`
 }
 
 type GetProductsResponse struct {
-	ProductID            string  `json:"product_id"`
-	ProductName          string  `json:"product_name"`
-	ProductThumbnail     string  `json:"product_thumbnail"`
-	ProductAverageRating float32 `json:"product_average_rating"`
-	ProductTotalReviews  int64   `json:"product_total_reviews"`
-	ProductCategoryID    int64   `json:"product_category_id"`
-	ProductPrice         float64 `json:"product_price"`
-	ProductDiscountPrice float64 `json:"product_discount_price"`
-	ProductCurrency      string  `json:"product_currency"`
-	CategoryName         string  `json:"category_name"`
+	ProductID            string      `json:"product_id"`
+	ProductName          string      `json:"product_name"`
+	ProductThumbnail     string      `json:"product_thumbnail"`
+	ProductAverageRating float32     `json:"product_average_rating"`
+	ProductTotalReviews  int64       `json:"product_total_reviews"`
+	ProductCategoryID    int64       `json:"product_category_id"`
+	ProductPrice         money.Money `json:"product_price" swaggertype:"number"`
+	ProductDiscountPrice money.Money `json:"product_discount_price" swaggertype:"number"`
+	ProductCurrency      string      `json:"product_currency"`
+	CategoryName         string      `json:"category_name"`
 }
 
 type GetProductDetailRequest struct {
@@ -56,8 +66,8 @@ type GetProductDetailVariantResponse struct {
 	ProductVariantID string                 `json:"product_variant_id"`
 	SKU              string                 `json:"sku"`
 	VariantName      string                 `json:"variant_name"`
-	Price            float64                `json:"price"`
-	DiscountPrice    float64                `json:"discount_price"`
+	Price            money.Money            `json:"price" swaggertype:"number"`
+	DiscountPrice    money.Money            `json:"discount_price" swaggertype:"number"`
 	Quantity         int64                  `json:"quantity"`
 	IsDefault        bool                   `json:"is_default"`
 	ShippingClass    string                 `json:"shipping_class"`
@@ -0,0 +1,49 @@
+package api_gateway_dto
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"time"
+)
+
+type GetShippingRatesRequest struct {
+	ShippingClass *string `form:"shipping_class" binding:"omitempty"`
+}
+
+type ShippingRateResponse struct {
+	ID              int64       `json:"id"`
+	ShippingClass   string      `json:"shipping_class"`
+	Zone            string      `json:"zone"`
+	BaseFee         money.Money `json:"base_fee" swaggertype:"number"`
+	BaseWeightGrams int64       `json:"base_weight_grams"`
+	FeePerExtraKg   money.Money `json:"fee_per_extra_kg" swaggertype:"number"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+}
+
+type CreateShippingRateRequest struct {
+	// ShippingClass is same as shipping_class of product variants, rates of 'default' are used for classes without rates
+	ShippingClass string `json:"shipping_class" binding:"required"`
+	// Zone is intra_district (same district), intra_province (same province) or inter_province
+	Zone            string      `json:"zone" binding:"required,oneof=intra_district intra_province inter_province"`
+	BaseFee         money.Money `json:"base_fee" binding:"gte=0" swaggertype:"number"`
+	BaseWeightGrams int64       `json:"base_weight_grams" binding:"gte=0"`
+	FeePerExtraKg   money.Money `json:"fee_per_extra_kg" binding:"gte=0" swaggertype:"number"`
+}
+
+type CreateShippingRateResponse struct {
+	ID int64 `json:"id"`
+}
+
+type ShippingRateURIRequest struct {
+	ID int64 `uri:"shippingRateID" binding:"required,gte=1"`
+}
+
+type UpdateShippingRateRequest struct {
+	BaseFee         money.Money `json:"base_fee" binding:"gte=0" swaggertype:"number"`
+	BaseWeightGrams int64       `json:"base_weight_grams" binding:"gte=0"`
+	FeePerExtraKg   money.Money `json:"fee_per_extra_kg" binding:"gte=0" swaggertype:"number"`
+}
+
+type UpdateShippingRateResponse struct{}
+
+type DeleteShippingRateResponse struct{}
@@ -2,6 +2,7 @@ package api_gateway_dto
 
 import (
 	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
 	"time"
 )
 
@@ -121,11 +122,12 @@ type GetSupplierOrdersResponse struct {
 	ProductName             string             `json:"product_name"`
 	ProductVariantName      string             `json:"product_variant_name"`
 	Quantity                int64              `json:"quantity"`
-	UnitPrice               float64            `json:"unit_price"`
-	TotalPrice              float64            `json:"total_price"`     // money need to be paid
-	DiscountAmount          float64            `json:"discount_amount"` // discount amount
-	TaxAmount               float64            `json:"tax_amount"`
-	ShippingFee             float64            `json:"shipping_fee"`
+	UnitPrice               money.Money        `json:"unit_price" swaggertype:"number"`
+	TotalPrice              money.Money        `json:"total_price" swaggertype:"number"`     // money need to be paid
+	DiscountAmount          money.Money        `json:"discount_amount" swaggertype:"number"` // discount amount
+	TaxAmount               money.Money        `json:"tax_amount" swaggertype:"number"`
+	ShippingFee             money.Money        `json:"shipping_fee" swaggertype:"number"`
+	Currency                string             `json:"currency"`
 	Status                  common.StatusOrder `json:"status"`
 
 	// Used for detail when click into one order item
@@ -143,7 +145,8 @@ type GetSupplierOrdersResponse struct {
 }
 
 type UpdateOrderItemRequest struct {
-	Status common.StatusOrder `json:"status" binding:"omitempty,enum,oneof=confirmed cancelled processing"`
+	Status common.StatusOrder `json:"status" binding:"omitempty,enum,oneof=confirmed cancelled processing ready_to_ship"`
+	Notes  *string            `json:"notes" binding:"omitempty"`
 }
 
 type UpdateOrderItemUriRequest struct {
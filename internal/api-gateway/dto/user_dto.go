@@ -2,6 +2,7 @@ package api_gateway_dto
 
 import (
 	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
 	"time"
 )
 
@@ -214,16 +215,36 @@ type UpdateCartItemResponse struct {
 }
 
 type GetCartItemsResponse struct {
-	CartItemID              string  `json:"cart_item_id"`
-	ProductName             string  `json:"product_name"`
-	Quantity                int64   `json:"quantity"`
-	Price                   float64 `json:"price"`
-	DiscountPrice           float64 `json:"discount_price"`
-	ProductID               string  `json:"product_id"`
-	ProductVariantID        string  `json:"product_variant_id"`
-	ProductVariantThumbnail string  `json:"product_variant_thumbnail"`
-	Currency                string  `json:"currency"`
-	VariantName             string  `json:"variant_name"`
+	CartItemID              string      `json:"cart_item_id"`
+	ProductName             string      `json:"product_name"`
+	Quantity                int64       `json:"quantity"`
+	Price                   money.Money `json:"price" swaggertype:"number"`
+	DiscountPrice           money.Money `json:"discount_price" swaggertype:"number"`
+	ProductID               string      `json:"product_id"`
+	ProductVariantID        string      `json:"product_variant_id"`
+	ProductVariantThumbnail string      `json:"product_variant_thumbnail"`
+	Currency                string      `json:"currency"`
+	VariantName             string      `json:"variant_name"`
+	// Warnings are changes of item since buyer last saw it
+	Warnings []CartItemWarningResponse `json:"warnings"`
+}
+
+type CartItemWarningResponse struct {
+	Type common.CartWarningType `json:"type"`
+	// MaxQuantity is quantity which can be bought, it is set for insufficient_stock
+	MaxQuantity *int64 `json:"max_quantity,omitempty"`
+	// OldPrice is last price seen by buyer and NewPrice is current price, they are set for price_changed
+	OldPrice *money.Money `json:"old_price,omitempty" swaggertype:"number"`
+	NewPrice *money.Money `json:"new_price,omitempty" swaggertype:"number"`
+	Currency string       `json:"currency,omitempty"`
+}
+
+type AcknowledgeCartItemChangesRequest struct {
+	CartItemIDs []string `json:"cart_item_ids" binding:"required,min=1"`
+}
+
+type AcknowledgeCartItemChangesResponse struct {
+	CartItemIDs []string `json:"cart_item_ids"`
 }
 
 type GetMyOrdersRequest struct {
@@ -246,11 +267,12 @@ type GetMyOrdersResponse struct {
 	ProductName             string             `json:"product_name"`
 	ProductVariantName      string             `json:"product_variant_name"`
 	Quantity                int64              `json:"quantity"`
-	UnitPrice               float64            `json:"unit_price"`
-	TotalPrice              float64            `json:"total_price"`     // money need to be paid
-	DiscountAmount          float64            `json:"discount_amount"` // discount amount
-	TaxAmount               float64            `json:"tax_amount"`
-	ShippingFee             float64            `json:"shipping_fee"`
+	UnitPrice               money.Money        `json:"unit_price" swaggertype:"number"`
+	TotalPrice              money.Money        `json:"total_price" swaggertype:"number"`     // money need to be paid
+	DiscountAmount          money.Money        `json:"discount_amount" swaggertype:"number"` // discount amount
+	TaxAmount               money.Money        `json:"tax_amount" swaggertype:"number"`
+	ShippingFee             money.Money        `json:"shipping_fee" swaggertype:"number"`
+	Currency                string             `json:"currency"`
 	Status                  common.StatusOrder `json:"status"`
 
 	// Used for detail when click into one order item
@@ -266,3 +288,134 @@ type GetMyOrdersResponse struct {
 
 	OrderItemID string `json:"order_item_id"`
 }
+
+type CancelOrderItemURIRequest struct {
+	OrderItemID string `uri:"orderItemID" binding:"required"`
+}
+
+type CancelOrderItemRequest struct {
+	CancelledReason string `json:"cancelled_reason" binding:"required"`
+}
+
+type CancelOrderItemResponse struct{}
+
+type GetOrderItemTimelineURIRequest struct {
+	OrderItemID string `uri:"orderItemID" binding:"required,uuid"`
+}
+
+type ReorderToCartURIRequest struct {
+	// OrderID is bound from orderItemID because gin allows one wildcard name at same segment of path
+	OrderID string `uri:"orderItemID" binding:"required,uuid"`
+}
+
+type ReorderToCartResponse struct {
+	AddedItems []ReorderItemResponse `json:"added_items"`
+	// UnavailableItems are not sold anymore or have not enough inventory, they are not added to cart
+	UnavailableItems []ReorderItemResponse `json:"unavailable_items"`
+	// PriceChangedItems are added items whose current price is different from price in order
+	PriceChangedItems []ReorderItemResponse `json:"price_changed_items"`
+}
+
+type ReorderItemResponse struct {
+	ProductID          string       `json:"product_id"`
+	ProductVariantID   string       `json:"product_variant_id"`
+	ProductName        string       `json:"product_name"`
+	ProductVariantName string       `json:"product_variant_name"`
+	Quantity           int64        `json:"quantity"`
+	Reason             *string      `json:"reason,omitempty"`
+	Currency           string       `json:"currency"`
+	OldUnitPrice       money.Money  `json:"old_unit_price" swaggertype:"number"`
+	NewUnitPrice       *money.Money `json:"new_unit_price,omitempty" swaggertype:"number"`
+}
+
+type OrderItemTimelineResponse struct {
+	Status    string    `json:"status"`
+	Actor     string    `json:"actor"`
+	Notes     *string   `json:"notes"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type UserPaymentMethodResponse struct {
+	ID                int64     `json:"id"`
+	PaymentMethodCode string    `json:"payment_method_code"`
+	PaymentMethodName string    `json:"payment_method_name"`
+	CardHolderName    string    `json:"card_holder_name"`
+	CardLast4         string    `json:"card_last4"`
+	CardBrand         string    `json:"card_brand"`
+	CardExpiryMonth   int64     `json:"card_expiry_month"`
+	CardExpiryYear    int64     `json:"card_expiry_year"`
+	IsDefault         bool      `json:"is_default"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// CreateUserPaymentMethodRequest needs provider_token (with card_last4) or card_number,
+// card number is encrypted by order service and never returned
+type CreateUserPaymentMethodRequest struct {
+	PaymentMethodCode string  `json:"payment_method_code" binding:"required,oneof=momo vnpay"`
+	CardHolderName    string  `json:"card_holder_name" binding:"required"`
+	ProviderToken     *string `json:"provider_token" binding:"required_without=CardNumber,omitempty"`
+	CardNumber        *string `json:"card_number" binding:"required_without=ProviderToken,omitempty,min=12,max=23"`
+	CardLast4         *string `json:"card_last4" binding:"required_with=ProviderToken,omitempty,len=4,numeric"`
+	CardBrand         *string `json:"card_brand" binding:"omitempty"`
+	CardExpiryMonth   int64   `json:"card_expiry_month" binding:"required,gte=1,lte=12"`
+	CardExpiryYear    int64   `json:"card_expiry_year" binding:"required,gte=2000"`
+	IsDefault         bool    `json:"is_default"`
+}
+
+type UserPaymentMethodURIRequest struct {
+	UserPaymentMethodID int64 `uri:"userPaymentMethodID" binding:"required,gte=1"`
+}
+
+type SetDefaultUserPaymentMethodResponse struct{}
+
+type DeleteUserPaymentMethodResponse struct{}
+
+type AddWishlistItemRequest struct {
+	ProductID        string `json:"product_id" binding:"required,uuid"`
+	ProductVariantID string `json:"product_variant_id" binding:"required,uuid"`
+}
+
+type AddWishlistItemResponse struct {
+	WishlistItemID string `json:"wishlist_item_id"`
+}
+
+type GetWishlistItemsResponse struct {
+	WishlistItemID          string `json:"wishlist_item_id"`
+	ProductID               string `json:"product_id"`
+	ProductVariantID        string `json:"product_variant_id"`
+	ProductName             string `json:"product_name"`
+	VariantName             string `json:"variant_name"`
+	ProductVariantThumbnail string `json:"product_variant_thumbnail"`
+	// SavedPrice is price when item is saved, prices are in currency
+	SavedPrice    money.Money  `json:"saved_price" swaggertype:"number"`
+	Price         *money.Money `json:"price,omitempty" swaggertype:"number"`
+	DiscountPrice *money.Money `json:"discount_price,omitempty" swaggertype:"number"`
+	Currency      string       `json:"currency"`
+	// PriceDropped is true when current price is lower than saved price
+	PriceDropped bool `json:"price_dropped"`
+	// Available is false when product is not sold anymore, current prices are not set then
+	Available bool      `json:"available"`
+	AddedAt   time.Time `json:"added_at"`
+}
+
+type DeleteWishlistItemsRequest struct {
+	WishlistItemIDs []string `json:"wishlist_item_ids" binding:"required,min=1,dive,uuid"`
+}
+
+type DeleteWishlistItemsResponse struct {
+	WishlistItemIDs []string `json:"wishlist_item_ids"`
+}
+
+type WishlistItemURIRequest struct {
+	WishlistItemID string `uri:"wishlistItemID" binding:"required,uuid"`
+}
+
+type MoveWishlistItemToCartRequest struct {
+	Quantity int64 `json:"quantity" binding:"required,gte=1"`
+}
+
+type MoveWishlistItemToCartResponse struct{}
+
+type MoveCartItemToWishlistResponse struct {
+	WishlistItemID string `json:"wishlist_item_id"`
+}
@@ -57,6 +57,7 @@ func (h *authenticationHandler) Register(ctx *gin.Context) {
 	}
 
 	// chi handle business error hoac technical error
+	// guest cart token is kept, guest cart is merged by first login after email is verified
 	res, err := h.service.Register(c, data)
 
 	if err != nil {
@@ -94,13 +95,19 @@ func (h *authenticationHandler) Login(ctx *gin.Context) {
 	}
 
 	// chi handle business error hoac technical error
-	res, err := h.service.Login(c, data)
+	guestCartToken := getGuestCartToken(ctx)
+	res, guestCartMerged, err := h.service.Login(c, data, guestCartToken)
 
 	if err != nil {
 		utils.HandleErrorResponse(ctx, err)
 		return
 	}
 
+	// failed merge keeps guest cart, so it is merged by next login
+	if guestCartMerged {
+		clearGuestCartToken(ctx)
+	}
+
 	utils.SuccessResponse[api_gateway_dto.LoginResponse](ctx, http.StatusOK, *res)
 }
 
@@ -472,12 +479,18 @@ func (h *authenticationHandler) ExchangeOAuthCode(ctx *gin.Context) {
 		return
 	}
 
-	res, err := h.service.ExchangeOAuthCode(c, data)
+	guestCartToken := getGuestCartToken(ctx)
+	res, guestCartMerged, err := h.service.ExchangeOAuthCode(c, data, guestCartToken)
 
 	if err != nil {
 		utils.HandleErrorResponse(ctx, err)
 		return
 	}
 
+	// failed merge keeps guest cart, so it is merged by next login
+	if guestCartMerged {
+		clearGuestCartToken(ctx)
+	}
+
 	utils.SuccessResponse[api_gateway_dto.ExchangeOauthCodeResponse](ctx, http.StatusOK, *res)
 }
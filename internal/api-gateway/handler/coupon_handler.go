@@ -94,7 +94,10 @@ func (couponHandler *couponHandler) GetCouponByClient(ctx *gin.Context) {
 		return
 	}
 
-	res, totalItems, totalPages, hasNext, hasPrevious, err := couponHandler.couponService.GetCouponByClient(ct, &query)
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	res, totalItems, totalPages, hasNext, hasPrevious, err := couponHandler.couponService.GetCouponByClient(ct, &query, userClaims.UserID)
 
 	if err != nil {
 		span.RecordError(err)
@@ -268,3 +271,184 @@ func (couponHandler *couponHandler) DeleteCouponByID(ctx *gin.Context) {
 
 	utils.SuccessResponse(ctx, http.StatusOK, api_gateway_dto.DeleteCouponResponse{})
 }
+
+// CreateCouponCampaign godoc
+//
+//	@Summary		Create coupon campaign
+//	@Description	Create campaign of single-use codes, codes are generated in background
+//	@Tags			coupon-campaigns
+//	@Accept			json
+//
+//	@Security		BearerAuth
+//
+//	@Param			data	body	api_gateway_dto.CreateCouponCampaignRequest	true	"data"
+//
+//	@Produce		json
+//	@Success		200	{object}	api_gateway_dto.CreateCouponCampaignResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/coupon-campaigns [post]
+func (couponHandler *couponHandler) CreateCouponCampaign(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := couponHandler.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "CreateCouponCampaign"))
+	defer span.End()
+
+	var data api_gateway_dto.CreateCouponCampaignRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	res, err := couponHandler.couponService.CreateCouponCampaign(ct, &data, userClaims.UserID)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, res)
+}
+
+// GetCouponCampaigns godoc
+//
+//	@Summary		Get coupon campaigns
+//	@Description	Get coupon campaigns with generating progress and usage of codes
+//	@Tags			coupon-campaigns
+//	@Accept			json
+//
+//	@Security		BearerAuth
+//
+//	@Param			data	query	api_gateway_dto.GetCouponCampaignsRequest	false	"info query"
+//
+//	@Produce		json
+//	@Success		200	{object}	api_gateway_dto.GetCouponCampaignsResponseDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/coupon-campaigns [get]
+func (couponHandler *couponHandler) GetCouponCampaigns(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := couponHandler.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "GetCouponCampaigns"))
+	defer span.End()
+
+	var query api_gateway_dto.GetCouponCampaignsRequest
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, totalItems, totalPages, hasNext, hasPrevious, err := couponHandler.couponService.GetCouponCampaigns(ct, &query)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.PaginatedResponse(ctx, res, int(query.Page), int(query.Limit), totalPages, totalItems, hasNext, hasPrevious)
+}
+
+// ExportCouponCampaignCodes godoc
+//
+//	@Summary		Export codes of coupon campaign
+//	@Description	Export codes of completed coupon campaign into csv file and return download url
+//	@Tags			coupon-campaigns
+//	@Accept			json
+//
+//	@Security		BearerAuth
+//
+//	@Param			data	path	api_gateway_dto.CouponCampaignURIRequest	true	"campaign id"
+//
+//	@Produce		json
+//	@Success		200	{object}	api_gateway_dto.ExportCouponCampaignCodesResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/coupon-campaigns/{campaignID}/export [post]
+func (couponHandler *couponHandler) ExportCouponCampaignCodes(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := couponHandler.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "ExportCouponCampaignCodes"))
+	defer span.End()
+
+	var uri api_gateway_dto.CouponCampaignURIRequest
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := couponHandler.couponService.ExportCouponCampaignCodes(ct, uri.ID)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, res)
+}
+
+// RevokeCouponCampaignCodes godoc
+//
+//	@Summary		Revoke codes of coupon campaign
+//	@Description	Revoke unused codes of coupon campaign, every unused code is revoked when coupon_ids is empty
+//	@Tags			coupon-campaigns
+//	@Accept			json
+//
+//	@Security		BearerAuth
+//
+//	@Param			campaign	path	api_gateway_dto.CouponCampaignURIRequest			true	"campaign id"
+//	@Param			data		body	api_gateway_dto.RevokeCouponCampaignCodesRequest	true	"data"
+//
+//	@Produce		json
+//	@Success		200	{object}	api_gateway_dto.RevokeCouponCampaignCodesResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/coupon-campaigns/{campaignID}/revoke [post]
+func (couponHandler *couponHandler) RevokeCouponCampaignCodes(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := couponHandler.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "RevokeCouponCampaignCodes"))
+	defer span.End()
+
+	var uri api_gateway_dto.CouponCampaignURIRequest
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	var data api_gateway_dto.RevokeCouponCampaignCodesRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := couponHandler.couponService.RevokeCouponCampaignCodes(ct, uri.ID, &data)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, res)
+}
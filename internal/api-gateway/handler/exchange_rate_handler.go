@@ -0,0 +1,95 @@
+package api_gateway_handler
+
+import (
+	"context"
+	api_gateway_dto "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/dto"
+	api_gateway_service "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/service"
+	"github.com/TienMinh25/ecommerce-platform/internal/utils"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type exchangeRateHandler struct {
+	tracer              pkg.Tracer
+	exchangeRateService api_gateway_service.IExchangeRateService
+}
+
+func NewExchangeRateHandler(tracer pkg.Tracer, exchangeRateService api_gateway_service.IExchangeRateService) IExchangeRateHandler {
+	return &exchangeRateHandler{
+		tracer:              tracer,
+		exchangeRateService: exchangeRateService,
+	}
+}
+
+// GetExchangeRates godoc
+//
+//	@Summary		Get exchange rates
+//	@Description	Get exchange rates to base currency, rate is amount of base currency for one unit of currency
+//	@Tags			exchange-rates
+//	@Accept			json
+//
+//	@Security		BearerAuth
+//
+//	@Produce		json
+//	@Success		200	{object}	api_gateway_dto.GetExchangeRatesResponseDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/exchange-rates [get]
+func (h *exchangeRateHandler) GetExchangeRates(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := h.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "GetExchangeRates"))
+	defer span.End()
+
+	res, err := h.exchangeRateService.GetExchangeRates(ct)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, res)
+}
+
+// UpdateExchangeRates godoc
+//
+//	@Summary		Update exchange rates
+//	@Description	Create or update rates of currencies, rates of currencies not in request are kept
+//	@Tags			exchange-rates
+//	@Accept			json
+//
+//	@Security		BearerAuth
+//
+//	@Param			data	body	api_gateway_dto.UpdateExchangeRatesRequest	true	"data"
+//
+//	@Produce		json
+//	@Success		200	{object}	api_gateway_dto.UpdateExchangeRatesResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/exchange-rates [put]
+func (h *exchangeRateHandler) UpdateExchangeRates(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := h.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "UpdateExchangeRates"))
+	defer span.End()
+
+	var data api_gateway_dto.UpdateExchangeRatesRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	if err := h.exchangeRateService.UpdateExchangeRates(ct, &data); err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, api_gateway_dto.UpdateExchangeRatesResponse{})
+}
@@ -0,0 +1,211 @@
+package api_gateway_handler
+
+import (
+	api_gateway_dto "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/dto"
+	api_gateway_service "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/service"
+	"github.com/TienMinh25/ecommerce-platform/internal/env"
+	"github.com/TienMinh25/ecommerce-platform/internal/utils"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+const (
+	guestCartTokenHeader = "X-Guest-Cart-Token"
+	guestCartTokenCookie = "guest_cart_token"
+)
+
+type guestCartHandler struct {
+	tracer           pkg.Tracer
+	guestCartService api_gateway_service.IGuestCartService
+	env              *env.EnvManager
+}
+
+func NewGuestCartHandler(tracer pkg.Tracer, guestCartService api_gateway_service.IGuestCartService, env *env.EnvManager) IGuestCartHandler {
+	return &guestCartHandler{
+		tracer:           tracer,
+		guestCartService: guestCartService,
+		env:              env,
+	}
+}
+
+// GetGuestCartItems godoc
+//
+//	@Summary		Get guest cart items
+//	@Tags			guest-carts
+//	@Description	Get items of guest cart, token is read from header X-Guest-Cart-Token or cookie guest_cart_token
+//	@Accept			json
+//	@Produce		json
+//
+//	@Param			X-Guest-Cart-Token	header		string									false	"guest cart token"
+//	@Param			data				query		api_gateway_dto.DisplayCurrencyRequest	false	"display currency"
+//
+//	@Success		200					{object}	api_gateway_dto.GetCartItemsResponseDocs
+//	@Failure		400					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/guest-carts [get]
+func (h *guestCartHandler) GetGuestCartItems(ctx *gin.Context) {
+	c, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "GetGuestCartItems"))
+	defer span.End()
+
+	var query api_gateway_dto.DisplayCurrencyRequest
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := h.guestCartService.GetItems(c, getGuestCartToken(ctx), query.Currency)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, res)
+}
+
+// AddGuestCartItem godoc
+//
+//	@Summary		Add item to guest cart
+//	@Tags			guest-carts
+//	@Description	Add item to guest cart, new guest cart is started when token is missing or invalid, token is returned and set into cookie
+//	@Accept			json
+//	@Produce		json
+//
+//	@Param			X-Guest-Cart-Token	header		string									false	"guest cart token"
+//	@Param			req					body		api_gateway_dto.AddItemToCartRequest	true	"info cart item"
+//
+//	@Success		200					{object}	api_gateway_dto.AddGuestCartItemResponseDocs
+//	@Failure		400					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/guest-carts/items [post]
+func (h *guestCartHandler) AddGuestCartItem(ctx *gin.Context) {
+	c, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "AddGuestCartItem"))
+	defer span.End()
+
+	var data api_gateway_dto.AddItemToCartRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	token, err := h.guestCartService.AddItem(c, getGuestCartToken(ctx), data)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	ctx.SetCookie(guestCartTokenCookie, token, h.env.GuestCartTTL*24*60*60, "/", "", false, true)
+
+	utils.SuccessResponse(ctx, http.StatusOK, api_gateway_dto.AddGuestCartItemResponse{
+		GuestCartToken: token,
+	})
+}
+
+// UpdateGuestCartItem godoc
+//
+//	@Summary		Update guest cart item
+//	@Tags			guest-carts
+//	@Description	Update quantity of guest cart item, quantity 0 removes item
+//	@Accept			json
+//	@Produce		json
+//
+//	@Param			X-Guest-Cart-Token	header		string										false	"guest cart token"
+//	@Param			req1				path		api_gateway_dto.GuestCartItemURIRequest		true	"product variant id"
+//	@Param			req2				body		api_gateway_dto.UpdateGuestCartItemRequest	true	"quantity"
+//
+//	@Success		200					{object}	api_gateway_dto.UpdateGuestCartItemResponseDocs
+//	@Failure		400					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/guest-carts/items/{productVariantID} [patch]
+func (h *guestCartHandler) UpdateGuestCartItem(ctx *gin.Context) {
+	c, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "UpdateGuestCartItem"))
+	defer span.End()
+
+	var data api_gateway_dto.UpdateGuestCartItemRequest
+	var uri api_gateway_dto.GuestCartItemURIRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := h.guestCartService.UpdateItem(c, getGuestCartToken(ctx), uri.ProductVariantID, data.Quantity)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, *res)
+}
+
+// DeleteGuestCartItems godoc
+//
+//	@Summary		Delete guest cart items
+//	@Tags			guest-carts
+//	@Description	Delete items of guest cart by product variant ids
+//	@Accept			json
+//	@Produce		json
+//
+//	@Param			X-Guest-Cart-Token	header		string										false	"guest cart token"
+//	@Param			req					body		api_gateway_dto.DeleteGuestCartItemsRequest	true	"product variant ids to delete"
+//
+//	@Success		200					{object}	api_gateway_dto.DeleteGuestCartItemsResponseDocs
+//	@Failure		400					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/guest-carts/items [delete]
+func (h *guestCartHandler) DeleteGuestCartItems(ctx *gin.Context) {
+	c, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "DeleteGuestCartItems"))
+	defer span.End()
+
+	var data api_gateway_dto.DeleteGuestCartItemsRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	if err := h.guestCartService.RemoveItems(c, getGuestCartToken(ctx), data.ProductVariantIDs); err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, api_gateway_dto.DeleteGuestCartItemsResponse{})
+}
+
+// getGuestCartToken reads guest cart token from header, then from cookie
+func getGuestCartToken(ctx *gin.Context) string {
+	if token := ctx.GetHeader(guestCartTokenHeader); token != "" {
+		return token
+	}
+
+	token, _ := ctx.Cookie(guestCartTokenCookie)
+
+	return token
+}
+
+// clearGuestCartToken removes cookie of guest cart after it is merged into cart of user
+func clearGuestCartToken(ctx *gin.Context) {
+	ctx.SetCookie(guestCartTokenCookie, "", -1, "/", "", false, true)
+}
@@ -83,9 +83,26 @@ type IUserHandler interface {
 	AddCartItem(ctx *gin.Context)
 	DeleteCartItems(ctx *gin.Context)
 	UpdateCartItem(ctx *gin.Context)
+	AcknowledgeCartItemChanges(ctx *gin.Context)
 
 	// manage my orders
 	GetMyOrders(ctx *gin.Context)
+	CancelOrderItem(ctx *gin.Context)
+	GetOrderItemTimeline(ctx *gin.Context)
+	ReorderToCart(ctx *gin.Context)
+
+	// manage wishlist
+	GetWishlistItems(ctx *gin.Context)
+	AddWishlistItem(ctx *gin.Context)
+	DeleteWishlistItems(ctx *gin.Context)
+	MoveWishlistItemToCart(ctx *gin.Context)
+	MoveCartItemToWishlist(ctx *gin.Context)
+
+	// manage saved payment methods
+	GetUserPaymentMethods(ctx *gin.Context)
+	CreateUserPaymentMethod(ctx *gin.Context)
+	SetDefaultUserPaymentMethod(ctx *gin.Context)
+	DeleteUserPaymentMethod(ctx *gin.Context)
 }
 
 type IAdministrativeDivisionHandler interface {
@@ -111,12 +128,41 @@ type ICouponHandler interface {
 	GetDetailCouponByID(ctx *gin.Context)
 	UpdateCoupon(ctx *gin.Context)
 	DeleteCouponByID(ctx *gin.Context)
+	CreateCouponCampaign(ctx *gin.Context)
+	GetCouponCampaigns(ctx *gin.Context)
+	ExportCouponCampaignCodes(ctx *gin.Context)
+	RevokeCouponCampaignCodes(ctx *gin.Context)
+}
+
+type IShippingRateHandler interface {
+	GetShippingRates(ctx *gin.Context)
+	CreateShippingRate(ctx *gin.Context)
+	UpdateShippingRate(ctx *gin.Context)
+	DeleteShippingRate(ctx *gin.Context)
+}
+
+type IGuestCartHandler interface {
+	GetGuestCartItems(ctx *gin.Context)
+	AddGuestCartItem(ctx *gin.Context)
+	UpdateGuestCartItem(ctx *gin.Context)
+	DeleteGuestCartItems(ctx *gin.Context)
+}
+
+type IExchangeRateHandler interface {
+	GetExchangeRates(ctx *gin.Context)
+	UpdateExchangeRates(ctx *gin.Context)
 }
 
 type IPaymentHandler interface {
 	GetPaymentMethods(ctx *gin.Context)
 	Checkout(ctx *gin.Context)
-	UpdateOrderIPNMomo(ctx *gin.Context)
+	QuoteCheckout(ctx *gin.Context)
+	HandlePaymentCallback(ctx *gin.Context)
+
+	// refunds
+	CreateRefund(ctx *gin.Context)
+	ProcessRefund(ctx *gin.Context)
+	GetRefunds(ctx *gin.Context)
 }
 
 type ISupplierHandler interface {
@@ -130,6 +176,7 @@ type ISupplierHandler interface {
 	// supplier management
 	GetSupplierOrders(ctx *gin.Context)
 	UpdateOrderItem(ctx *gin.Context)
+	GetOrderItemTimeline(ctx *gin.Context)
 }
 
 type IS3Handler interface {
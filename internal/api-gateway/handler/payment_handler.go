@@ -2,34 +2,25 @@ package api_gateway_handler
 
 import (
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
-	"fmt"
 	api_gateway_dto "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/dto"
 	api_gateway_service "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/service"
-	"github.com/TienMinh25/ecommerce-platform/internal/env"
 	"github.com/TienMinh25/ecommerce-platform/internal/utils"
 	"github.com/TienMinh25/ecommerce-platform/internal/utils/errorcode"
 	"github.com/TienMinh25/ecommerce-platform/pkg"
 	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
 	"github.com/gin-gonic/gin"
-	"github.com/pkg/errors"
 	"net/http"
 )
 
 type paymentHandler struct {
 	tracer         pkg.Tracer
 	paymentService api_gateway_service.IPaymentService
-	envManager     *env.EnvManager
 }
 
-func NewPaymentHandler(tracer pkg.Tracer, paymentService api_gateway_service.IPaymentService,
-	envManager *env.EnvManager) IPaymentHandler {
+func NewPaymentHandler(tracer pkg.Tracer, paymentService api_gateway_service.IPaymentService) IPaymentHandler {
 	return &paymentHandler{
 		tracer:         tracer,
 		paymentService: paymentService,
-		envManager:     envManager,
 	}
 }
 
@@ -67,16 +58,20 @@ func (p *paymentHandler) GetPaymentMethods(ctx *gin.Context) {
 // Checkout godoc
 //
 //	@Summary		create order
-//	@Description	create order
+//	@Description	create order, retry with same Idempotency-Key returns response of first checkout instead of
+//	@Description	creating other order, same key with other payload returns 409
 //	@Tags			payments
 //	@Accept			json
 //
 //	@Security		BearerAuth
 //
+//	@Param			Idempotency-Key	header	string	false	"idempotency key of checkout"
+//
 //	@Produce		json
 //	@Success		200	{object}	api_gateway_dto.CheckoutResponseDocs
 //	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
 //	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		409	{object}	api_gateway_dto.ResponseErrorDocs
 //	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
 //	@Router			/payments/checkout [post]
 func (p *paymentHandler) Checkout(ctx *gin.Context) {
@@ -95,6 +90,20 @@ func (p *paymentHandler) Checkout(ctx *gin.Context) {
 
 	req, _ := ctx.Get("user")
 	claims := req.(*api_gateway_service.UserClaims)
+	data.ClientIP = ctx.ClientIP()
+
+	if idempotencyKey := ctx.GetHeader("Idempotency-Key"); idempotencyKey != "" {
+		if len(idempotencyKey) > 255 {
+			utils.HandleErrorResponse(ctx, utils.BusinessError{
+				Code:      http.StatusBadRequest,
+				Message:   "Idempotency-Key must have at most 255 characters",
+				ErrorCode: errorcode.BAD_REQUEST,
+			})
+			return
+		}
+
+		data.IdempotencyKey = &idempotencyKey
+	}
 
 	res, err := p.paymentService.CreateOrder(ct, data, claims.UserID)
 
@@ -107,26 +116,31 @@ func (p *paymentHandler) Checkout(ctx *gin.Context) {
 	utils.SuccessResponse(ctx, http.StatusCreated, *res)
 }
 
-// UpdateOrderIPNMomo godoc
+// QuoteCheckout godoc
 //
-//	@Summary		update order status (receive event from momo)
-//	@Description	update order status (receive event from momo)
+//	@Summary		quote checkout
+//	@Description	calculate price, discount, tax and shipping fee of checkout per item and per supplier,
+//	@Description	problems (out of stock, invalid coupon, ...) are returned instead of error, nothing is created
 //	@Tags			payments
 //	@Accept			json
 //
 //	@Security		BearerAuth
-//	@Param			data	body	api_gateway_dto.UpdateOrderIPNMomoRequest	true	"data"
+//
+//	@Param			data	body	api_gateway_dto.CheckoutRequest	true	"data"
 //
 //	@Produce		json
-//	@Success		200	{object}	api_gateway_dto.UpdateOrderIPNMomoResponseDocs
+//	@Success		200	{object}	api_gateway_dto.QuoteCheckoutResponseDocs
 //	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
 //	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
-//	@Router			/payments/webhook/momo [post]
-func (p *paymentHandler) UpdateOrderIPNMomo(ctx *gin.Context) {
-	c, span := p.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "UpdateOrderIPNMomo"))
+//	@Router			/payments/checkout/quote [post]
+func (p *paymentHandler) QuoteCheckout(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := p.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "QuoteCheckout"))
 	defer span.End()
 
-	var data api_gateway_dto.UpdateOrderIPNMomoRequest
+	var data api_gateway_dto.CheckoutRequest
 
 	if err := ctx.ShouldBindJSON(&data); err != nil {
 		span.RecordError(err)
@@ -134,30 +148,211 @@ func (p *paymentHandler) UpdateOrderIPNMomo(ctx *gin.Context) {
 		return
 	}
 
-	rawSignature := fmt.Sprintf("accessKey=%v&amount=%v&extraData=%v&message=%v&orderId=%v&orderInfo=%v&orderType=%v&partnerCode=%v&payType=%v&requestId=%v&responseTime=%v&resultCode=%v&transId=%v",
-		p.envManager.MomoConfig.MomoAccessKey, data.Amount, data.ExtraData, data.Message, data.OrderID, data.OrderInfo,
-		data.OrderType, data.PartnerCode, data.PayType, data.RequestID, data.ResponseTime, data.ResultCode,
-		data.TransId)
+	req, _ := ctx.Get("user")
+	claims := req.(*api_gateway_service.UserClaims)
+
+	res, err := p.paymentService.QuoteCheckout(ct, data, claims.UserID)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
 
-	hmacBuilder := hmac.New(sha256.New, []byte(p.envManager.MomoConfig.MomoSecretKey))
-	hmacBuilder.Write([]byte(rawSignature))
+	utils.SuccessResponse(ctx, http.StatusOK, *res)
+}
+
+// HandlePaymentCallback godoc
+//
+//	@Summary		update order status (receive callback from payment gateway)
+//	@Description	update order status, body (or query string when body is empty, ex: vnpay) is forwarded as it is
+//	@Description	to payment gateway of method code (momo, vnpay)
+//	@Tags			payments
+//	@Accept			json
+//
+//	@Param			methodCode	path	string	true	"method code"
+//	@Param			data		body	object	false	"data"
+//
+//	@Produce		json
+//	@Success		200	{object}	object	"acknowledgement of payment gateway, ex vnpay: RspCode and Message"
+//	@Success		204	"acknowledgement of payment gateway which only reads status code, ex: momo"
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/payments/webhook/{methodCode} [get]
+//	@Router			/payments/webhook/{methodCode} [post]
+func (p *paymentHandler) HandlePaymentCallback(ctx *gin.Context) {
+	c, span := p.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "HandlePaymentCallback"))
+	defer span.End()
+
+	var uri api_gateway_dto.HandlePaymentCallbackURIRequest
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
 
-	signature := hex.EncodeToString(hmacBuilder.Sum(nil))
+	rawData, err := ctx.GetRawData()
 
-	if signature != data.Signature {
-		span.RecordError(errors.New("signature does not match"))
+	if err != nil {
+		span.RecordError(err)
 		utils.HandleErrorResponse(ctx, utils.BusinessError{
 			Code:      http.StatusBadRequest,
-			Message:   "signature does not match",
+			Message:   "callback data is invalid",
 			ErrorCode: errorcode.BAD_REQUEST,
 		})
+		return
+	}
+
+	// some payment gateways send callback as query string instead of body
+	if len(rawData) == 0 {
+		rawData = []byte(ctx.Request.URL.RawQuery)
 	}
 
-	if err := p.paymentService.UpdateOrderIPNMomo(c, data); err != nil {
+	res, err := p.paymentService.HandlePaymentCallback(c, uri.MethodCode, rawData)
+
+	if err != nil {
 		span.RecordError(err)
 		utils.HandleErrorResponse(ctx, err)
 		return
 	}
 
-	utils.SuccessResponse(ctx, http.StatusNoContent, struct{}{})
+	// acknowledgement is built by payment gateway of method, so it is not wrapped like other responses
+	if len(res.Body) == 0 {
+		ctx.Status(res.StatusCode)
+		return
+	}
+
+	ctx.Data(res.StatusCode, res.ContentType, res.Body)
+}
+
+// CreateRefund godoc
+//
+//	@Summary		create refund for payment
+//	@Description	create refund (full or partial) for payment and send it to payment gateway
+//	@Tags			payments
+//	@Accept			json
+//
+//	@Security		BearerAuth
+//	@Param			data	body	api_gateway_dto.CreateRefundRequest	true	"data"
+//
+//	@Produce		json
+//	@Success		200	{object}	api_gateway_dto.RefundResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		403	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/payments/refunds [post]
+func (p *paymentHandler) CreateRefund(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := p.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "CreateRefund"))
+	defer span.End()
+
+	var data api_gateway_dto.CreateRefundRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	req, _ := ctx.Get("user")
+	claims := req.(*api_gateway_service.UserClaims)
+
+	res, err := p.paymentService.CreateRefund(ct, data, claims.UserID)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, *res)
+}
+
+// ProcessRefund godoc
+//
+//	@Summary		process refund
+//	@Description	send pending or failed refund to payment gateway again
+//	@Tags			payments
+//	@Accept			json
+//
+//	@Security		BearerAuth
+//	@Param			data	path	api_gateway_dto.ProcessRefundURIRequest	true	"refund id"
+//
+//	@Produce		json
+//	@Success		200	{object}	api_gateway_dto.RefundResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		403	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/payments/refunds/{refundID}/process [post]
+func (p *paymentHandler) ProcessRefund(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := p.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "ProcessRefund"))
+	defer span.End()
+
+	var uri api_gateway_dto.ProcessRefundURIRequest
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := p.paymentService.ProcessRefund(ct, uri.RefundID)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, *res)
+}
+
+// GetRefunds godoc
+//
+//	@Summary		get refunds of payment
+//	@Description	get refunds of payment
+//	@Tags			payments
+//	@Accept			json
+//
+//	@Security		BearerAuth
+//	@Param			data	query	api_gateway_dto.GetRefundsRequest	true	"payment history id"
+//
+//	@Produce		json
+//	@Success		200	{object}	api_gateway_dto.GetRefundsResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		403	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/payments/refunds [get]
+func (p *paymentHandler) GetRefunds(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := p.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "GetRefunds"))
+	defer span.End()
+
+	var data api_gateway_dto.GetRefundsRequest
+
+	if err := ctx.ShouldBindQuery(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := p.paymentService.GetRefunds(ct, data.PaymentHistoryID)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, res)
 }
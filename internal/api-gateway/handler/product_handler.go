@@ -74,7 +74,8 @@ func (p *productHandler) GetProducts(ctx *gin.Context) {
 //
 //	@Security		BearerAuth
 //
-//	@Param			productID	path	string	true	"ProductID"
+//	@Param			productID	path	string									true	"ProductID"
+//	@Param			data		query	api_gateway_dto.DisplayCurrencyRequest	false	"display currency"
 //
 //	@Produce		json
 //	@Success		200	{object}	api_gateway_dto.GetProductDetailResponseDocs
@@ -96,7 +97,15 @@ func (p *productHandler) GetProductByID(ctx *gin.Context) {
 		return
 	}
 
-	res, err := p.service.GetProductByID(ct, uri.ProductID)
+	var query api_gateway_dto.DisplayCurrencyRequest
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := p.service.GetProductByID(ct, uri.ProductID, query.Currency)
 
 	if err != nil {
 		span.RecordError(err)
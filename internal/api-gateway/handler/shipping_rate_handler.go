@@ -0,0 +1,198 @@
+package api_gateway_handler
+
+import (
+	"context"
+	api_gateway_dto "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/dto"
+	api_gateway_service "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/service"
+	"github.com/TienMinh25/ecommerce-platform/internal/utils"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+type shippingRateHandler struct {
+	tracer              pkg.Tracer
+	shippingRateService api_gateway_service.IShippingRateService
+}
+
+func NewShippingRateHandler(tracer pkg.Tracer, shippingRateService api_gateway_service.IShippingRateService) IShippingRateHandler {
+	return &shippingRateHandler{
+		tracer:              tracer,
+		shippingRateService: shippingRateService,
+	}
+}
+
+// GetShippingRates godoc
+//
+//	@Summary		Get shipping rates
+//	@Description	Get shipping rates of shipping classes for each zone
+//	@Tags			shipping-rates
+//	@Accept			json
+//
+//	@Security		BearerAuth
+//
+//	@Param			data	query	api_gateway_dto.GetShippingRatesRequest	false	"info query"
+//
+//	@Produce		json
+//	@Success		200	{object}	api_gateway_dto.GetShippingRatesResponseDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/shipping-rates [get]
+func (h *shippingRateHandler) GetShippingRates(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := h.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "GetShippingRates"))
+	defer span.End()
+
+	var query api_gateway_dto.GetShippingRatesRequest
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := h.shippingRateService.GetShippingRates(ct, &query)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, res)
+}
+
+// CreateShippingRate godoc
+//
+//	@Summary		Create shipping rate
+//	@Description	Create rate of shipping class for zone, fee = base_fee + fee_per_extra_kg * extra kg over base_weight_grams
+//	@Tags			shipping-rates
+//	@Accept			json
+//
+//	@Security		BearerAuth
+//
+//	@Param			data	body	api_gateway_dto.CreateShippingRateRequest	true	"data"
+//
+//	@Produce		json
+//	@Success		201	{object}	api_gateway_dto.CreateShippingRateResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		409	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/shipping-rates [post]
+func (h *shippingRateHandler) CreateShippingRate(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := h.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "CreateShippingRate"))
+	defer span.End()
+
+	var data api_gateway_dto.CreateShippingRateRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := h.shippingRateService.CreateShippingRate(ct, &data)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusCreated, res)
+}
+
+// UpdateShippingRate godoc
+//
+//	@Summary		Update shipping rate
+//	@Description	Update fees of shipping rate
+//	@Tags			shipping-rates
+//	@Accept			json
+//
+//	@Security		BearerAuth
+//
+//	@Param			id		path	api_gateway_dto.ShippingRateURIRequest		true	"shipping rate id"
+//	@Param			data	body	api_gateway_dto.UpdateShippingRateRequest	true	"data"
+//
+//	@Produce		json
+//	@Success		200	{object}	api_gateway_dto.UpdateShippingRateResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/shipping-rates/{shippingRateID} [patch]
+func (h *shippingRateHandler) UpdateShippingRate(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := h.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "UpdateShippingRate"))
+	defer span.End()
+
+	var uri api_gateway_dto.ShippingRateURIRequest
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	var data api_gateway_dto.UpdateShippingRateRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	if err := h.shippingRateService.UpdateShippingRate(ct, &data, uri.ID); err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, api_gateway_dto.UpdateShippingRateResponse{})
+}
+
+// DeleteShippingRate godoc
+//
+//	@Summary		Delete shipping rate
+//	@Description	Delete shipping rate, shipping class without rate of zone uses rate of default class
+//	@Tags			shipping-rates
+//	@Accept			json
+//
+//	@Security		BearerAuth
+//
+//	@Param			id	path	api_gateway_dto.ShippingRateURIRequest	true	"shipping rate id"
+//
+//	@Produce		json
+//	@Success		200	{object}	api_gateway_dto.DeleteShippingRateResponseDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/shipping-rates/{shippingRateID} [delete]
+func (h *shippingRateHandler) DeleteShippingRate(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := h.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "DeleteShippingRate"))
+	defer span.End()
+
+	var uri api_gateway_dto.ShippingRateURIRequest
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	if err := h.shippingRateService.DeleteShippingRate(ct, uri.ID); err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, api_gateway_dto.DeleteShippingRateResponse{})
+}
@@ -336,6 +336,7 @@ func (h *supplierHandler) GetSupplierOrders(ctx *gin.Context) {
 //	@Success		200		{object}	api_gateway_dto.UpdateOrderItemResponseDocs
 //	@Failure		400		{object}	api_gateway_dto.ResponseErrorDocs
 //	@Failure		401		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404		{object}	api_gateway_dto.ResponseErrorDocs
 //	@Failure		500		{object}	api_gateway_dto.ResponseErrorDocs
 //	@Router			/suppliers/orders/{orderItemID} [post]
 func (h *supplierHandler) UpdateOrderItem(ctx *gin.Context) {
@@ -370,3 +371,46 @@ func (h *supplierHandler) UpdateOrderItem(ctx *gin.Context) {
 
 	utils.SuccessResponse(ctx, http.StatusOK, api_gateway_dto.UpdateOrderItemResponse{})
 }
+
+// GetOrderItemTimeline get timeline of supplier order item
+//
+//	@Summary		get timeline of order item
+//	@Tags			suppliers
+//	@Description	get status events of supplier order item, merged with pickup and delivery time of deliverer
+//	@Accept			json
+//	@Produce		json
+//
+//	@Param			request	path		api_gateway_dto.GetOrderItemTimelineURIRequest	true	"order item id"
+//	@Success		200		{object}	api_gateway_dto.GetOrderItemTimelineResponseDocs
+//	@Failure		400		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/suppliers/orders/{orderItemID}/timeline [get]
+func (h *supplierHandler) GetOrderItemTimeline(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := h.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "GetOrderItemTimeline"))
+	defer span.End()
+
+	req, _ := ctx.Get("user")
+	userClaims := req.(*api_gateway_service.UserClaims)
+
+	var uri api_gateway_dto.GetOrderItemTimelineURIRequest
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := h.service.GetOrderItemTimeline(ct, uri.OrderItemID, userClaims.UserID)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, res)
+}
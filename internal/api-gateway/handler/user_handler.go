@@ -581,6 +581,8 @@ func (u *userHandler) MarkOnlyOneNotificationRead(ctx *gin.Context) {
 //
 //	@Security		BearerAuth
 //
+//	@Param			data	query		api_gateway_dto.DisplayCurrencyRequest	false	"display currency"
+//
 //	@Success		200	{object}	api_gateway_dto.GetCartItemsResponseDocs
 //	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
 //	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
@@ -595,7 +597,15 @@ func (u *userHandler) GetCartItems(ctx *gin.Context) {
 	userClaimsRaw, _ := ctx.Get("user")
 	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
 
-	res, err := u.service.GetCartItems(ct, userClaims.UserID)
+	var query api_gateway_dto.DisplayCurrencyRequest
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := u.service.GetCartItems(ct, userClaims.UserID, query.Currency)
 
 	if err != nil {
 		span.RecordError(err)
@@ -748,6 +758,52 @@ func (u *userHandler) UpdateCartItem(ctx *gin.Context) {
 	utils.SuccessResponse(ctx, http.StatusOK, *res)
 }
 
+// AcknowledgeCartItemChanges godoc
+//
+//	@Summary		acknowledge changes of cart items
+//	@Tags			me
+//	@Description	current prices become last prices seen, so price_changed warnings of cart items are cleared
+//	@Accept			json
+//	@Produce		json
+//
+//	@Security		BearerAuth
+//
+//	@Param			req	body		api_gateway_dto.AcknowledgeCartItemChangesRequest	true	"cart item ids to acknowledge"
+//
+//	@Success		200	{object}	api_gateway_dto.AcknowledgeCartItemChangesResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/users/me/carts/acknowledge-changes [post]
+func (u *userHandler) AcknowledgeCartItemChanges(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := u.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "AcknowledgeCartItemChanges"))
+	defer span.End()
+
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	var data api_gateway_dto.AcknowledgeCartItemChangesRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	if err := u.service.AcknowledgeCartItemChanges(ct, data.CartItemIDs, userClaims.UserID); err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, api_gateway_dto.AcknowledgeCartItemChangesResponse{
+		CartItemIDs: data.CartItemIDs,
+	})
+}
+
 // GetMyOrders godoc
 //
 //	@Summary		update cart item
@@ -800,3 +856,549 @@ func (u *userHandler) GetMyOrders(ctx *gin.Context) {
 
 	utils.PaginatedResponse(ctx, res, int(data.Page), int(data.Limit), totalPages, totalItems, hasNext, hasPrevious)
 }
+
+// CancelOrderItem godoc
+//
+//	@Summary		cancel order item
+//	@Tags			me
+//	@Description	customer cancel order item before it is processed by supplier
+//	@Accept			json
+//	@Produce		json
+//
+//	@Security		BearerAuth
+//
+//	@Param			req1	path		api_gateway_dto.CancelOrderItemURIRequest	true	"order item id"
+//
+//	@Param			req2	body		api_gateway_dto.CancelOrderItemRequest		true	"reason cancel order item"
+//
+//	@Success		200		{object}	api_gateway_dto.CancelOrderItemResponseDocs
+//	@Failure		400		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/users/me/orders/{orderItemID}/cancel [post]
+func (u *userHandler) CancelOrderItem(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := u.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "CancelOrderItem"))
+	defer span.End()
+
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	var data api_gateway_dto.CancelOrderItemRequest
+	var uri api_gateway_dto.CancelOrderItemURIRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	if err := u.service.CancelOrderItem(ct, data, uri.OrderItemID, userClaims.UserID); err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, api_gateway_dto.CancelOrderItemResponse{})
+}
+
+// GetOrderItemTimeline godoc
+//
+//	@Summary		get timeline of order item
+//	@Tags			me
+//	@Description	get status events of own order item, merged with pickup and delivery time of deliverer
+//	@Accept			json
+//	@Produce		json
+//
+//	@Security		BearerAuth
+//
+//	@Param			req	path		api_gateway_dto.GetOrderItemTimelineURIRequest	true	"order item id"
+//
+//	@Success		200	{object}	api_gateway_dto.GetOrderItemTimelineResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/users/me/orders/{orderItemID}/timeline [get]
+func (u *userHandler) GetOrderItemTimeline(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := u.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "GetOrderItemTimeline"))
+	defer span.End()
+
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	var uri api_gateway_dto.GetOrderItemTimelineURIRequest
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := u.service.GetOrderItemTimeline(ct, uri.OrderItemID, userClaims.UserID)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, res)
+}
+
+// ReorderToCart godoc
+//
+//	@Summary		buy again
+//	@Tags			me
+//	@Description	add items of own order to cart, items which are not available are reported instead of added
+//	@Accept			json
+//	@Produce		json
+//
+//	@Security		BearerAuth
+//
+//	@Param			orderID	path		string	true	"order id"
+//
+//	@Success		200		{object}	api_gateway_dto.ReorderToCartResponseDocs
+//	@Failure		400		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/users/me/orders/{orderID}/reorder [post]
+func (u *userHandler) ReorderToCart(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := u.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "ReorderToCart"))
+	defer span.End()
+
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	var uri api_gateway_dto.ReorderToCartURIRequest
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := u.service.ReorderToCart(ct, uri.OrderID, userClaims.UserID)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, res)
+}
+
+// GetWishlistItems godoc
+//
+//	@Summary		get wishlist items
+//	@Tags			me
+//	@Description	get wishlist items of current user, price_dropped is true when current price is lower than saved price
+//	@Accept			json
+//	@Produce		json
+//
+//	@Security		BearerAuth
+//
+//	@Param			data	query		api_gateway_dto.DisplayCurrencyRequest	false	"display currency"
+//
+//	@Success		200		{object}	api_gateway_dto.GetWishlistItemsResponseDocs
+//	@Failure		400		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500		{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/users/me/wishlist [get]
+func (u *userHandler) GetWishlistItems(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := u.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "GetWishlistItems"))
+	defer span.End()
+
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	var query api_gateway_dto.DisplayCurrencyRequest
+
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := u.service.GetWishlistItems(ct, userClaims.UserID, query.Currency)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, res)
+}
+
+// AddWishlistItem godoc
+//
+//	@Summary		add item to wishlist
+//	@Tags			me
+//	@Description	save product into wishlist with its current price, item which is already saved keeps its saved price
+//	@Accept			json
+//	@Produce		json
+//
+//	@Security		BearerAuth
+//
+//	@Param			req	body		api_gateway_dto.AddWishlistItemRequest	true	"product to save"
+//
+//	@Success		200	{object}	api_gateway_dto.AddWishlistItemResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/users/me/wishlist [post]
+func (u *userHandler) AddWishlistItem(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := u.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "AddWishlistItem"))
+	defer span.End()
+
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	var data api_gateway_dto.AddWishlistItemRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := u.service.AddWishlistItem(ct, data, userClaims.UserID)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, *res)
+}
+
+// DeleteWishlistItems godoc
+//
+//	@Summary		delete wishlist items
+//	@Tags			me
+//	@Description	delete wishlist items of current user
+//	@Accept			json
+//	@Produce		json
+//
+//	@Security		BearerAuth
+//
+//	@Param			req	body		api_gateway_dto.DeleteWishlistItemsRequest	true	"wishlist item ids to delete"
+//
+//	@Success		200	{object}	api_gateway_dto.DeleteWishlistItemsResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/users/me/wishlist [delete]
+func (u *userHandler) DeleteWishlistItems(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := u.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "DeleteWishlistItems"))
+	defer span.End()
+
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	var data api_gateway_dto.DeleteWishlistItemsRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	if err := u.service.DeleteWishlistItems(ct, data.WishlistItemIDs, userClaims.UserID); err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, api_gateway_dto.DeleteWishlistItemsResponse{
+		WishlistItemIDs: data.WishlistItemIDs,
+	})
+}
+
+// MoveWishlistItemToCart godoc
+//
+//	@Summary		move wishlist item to cart
+//	@Tags			me
+//	@Description	add wishlist item to cart with quantity and remove it from wishlist, item is kept when inventory is not enough
+//	@Accept			json
+//	@Produce		json
+//
+//	@Security		BearerAuth
+//
+//	@Param			wishlistItemID	path		string										true	"wishlist item id"
+//	@Param			req				body		api_gateway_dto.MoveWishlistItemToCartRequest	true	"quantity to add to cart"
+//
+//	@Success		200				{object}	api_gateway_dto.MoveWishlistItemToCartResponseDocs
+//	@Failure		400				{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401				{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404				{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500				{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/users/me/wishlist/{wishlistItemID}/move-to-cart [post]
+func (u *userHandler) MoveWishlistItemToCart(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := u.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "MoveWishlistItemToCart"))
+	defer span.End()
+
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	var data api_gateway_dto.MoveWishlistItemToCartRequest
+	var uri api_gateway_dto.WishlistItemURIRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	if err := u.service.MoveWishlistItemToCart(ct, uri.WishlistItemID, data.Quantity, userClaims.UserID); err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, api_gateway_dto.MoveWishlistItemToCartResponse{})
+}
+
+// MoveCartItemToWishlist godoc
+//
+//	@Summary		move cart item to wishlist
+//	@Tags			me
+//	@Description	save cart item into wishlist with current price and remove it from cart
+//	@Accept			json
+//	@Produce		json
+//
+//	@Security		BearerAuth
+//
+//	@Param			req	path		api_gateway_dto.UpdateCartItemURIRequest	true	"cart item id"
+//
+//	@Success		200	{object}	api_gateway_dto.MoveCartItemToWishlistResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/users/me/carts/{cartItemID}/move-to-wishlist [post]
+func (u *userHandler) MoveCartItemToWishlist(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := u.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "MoveCartItemToWishlist"))
+	defer span.End()
+
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	var uri api_gateway_dto.UpdateCartItemURIRequest
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := u.service.MoveCartItemToWishlist(ct, uri.CartItemID, userClaims.UserID)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, *res)
+}
+
+// GetUserPaymentMethods godoc
+//
+//	@Summary		get saved payment methods
+//	@Tags			me
+//	@Description	get saved payment methods of current user, card data is returned only as last 4 digits
+//	@Accept			json
+//	@Produce		json
+//
+//	@Security		BearerAuth
+//
+//	@Success		200	{object}	api_gateway_dto.GetUserPaymentMethodsResponseDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/users/me/payment-methods [get]
+func (u *userHandler) GetUserPaymentMethods(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := u.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "GetUserPaymentMethods"))
+	defer span.End()
+
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	res, err := u.service.GetUserPaymentMethods(ct, userClaims.UserID)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, res)
+}
+
+// CreateUserPaymentMethod godoc
+//
+//	@Summary		save payment method
+//	@Tags			me
+//	@Description	save payment method by provider token (with last 4 digits) or card number, card number is encrypted
+//	@Accept			json
+//	@Produce		json
+//
+//	@Security		BearerAuth
+//
+//	@Param			req	body		api_gateway_dto.CreateUserPaymentMethodRequest	true	"payment method"
+//
+//	@Success		201	{object}	api_gateway_dto.CreateUserPaymentMethodResponseDocs
+//	@Failure		400	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500	{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/users/me/payment-methods [post]
+func (u *userHandler) CreateUserPaymentMethod(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := u.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "CreateUserPaymentMethod"))
+	defer span.End()
+
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	var data api_gateway_dto.CreateUserPaymentMethodRequest
+
+	if err := ctx.ShouldBindJSON(&data); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	res, err := u.service.CreateUserPaymentMethod(ct, data, userClaims.UserID)
+
+	if err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusCreated, *res)
+}
+
+// SetDefaultUserPaymentMethod godoc
+//
+//	@Summary		set default payment method
+//	@Tags			me
+//	@Description	set default saved payment method of current user
+//	@Accept			json
+//	@Produce		json
+//
+//	@Security		BearerAuth
+//
+//	@Param			userPaymentMethodID	path		int	true	"saved payment method id on path"
+//
+//	@Success		200					{object}	api_gateway_dto.SetDefaultUserPaymentMethodResponseDocs
+//	@Failure		400					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/users/me/payment-methods/{userPaymentMethodID}/default [patch]
+func (u *userHandler) SetDefaultUserPaymentMethod(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := u.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "SetDefaultUserPaymentMethod"))
+	defer span.End()
+
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	var uri api_gateway_dto.UserPaymentMethodURIRequest
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	if err := u.service.SetDefaultUserPaymentMethod(ct, uri.UserPaymentMethodID, userClaims.UserID); err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, api_gateway_dto.SetDefaultUserPaymentMethodResponse{})
+}
+
+// DeleteUserPaymentMethod godoc
+//
+//	@Summary		delete saved payment method
+//	@Tags			me
+//	@Description	delete saved payment method of current user, next latest method becomes default one
+//	@Accept			json
+//	@Produce		json
+//
+//	@Security		BearerAuth
+//
+//	@Param			userPaymentMethodID	path		int	true	"saved payment method id on path"
+//
+//	@Success		200					{object}	api_gateway_dto.DeleteUserPaymentMethodResponseDocs
+//	@Failure		400					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		401					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		404					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Failure		500					{object}	api_gateway_dto.ResponseErrorDocs
+//	@Router			/users/me/payment-methods/{userPaymentMethodID} [delete]
+func (u *userHandler) DeleteUserPaymentMethod(ctx *gin.Context) {
+	cRaw, _ := ctx.Get("tracingContext")
+	c := cRaw.(context.Context)
+	ct, span := u.tracer.StartFromContext(c, tracing.GetSpanName(tracing.HandlerLayer, "DeleteUserPaymentMethod"))
+	defer span.End()
+
+	userClaimsRaw, _ := ctx.Get("user")
+	userClaims := userClaimsRaw.(*api_gateway_service.UserClaims)
+
+	var uri api_gateway_dto.UserPaymentMethodURIRequest
+
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		span.RecordError(err)
+		utils.HandleValidateData(ctx, err)
+		return
+	}
+
+	if err := u.service.DeleteUserPaymentMethod(ct, uri.UserPaymentMethodID, userClaims.UserID); err != nil {
+		span.RecordError(err)
+		utils.HandleErrorResponse(ctx, err)
+		return
+	}
+
+	utils.SuccessResponse(ctx, http.StatusOK, api_gateway_dto.DeleteUserPaymentMethodResponse{})
+}
@@ -2,9 +2,11 @@ package middleware
 
 import (
 	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 	"log"
+	"reflect"
 )
 
 type ValidatorManager struct {
@@ -30,6 +32,15 @@ func (vm *ValidatorManager) RegisterDefaultValidator() {
 
 			log.Printf("validator %s register success", tag)
 		}
+
+		// money is validated by its minor units, so tags as gt=0 work same as on numbers
+		v.RegisterCustomTypeFunc(func(field reflect.Value) interface{} {
+			if amount, ok := field.Interface().(money.Money); ok {
+				return amount.Minor()
+			}
+
+			return nil
+		}, money.Money{})
 	}
 }
 
@@ -306,3 +306,30 @@ func (a *addressRepository) GetBusinessAddressForSupplier(ctx context.Context, b
 
 	return addressesMap, nil
 }
+
+func (a *addressRepository) GetAddressByID(ctx context.Context, addressID, userID int) (*api_gateway_models.Address, error) {
+	ctx, span := a.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetAddressByID"))
+	defer span.End()
+
+	querySelect := `SELECT id, user_id, province, district, ward from addresses WHERE id = $1 and user_id = $2`
+	var address api_gateway_models.Address
+
+	if err := a.db.QueryRow(ctx, querySelect, addressID, userID).Scan(&address.ID, &address.UserID,
+		&address.Province, &address.District, &address.Ward); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, utils.BusinessError{
+				Message:   "Address not found",
+				Code:      http.StatusNotFound,
+				ErrorCode: errorcode.NOT_FOUND,
+			}
+		}
+
+		span.RecordError(err)
+		return nil, utils.TechnicalError{
+			Message: common.MSG_INTERNAL_ERROR,
+			Code:    http.StatusInternalServerError,
+		}
+	}
+
+	return &address, nil
+}
@@ -137,6 +137,7 @@ type IAddressRepository interface {
 	UpdateAddressByID(ctx context.Context, data *api_gateway_dto.UpdateAddressRequest, userID, addressID int) error
 	DeleteAddressByID(ctx context.Context, addressID int) error
 	GetBusinessAddressForSupplier(ctx context.Context, businessIdsMap map[int64]bool) (map[int64]string, error)
+	GetAddressByID(ctx context.Context, addressID, userID int) (*api_gateway_models.Address, error)
 }
 
 type IAdministrativeDivisionRepository interface {
@@ -40,6 +40,9 @@ func NewRouter(
 	supplierHandler api_gateway_handler.ISupplierHandler,
 	s3Handler api_gateway_handler.IS3Handler,
 	delivererHandler api_gateway_handler.IDelivererHandler,
+	shippingRateHandler api_gateway_handler.IShippingRateHandler,
+	exchangeRateHandler api_gateway_handler.IExchangeRateHandler,
+	guestCartHandler api_gateway_handler.IGuestCartHandler,
 ) *Router {
 	apiV1Group := router.Group("/api/v1")
 
@@ -58,6 +61,9 @@ func NewRouter(
 	registerSupplierEndpoint(apiV1Group, accessTokenMiddleware, permissionMiddleware, xAuthMiddleware, supplierHandler)
 	registerS3Endpoint(apiV1Group, accessTokenMiddleware, s3Handler)
 	registerDelivererEndpoint(apiV1Group, accessTokenMiddleware, permissionMiddleware, delivererHandler)
+	registerShippingRateEndpoint(apiV1Group, accessTokenMiddleware, permissionMiddleware, shippingRateHandler)
+	registerExchangeRateEndpoint(apiV1Group, accessTokenMiddleware, permissionMiddleware, exchangeRateHandler)
+	registerGuestCartEndpoint(apiV1Group, guestCartHandler)
 
 	return &Router{
 		Router: router,
@@ -180,9 +186,27 @@ func registerUserMeHandler(group *gin.RouterGroup, permissionMiddleware *middlew
 		userMeGroup.POST("/carts", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.UserManagement, common.Create), handler.AddCartItem)
 		userMeGroup.PATCH("/carts/:cartItemID", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.UserManagement, common.Update), handler.UpdateCartItem)
 		userMeGroup.DELETE("/carts", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.UserManagement, common.Delete), handler.DeleteCartItems)
+		userMeGroup.POST("/carts/acknowledge-changes", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.UserManagement, common.Update), handler.AcknowledgeCartItemChanges)
 
 		// my orders
 		userMeGroup.GET("/orders", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.OrderManagement, common.Read), handler.GetMyOrders)
+		userMeGroup.POST("/orders/:orderItemID/cancel", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.OrderManagement, common.Update), handler.CancelOrderItem)
+		userMeGroup.GET("/orders/:orderItemID/timeline", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.OrderManagement, common.Read), handler.GetOrderItemTimeline)
+		// wildcard is named orderItemID like routes above because gin allows one name at same segment, it is order id here
+		userMeGroup.POST("/orders/:orderItemID/reorder", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.UserManagement, common.Create), handler.ReorderToCart)
+
+		// wishlist
+		userMeGroup.GET("/wishlist", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.UserManagement, common.Read), handler.GetWishlistItems)
+		userMeGroup.POST("/wishlist", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.UserManagement, common.Create), handler.AddWishlistItem)
+		userMeGroup.DELETE("/wishlist", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.UserManagement, common.Delete), handler.DeleteWishlistItems)
+		userMeGroup.POST("/wishlist/:wishlistItemID/move-to-cart", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.UserManagement, common.Update), handler.MoveWishlistItemToCart)
+		userMeGroup.POST("/carts/:cartItemID/move-to-wishlist", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.UserManagement, common.Update), handler.MoveCartItemToWishlist)
+
+		// saved payment methods
+		userMeGroup.GET("/payment-methods", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.UserManagement, common.Read), handler.GetUserPaymentMethods)
+		userMeGroup.POST("/payment-methods", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.UserManagement, common.Create), handler.CreateUserPaymentMethod)
+		userMeGroup.PATCH("/payment-methods/:userPaymentMethodID/default", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.UserManagement, common.Update), handler.SetDefaultUserPaymentMethod)
+		userMeGroup.DELETE("/payment-methods/:userPaymentMethodID", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin, common.RoleCustomer}, common.UserManagement, common.Delete), handler.DeleteUserPaymentMethod)
 	}
 }
 
@@ -225,15 +249,62 @@ func registerCouponEndpoint(group *gin.RouterGroup, accessTokenMiddleware *middl
 		couponGroup.PATCH("/:couponID", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.CouponManagement, common.Update), couponHandler.UpdateCoupon)
 		couponGroup.DELETE("/:couponID", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.CouponManagement, common.Delete), couponHandler.DeleteCouponByID)
 	}
+
+	couponCampaignGroup := group.Group("/coupon-campaigns")
+	couponCampaignGroup.Use(accessTokenMiddleware.JwtAccessTokenMiddleware())
+	{
+		couponCampaignGroup.GET("", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.CouponManagement, common.Read), couponHandler.GetCouponCampaigns)
+		couponCampaignGroup.POST("", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.CouponManagement, common.Create), couponHandler.CreateCouponCampaign)
+		couponCampaignGroup.POST("/:campaignID/export", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.CouponManagement, common.Read), couponHandler.ExportCouponCampaignCodes)
+		couponCampaignGroup.POST("/:campaignID/revoke", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.CouponManagement, common.Update), couponHandler.RevokeCouponCampaignCodes)
+	}
+}
+
+func registerShippingRateEndpoint(group *gin.RouterGroup, accessTokenMiddleware *middleware.JwtMiddleware, permissionMiddleware *middleware.PermissionMiddleware, shippingRateHandler api_gateway_handler.IShippingRateHandler) {
+	shippingRateGroup := group.Group("/shipping-rates")
+	shippingRateGroup.Use(accessTokenMiddleware.JwtAccessTokenMiddleware())
+	{
+		shippingRateGroup.GET("", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.ShippingManagement, common.Read), shippingRateHandler.GetShippingRates)
+		shippingRateGroup.POST("", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.ShippingManagement, common.Create), shippingRateHandler.CreateShippingRate)
+		shippingRateGroup.PATCH("/:shippingRateID", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.ShippingManagement, common.Update), shippingRateHandler.UpdateShippingRate)
+		shippingRateGroup.DELETE("/:shippingRateID", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.ShippingManagement, common.Delete), shippingRateHandler.DeleteShippingRate)
+	}
+}
+
+func registerExchangeRateEndpoint(group *gin.RouterGroup, accessTokenMiddleware *middleware.JwtMiddleware, permissionMiddleware *middleware.PermissionMiddleware, exchangeRateHandler api_gateway_handler.IExchangeRateHandler) {
+	exchangeRateGroup := group.Group("/exchange-rates")
+	exchangeRateGroup.Use(accessTokenMiddleware.JwtAccessTokenMiddleware())
+	{
+		exchangeRateGroup.GET("", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.Payment, common.Read), exchangeRateHandler.GetExchangeRates)
+		exchangeRateGroup.PUT("", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.Payment, common.Update), exchangeRateHandler.UpdateExchangeRates)
+	}
+}
+
+func registerGuestCartEndpoint(group *gin.RouterGroup, guestCartHandler api_gateway_handler.IGuestCartHandler) {
+	// guest cart is used without login, it is identified by guest cart token
+	guestCartGroup := group.Group("/guest-carts")
+	{
+		guestCartGroup.GET("", guestCartHandler.GetGuestCartItems)
+		guestCartGroup.POST("/items", guestCartHandler.AddGuestCartItem)
+		guestCartGroup.PATCH("/items/:productVariantID", guestCartHandler.UpdateGuestCartItem)
+		guestCartGroup.DELETE("/items", guestCartHandler.DeleteGuestCartItems)
+	}
 }
 
 func registerPaymentEndpoint(group *gin.RouterGroup, accessTokenMiddleware *middleware.JwtMiddleware, permissionMiddleware *middleware.PermissionMiddleware, paymentHandler api_gateway_handler.IPaymentHandler) {
 	paymentGroup := group.Group("/payments")
-	paymentGroup.POST("/webhook/momo", paymentHandler.UpdateOrderIPNMomo)
+	paymentGroup.GET("/webhook/:methodCode", paymentHandler.HandlePaymentCallback)
+	paymentGroup.POST("/webhook/:methodCode", paymentHandler.HandlePaymentCallback)
 	paymentGroup.Use(accessTokenMiddleware.JwtAccessTokenMiddleware())
 	{
 		paymentGroup.GET("/payment-methods", permissionMiddleware.HasPermission([]common.RoleName{common.RoleCustomer, common.RoleAdmin}, common.Payment, common.Read), paymentHandler.GetPaymentMethods)
 		paymentGroup.POST("/checkout", permissionMiddleware.HasPermission([]common.RoleName{common.RoleCustomer, common.RoleAdmin}, common.OrderManagement, common.Create), paymentHandler.Checkout)
+		paymentGroup.POST("/checkout/quote", permissionMiddleware.HasPermission([]common.RoleName{common.RoleCustomer, common.RoleAdmin}, common.OrderManagement, common.Create), paymentHandler.QuoteCheckout)
+
+		// refunds
+		paymentGroup.GET("/refunds", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.Payment, common.Read), paymentHandler.GetRefunds)
+		paymentGroup.POST("/refunds", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.Payment, common.Create), paymentHandler.CreateRefund)
+		paymentGroup.POST("/refunds/:refundID/process", permissionMiddleware.HasPermission([]common.RoleName{common.RoleAdmin}, common.Payment, common.Update), paymentHandler.ProcessRefund)
 	}
 }
 
@@ -252,6 +323,7 @@ func registerSupplierEndpoint(group *gin.RouterGroup, accessTokenMiddleware *mid
 
 		supplierGroup.GET("/me", permissionMiddleware.HasPermission([]common.RoleName{common.RoleSupplier}, common.OrderManagement, common.Read), supplierHandler.GetSupplierOrders)
 		supplierGroup.POST("/orders/:orderItemID", permissionMiddleware.HasPermission([]common.RoleName{common.RoleSupplier}, common.OrderManagement, common.Update), supplierHandler.UpdateOrderItem)
+		supplierGroup.GET("/orders/:orderItemID/timeline", permissionMiddleware.HasPermission([]common.RoleName{common.RoleSupplier}, common.OrderManagement, common.Read), supplierHandler.GetOrderItemTimeline)
 	}
 }
 
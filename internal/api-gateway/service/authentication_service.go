@@ -34,6 +34,7 @@ type authenticationService struct {
 	messageBroker          pkg.MessageQueue
 	oauthCacheService      IOauthCacheService
 	httpClient             pkg.HTTPClient
+	guestCartService       IGuestCartService
 }
 
 func NewAuthenticationService(
@@ -47,6 +48,7 @@ func NewAuthenticationService(
 	messageBroker pkg.MessageQueue,
 	oauthCacheService IOauthCacheService,
 	httpClient pkg.HTTPClient,
+	guestCartService IGuestCartService,
 ) IAuthenticationService {
 	return &authenticationService{
 		tracer:                 tracer,
@@ -59,6 +61,7 @@ func NewAuthenticationService(
 		messageBroker:          messageBroker,
 		oauthCacheService:      oauthCacheService,
 		httpClient:             httpClient,
+		guestCartService:       guestCartService,
 	}
 }
 
@@ -130,18 +133,18 @@ func (a *authenticationService) Register(ctx context.Context, data api_gateway_d
 	return &api_gateway_dto.RegisterResponse{}, nil
 }
 
-func (a *authenticationService) Login(ctx context.Context, data api_gateway_dto.LoginRequest) (*api_gateway_dto.LoginResponse, error) {
+func (a *authenticationService) Login(ctx context.Context, data api_gateway_dto.LoginRequest, guestCartToken string) (*api_gateway_dto.LoginResponse, bool, error) {
 	ctx, span := a.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "Login"))
 	defer span.End()
 
 	userInfo, err := a.userRepo.GetUserByEmail(ctx, data.Email)
 
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	if !userInfo.EmailVerified {
-		return nil, utils.BusinessError{
+		return nil, false, utils.BusinessError{
 			Code:      http.StatusUnauthorized,
 			Message:   "Please verify your email link to this account",
 			ErrorCode: errorcode.NOT_VERIFY_EMAIL,
@@ -150,7 +153,7 @@ func (a *authenticationService) Login(ctx context.Context, data api_gateway_dto.
 
 	// check password correct or not
 	if isValidPassword := utils.CheckPasswordHash(data.Password, userInfo.UserPassword.Password); !isValidPassword {
-		return nil, utils.BusinessError{
+		return nil, false, utils.BusinessError{
 			Code:      http.StatusUnauthorized,
 			Message:   common.INCORRECT_USER_PASSWORD,
 			ErrorCode: errorcode.EMAIL_OR_PASSWORD_INCOORECT,
@@ -158,7 +161,7 @@ func (a *authenticationService) Login(ctx context.Context, data api_gateway_dto.
 	}
 
 	if userInfo.Status == api_gateway_models.UserStatusInactive {
-		return nil, utils.BusinessError{
+		return nil, false, utils.BusinessError{
 			Code:      http.StatusForbidden,
 			Message:   "Your account is inactive",
 			ErrorCode: errorcode.INACTIVE_ACCOUNT,
@@ -183,13 +186,15 @@ func (a *authenticationService) Login(ctx context.Context, data api_gateway_dto.
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	if err = a.refreshTokenRepository.CreateRefreshToken(ctx, userInfo.ID, userInfo.Email, time.Now().Add(time.Duration(a.env.ExpireRefreshToken)*time.Hour*24), refreshToken); err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
+	guestCartMerged := a.mergeGuestCart(ctx, guestCartToken, userInfo.ID)
+
 	avatarURL := ""
 
 	if userInfo.AvatarURL != nil {
@@ -202,7 +207,7 @@ func (a *authenticationService) Login(ctx context.Context, data api_gateway_dto.
 		FullName:     userInfo.FullName,
 		AvatarURL:    avatarURL,
 		Roles:        roleResponse,
-	}, nil
+	}, guestCartMerged, nil
 }
 
 func (a *authenticationService) VerifyEmail(ctx context.Context, data api_gateway_dto.VerifyEmailRequest) error {
@@ -531,7 +536,7 @@ func (a *authenticationService) GetAuthorizationURL(ctx context.Context, data ap
 	return authorizationURL, nil
 }
 
-func (a *authenticationService) ExchangeOAuthCode(ctx context.Context, data api_gateway_dto.ExchangeOauthCodeRequest) (*api_gateway_dto.ExchangeOauthCodeResponse, error) {
+func (a *authenticationService) ExchangeOAuthCode(ctx context.Context, data api_gateway_dto.ExchangeOauthCodeRequest, guestCartToken string) (*api_gateway_dto.ExchangeOauthCodeResponse, bool, error) {
 	ctx, span := a.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "ExchangeOAuthCode"))
 	defer span.End()
 
@@ -539,24 +544,24 @@ func (a *authenticationService) ExchangeOAuthCode(ctx context.Context, data api_
 	token, err := a.getTokenFromOauthServer(ctx, data)
 
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	// step 2: use access token to get information about user
 	userInfo, err := a.getUserInfo(ctx, token, data.OAuthProvider)
 
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	// step 3: if already exists -> login, if not, insert new user
-	res, err := a.loginOrRegisterOAuthUser(ctx, userInfo, data.OAuthProvider)
+	res, guestCartMerged, err := a.loginOrRegisterOAuthUser(ctx, userInfo, data.OAuthProvider, guestCartToken)
 
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	return res, nil
+	return res, guestCartMerged, nil
 }
 
 func (a *authenticationService) getTokenFromOauthServer(ctx context.Context, data api_gateway_dto.ExchangeOauthCodeRequest) (interface{}, error) {
@@ -706,7 +711,7 @@ func (a *authenticationService) getUserInfo(ctx context.Context, token interface
 	return userInfo, nil
 }
 
-func (a *authenticationService) loginOrRegisterOAuthUser(ctx context.Context, userInfo interface{}, oauthProvider api_gateway_dto.OAuthProvider) (*api_gateway_dto.ExchangeOauthCodeResponse, error) {
+func (a *authenticationService) loginOrRegisterOAuthUser(ctx context.Context, userInfo interface{}, oauthProvider api_gateway_dto.OAuthProvider, guestCartToken string) (*api_gateway_dto.ExchangeOauthCodeResponse, bool, error) {
 	ctx, span := a.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "loginOrRegisterOAuthUser"))
 	defer span.End()
 
@@ -717,7 +722,7 @@ func (a *authenticationService) loginOrRegisterOAuthUser(ctx context.Context, us
 
 	if err != nil {
 		span.RecordError(err)
-		return nil, err
+		return nil, false, err
 	}
 
 	var user *api_gateway_models.User
@@ -728,7 +733,7 @@ func (a *authenticationService) loginOrRegisterOAuthUser(ctx context.Context, us
 
 		if err != nil {
 			span.RecordError(err)
-			return nil, err
+			return nil, false, err
 		}
 	} else {
 		// create new user based on information get from oauth provider
@@ -741,14 +746,14 @@ func (a *authenticationService) loginOrRegisterOAuthUser(ctx context.Context, us
 
 		if err = a.userRepo.CreateUserBasedOauth(ctx, userCreated); err != nil {
 			span.RecordError(err)
-			return nil, err
+			return nil, false, err
 		}
 
 		user, err = a.userRepo.GetUserByEmailWithoutPassword(ctx, userOauth.Email)
 
 		if err != nil {
 			span.RecordError(err)
-			return nil, err
+			return nil, false, err
 		}
 	}
 
@@ -770,11 +775,18 @@ func (a *authenticationService) loginOrRegisterOAuthUser(ctx context.Context, us
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	if err = a.refreshTokenRepository.CreateRefreshToken(ctx, user.ID, user.Email, time.Now().Add(time.Duration(a.env.ExpireRefreshToken)*time.Hour*24), refreshToken); err != nil {
-		return nil, err
+		return nil, false, err
+	}
+
+	// email which provider has not verified gives no proof of owner, so guest cart waits for verified login
+	guestCartMerged := false
+
+	if user.EmailVerified {
+		guestCartMerged = a.mergeGuestCart(ctx, guestCartToken, user.ID)
 	}
 
 	avatarURL := ""
@@ -789,7 +801,7 @@ func (a *authenticationService) loginOrRegisterOAuthUser(ctx context.Context, us
 		FullName:     user.FullName,
 		AvatarURL:    avatarURL,
 		Roles:        roleResponse,
-	}, nil
+	}, guestCartMerged, nil
 }
 
 func (a *authenticationService) extractInfo(ctx context.Context, userInfo interface{}, oauthProvider api_gateway_dto.OAuthProvider) *struct {
@@ -838,3 +850,21 @@ func (a *authenticationService) extractInfo(ctx context.Context, userInfo interf
 		VerifiedEmail: verifiedEmail,
 	}
 }
+
+// mergeGuestCart merges guest cart into cart of user who has verified email and reports whether it was merged,
+// failed merge does not fail login, guest cart is kept for next login
+func (a *authenticationService) mergeGuestCart(ctx context.Context, guestCartToken string, userID int) bool {
+	ctx, span := a.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "mergeGuestCart"))
+	defer span.End()
+
+	if guestCartToken == "" {
+		return false
+	}
+
+	if err := a.guestCartService.MergeIntoUserCart(ctx, guestCartToken, userID); err != nil {
+		span.RecordError(err)
+		return false
+	}
+
+	return true
+}
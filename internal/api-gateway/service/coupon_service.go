@@ -4,6 +4,7 @@ import (
 	"context"
 	api_gateway_dto "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/dto"
 	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/utils"
 	"github.com/TienMinh25/ecommerce-platform/internal/utils/errorcode"
@@ -50,6 +51,8 @@ func (service *couponService) GetCoupons(ctx context.Context, data *api_gateway_
 		StartDate:    startDate,
 		EndDate:      endDate,
 		IsActive:     data.IsActive,
+		Scope:        data.Scope,
+		CampaignId:   data.CampaignID,
 	})
 
 	if err != nil {
@@ -67,22 +70,26 @@ func (service *couponService) GetCoupons(ctx context.Context, data *api_gateway_
 			Code:                  coupon.Code,
 			Name:                  coupon.Name,
 			DiscountType:          coupon.DiscountType,
-			DiscountValue:         coupon.DiscountValue,
-			MinimumOrderAmount:    coupon.MinimumOrderAmount,
-			MaximumDiscountAmount: coupon.MaximumDiscountAmount,
+			DiscountValue:         parseDiscountValue(coupon.DiscountValue),
+			MinimumOrderAmount:    fromMoneyProto(coupon.MinimumOrderAmount),
+			MaximumDiscountAmount: fromMoneyProto(coupon.MaximumDiscountAmount),
 			UsageLimit:            coupon.UsageLimit,
 			UsageCount:            coupon.UsageCount,
 			Currency:              coupon.Currency,
 			StartDate:             coupon.StartDate.AsTime(),
 			EndDate:               coupon.EndDate.AsTime(),
 			IsActive:              coupon.IsActive,
+			Scope:                 coupon.Scope,
+			CategoryID:            coupon.CategoryId,
+			UsageLimitPerUser:     coupon.UsageLimitPerUser,
+			UserID:                coupon.UserId,
 		})
 	}
 
 	return result, int(res.Metadata.TotalItems), int(res.Metadata.TotalPages), res.Metadata.HasNext, res.Metadata.HasPrevious, nil
 }
 
-func (service *couponService) GetCouponByClient(ctx context.Context, data *api_gateway_dto.GetCouponsByClientRequest) ([]api_gateway_dto.GetCouponsResponse, int, int, bool, bool, error) {
+func (service *couponService) GetCouponByClient(ctx context.Context, data *api_gateway_dto.GetCouponsByClientRequest, userID int) ([]api_gateway_dto.GetCouponsResponse, int, int, bool, bool, error) {
 	ctx, span := service.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetCouponByClient"))
 	defer span.End()
 
@@ -90,6 +97,7 @@ func (service *couponService) GetCouponByClient(ctx context.Context, data *api_g
 		Limit:       data.Limit,
 		Page:        data.Page,
 		CurrentDate: timestamppb.New(data.CurrentDate),
+		UserId:      int64(userID),
 	})
 
 	if err != nil {
@@ -107,15 +115,19 @@ func (service *couponService) GetCouponByClient(ctx context.Context, data *api_g
 			Code:                  coupon.Code,
 			Name:                  coupon.Name,
 			DiscountType:          coupon.DiscountType,
-			DiscountValue:         coupon.DiscountValue,
-			MinimumOrderAmount:    coupon.MinimumOrderAmount,
-			MaximumDiscountAmount: coupon.MaximumDiscountAmount,
+			DiscountValue:         parseDiscountValue(coupon.DiscountValue),
+			MinimumOrderAmount:    fromMoneyProto(coupon.MinimumOrderAmount),
+			MaximumDiscountAmount: fromMoneyProto(coupon.MaximumDiscountAmount),
 			UsageLimit:            coupon.UsageLimit,
 			UsageCount:            coupon.UsageCount,
 			Currency:              coupon.Currency,
 			StartDate:             coupon.StartDate.AsTime(),
 			EndDate:               coupon.EndDate.AsTime(),
 			IsActive:              coupon.IsActive,
+			Scope:                 coupon.Scope,
+			CategoryID:            coupon.CategoryId,
+			UsageLimitPerUser:     coupon.UsageLimitPerUser,
+			UserID:                coupon.UserId,
 		})
 	}
 
@@ -130,16 +142,29 @@ func (service *couponService) CreateCoupon(ctx context.Context, data *api_gatewa
 		Name:                  data.Name,
 		Description:           data.Description,
 		DiscountType:          data.DiscountType,
-		DiscountValue:         data.DiscountValue,
-		MaximumDiscountAmount: data.MaximumDiscountAmount,
-		MinimumOrderAmount:    data.MinimumOrderAmount,
+		DiscountValue:         data.DiscountValue.String(),
+		MaximumDiscountAmount: toMoneyProto(data.MaximumDiscountAmount, data.Currency),
+		MinimumOrderAmount:    toMoneyProto(data.MinimumOrderAmount, data.Currency),
 		Currency:              data.Currency,
 		StartDate:             timestamppb.New(data.StartDate),
 		EndDate:               timestamppb.New(data.EndDate),
 		UsageLimit:            data.UsageLimit,
+		Scope:                 data.Scope,
+		CategoryId:            data.CategoryID,
+		UsageLimitPerUser:     data.UsageLimitPerUser,
 	})
 
 	if err != nil {
+		st, _ := status.FromError(err)
+
+		if st.Code() == codes.InvalidArgument {
+			return utils.BusinessError{
+				Code:      http.StatusBadRequest,
+				Message:   st.Message(),
+				ErrorCode: errorcode.BAD_REQUEST,
+			}
+		}
+
 		return utils.TechnicalError{
 			Code:    http.StatusInternalServerError,
 			Message: common.MSG_INTERNAL_ERROR,
@@ -181,15 +206,18 @@ func (service *couponService) GetDetailCouponByID(ctx context.Context, couponID
 		Name:                  res.Name,
 		Description:           res.Description,
 		DiscountType:          res.DiscountType,
-		DiscountValue:         res.DiscountValue,
-		MaximumDiscountAmount: res.MaximumDiscountAmount,
-		MinimumOrderAmount:    res.MinimumOrderAmount,
+		DiscountValue:         parseDiscountValue(res.DiscountValue),
+		MaximumDiscountAmount: fromMoneyProto(res.MaximumDiscountAmount),
+		MinimumOrderAmount:    fromMoneyProto(res.MinimumOrderAmount),
 		Currency:              res.Currency,
 		StartDate:             res.StartDate.AsTime(),
 		EndDate:               res.EndDate.AsTime(),
 		UsageLimit:            res.UsageLimit,
 		UsageCount:            res.UsageCount,
 		IsActive:              res.IsActive,
+		Scope:                 res.Scope,
+		CategoryID:            res.CategoryId,
+		UsageLimitPerUser:     res.UsageLimitPerUser,
 		CreatedAt:             res.CreatedAt.AsTime(),
 		UpdatedAt:             res.UpdatedAt.AsTime(),
 	}, nil
@@ -204,16 +232,29 @@ func (service *couponService) UpdateCoupon(ctx context.Context, data *api_gatewa
 		Name:                  data.Name,
 		Description:           data.Description,
 		DiscountType:          data.DiscountType,
-		DiscountValue:         data.DiscountValue,
-		MaximumDiscountAmount: data.MaximumDiscountAmount,
-		MinimumOrderAmount:    data.MinimumOrderAmount,
+		DiscountValue:         data.DiscountValue.String(),
+		MaximumDiscountAmount: toMoneyProto(data.MaximumDiscountAmount, common.DefaultCurrency),
+		MinimumOrderAmount:    toMoneyProto(data.MinimumOrderAmount, common.DefaultCurrency),
 		StartDate:             timestamppb.New(data.StartDate),
 		EndDate:               timestamppb.New(data.EndDate),
 		UsageLimit:            data.UsageLimit,
 		IsActive:              data.IsActive,
+		Scope:                 data.Scope,
+		CategoryId:            data.CategoryID,
+		UsageLimitPerUser:     data.UsageLimitPerUser,
 	})
 
 	if err != nil {
+		st, _ := status.FromError(err)
+
+		if st.Code() == codes.InvalidArgument {
+			return utils.BusinessError{
+				Code:      http.StatusBadRequest,
+				Message:   st.Message(),
+				ErrorCode: errorcode.BAD_REQUEST,
+			}
+		}
+
 		return utils.TechnicalError{
 			Code:    http.StatusInternalServerError,
 			Message: common.MSG_INTERNAL_ERROR,
@@ -240,3 +281,146 @@ func (service *couponService) DeleteCouponByID(ctx context.Context, couponID str
 
 	return nil
 }
+
+func (service *couponService) CreateCouponCampaign(ctx context.Context, data *api_gateway_dto.CreateCouponCampaignRequest, userID int) (*api_gateway_dto.CreateCouponCampaignResponse, error) {
+	ctx, span := service.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CreateCouponCampaign"))
+	defer span.End()
+
+	res, err := service.orderClient.CreateCouponCampaign(ctx, &order_proto_gen.CreateCouponCampaignRequest{
+		Name:                  data.Name,
+		Description:           data.Description,
+		DiscountType:          data.DiscountType,
+		DiscountValue:         data.DiscountValue.String(),
+		MaximumDiscountAmount: toMoneyProto(data.MaximumDiscountAmount, data.Currency),
+		MinimumOrderAmount:    toMoneyProto(data.MinimumOrderAmount, data.Currency),
+		Currency:              data.Currency,
+		StartDate:             timestamppb.New(data.StartDate),
+		EndDate:               timestamppb.New(data.EndDate),
+		Scope:                 data.Scope,
+		CategoryId:            data.CategoryID,
+		CodePrefix:            data.CodePrefix,
+		TotalCodes:            data.TotalCodes,
+		UserIds:               data.UserIDs,
+		CreatedBy:             int64(userID),
+	})
+
+	if err != nil {
+		return nil, handleCouponCampaignError(err)
+	}
+
+	return &api_gateway_dto.CreateCouponCampaignResponse{
+		ID:     res.Id,
+		Status: res.Status,
+	}, nil
+}
+
+func (service *couponService) GetCouponCampaigns(ctx context.Context, data *api_gateway_dto.GetCouponCampaignsRequest) ([]api_gateway_dto.GetCouponCampaignsResponse, int, int, bool, bool, error) {
+	ctx, span := service.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetCouponCampaigns"))
+	defer span.End()
+
+	res, err := service.orderClient.GetCouponCampaigns(ctx, &order_proto_gen.GetCouponCampaignsRequest{
+		Limit:  data.Limit,
+		Page:   data.Page,
+		Status: data.Status,
+	})
+
+	if err != nil {
+		return nil, 0, 0, false, false, utils.TechnicalError{
+			Code:    http.StatusInternalServerError,
+			Message: common.MSG_INTERNAL_ERROR,
+		}
+	}
+
+	result := make([]api_gateway_dto.GetCouponCampaignsResponse, 0)
+
+	for _, campaign := range res.Data {
+		result = append(result, api_gateway_dto.GetCouponCampaignsResponse{
+			ID:                    campaign.Id,
+			Name:                  campaign.Name,
+			DiscountType:          campaign.DiscountType,
+			DiscountValue:         parseDiscountValue(campaign.DiscountValue),
+			MaximumDiscountAmount: fromMoneyProto(campaign.MaximumDiscountAmount),
+			MinimumOrderAmount:    fromMoneyProto(campaign.MinimumOrderAmount),
+			Currency:              campaign.Currency,
+			StartDate:             campaign.StartDate.AsTime(),
+			EndDate:               campaign.EndDate.AsTime(),
+			Scope:                 campaign.Scope,
+			CategoryID:            campaign.CategoryId,
+			CodePrefix:            campaign.CodePrefix,
+			TotalCodes:            campaign.TotalCodes,
+			GeneratedCodes:        campaign.GeneratedCodes,
+			UsedCodes:             campaign.UsedCodes,
+			RevokedCodes:          campaign.RevokedCodes,
+			Status:                campaign.Status,
+			CreatedAt:             campaign.CreatedAt.AsTime(),
+		})
+	}
+
+	return result, int(res.Metadata.TotalItems), int(res.Metadata.TotalPages), res.Metadata.HasNext, res.Metadata.HasPrevious, nil
+}
+
+func (service *couponService) ExportCouponCampaignCodes(ctx context.Context, campaignID string) (*api_gateway_dto.ExportCouponCampaignCodesResponse, error) {
+	ctx, span := service.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "ExportCouponCampaignCodes"))
+	defer span.End()
+
+	res, err := service.orderClient.ExportCouponCampaignCodes(ctx, &order_proto_gen.ExportCouponCampaignCodesRequest{
+		CampaignId: campaignID,
+	})
+
+	if err != nil {
+		return nil, handleCouponCampaignError(err)
+	}
+
+	return &api_gateway_dto.ExportCouponCampaignCodesResponse{
+		DownloadURL: res.DownloadUrl,
+	}, nil
+}
+
+func (service *couponService) RevokeCouponCampaignCodes(ctx context.Context, campaignID string, data *api_gateway_dto.RevokeCouponCampaignCodesRequest) (*api_gateway_dto.RevokeCouponCampaignCodesResponse, error) {
+	ctx, span := service.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "RevokeCouponCampaignCodes"))
+	defer span.End()
+
+	res, err := service.orderClient.RevokeCouponCampaignCodes(ctx, &order_proto_gen.RevokeCouponCampaignCodesRequest{
+		CampaignId: campaignID,
+		CouponIds:  data.CouponIDs,
+	})
+
+	if err != nil {
+		return nil, handleCouponCampaignError(err)
+	}
+
+	return &api_gateway_dto.RevokeCouponCampaignCodesResponse{
+		RevokedCodes: res.RevokedCodes,
+	}, nil
+}
+
+func handleCouponCampaignError(err error) error {
+	st, _ := status.FromError(err)
+
+	switch st.Code() {
+	case codes.NotFound:
+		return utils.BusinessError{
+			Message:   st.Message(),
+			Code:      http.StatusNotFound,
+			ErrorCode: errorcode.NOT_FOUND,
+		}
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		return utils.BusinessError{
+			Message:   st.Message(),
+			Code:      http.StatusBadRequest,
+			ErrorCode: errorcode.BAD_REQUEST,
+		}
+	}
+
+	return utils.TechnicalError{
+		Message: common.MSG_INTERNAL_ERROR,
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+// parseDiscountValue reads discount value which order service formats from numeric, so it is always valid decimal
+func parseDiscountValue(value string) money.Money {
+	discountValue, _ := money.Parse(value)
+
+	return discountValue
+}
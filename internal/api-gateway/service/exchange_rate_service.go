@@ -0,0 +1,162 @@
+package api_gateway_service
+
+import (
+	"context"
+	"fmt"
+	api_gateway_dto "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/dto"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/utils"
+	"github.com/TienMinh25/ecommerce-platform/internal/utils/errorcode"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+)
+
+type exchangeRateService struct {
+	tracer      pkg.Tracer
+	orderClient order_proto_gen.OrderServiceClient
+}
+
+func NewExchangeRateService(tracer pkg.Tracer, orderClient order_proto_gen.OrderServiceClient) IExchangeRateService {
+	return &exchangeRateService{
+		tracer:      tracer,
+		orderClient: orderClient,
+	}
+}
+
+func (s *exchangeRateService) GetExchangeRates(ctx context.Context) (*api_gateway_dto.GetExchangeRatesResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetExchangeRates"))
+	defer span.End()
+
+	res, err := s.orderClient.GetExchangeRates(ctx, &order_proto_gen.GetExchangeRatesRequest{})
+
+	if err != nil {
+		return nil, utils.TechnicalError{
+			Code:    http.StatusInternalServerError,
+			Message: common.MSG_INTERNAL_ERROR,
+		}
+	}
+
+	result := make([]api_gateway_dto.ExchangeRateResponse, 0, len(res.Data))
+
+	for _, exchangeRate := range res.Data {
+		rate, _ := money.ParseRate(exchangeRate.Rate)
+
+		result = append(result, api_gateway_dto.ExchangeRateResponse{
+			Currency:  exchangeRate.Currency,
+			Rate:      rate,
+			UpdatedAt: exchangeRate.UpdatedAt.AsTime(),
+		})
+	}
+
+	return &api_gateway_dto.GetExchangeRatesResponse{
+		BaseCurrency: res.BaseCurrency,
+		Rates:        result,
+	}, nil
+}
+
+func (s *exchangeRateService) UpdateExchangeRates(ctx context.Context, data *api_gateway_dto.UpdateExchangeRatesRequest) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "UpdateExchangeRates"))
+	defer span.End()
+
+	rates := make([]*order_proto_gen.ExchangeRateRequest, 0, len(data.Rates))
+
+	for _, item := range data.Rates {
+		rates = append(rates, &order_proto_gen.ExchangeRateRequest{
+			Currency: item.Currency,
+			Rate:     item.Rate.String(),
+		})
+	}
+
+	_, err := s.orderClient.UpdateExchangeRates(ctx, &order_proto_gen.UpdateExchangeRatesRequest{
+		Rates: rates,
+	})
+
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		if st.Code() == codes.InvalidArgument {
+			return utils.BusinessError{
+				Message:   st.Message(),
+				Code:      http.StatusBadRequest,
+				ErrorCode: errorcode.BAD_REQUEST,
+			}
+		}
+
+		return utils.TechnicalError{
+			Message: common.MSG_INTERNAL_ERROR,
+			Code:    http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
+// exchangeRates are rates to base currency by currency, rate of base currency is included
+type exchangeRates money.Rates
+
+// getExchangeRates loads rates from order server and checks display currency has rate
+func getExchangeRates(ctx context.Context, orderClient order_proto_gen.OrderServiceClient, currency string) (exchangeRates, error) {
+	res, err := orderClient.GetExchangeRates(ctx, &order_proto_gen.GetExchangeRatesRequest{})
+
+	if err != nil {
+		return nil, utils.TechnicalError{
+			Code:    http.StatusInternalServerError,
+			Message: common.MSG_INTERNAL_ERROR,
+		}
+	}
+
+	rates := exchangeRates{res.BaseCurrency: money.OneRate}
+
+	for _, exchangeRate := range res.Data {
+		rate, err := money.ParseRate(exchangeRate.Rate)
+
+		if err != nil {
+			continue
+		}
+
+		rates[exchangeRate.Currency] = rate
+	}
+
+	if _, ok := rates[currency]; !ok {
+		return nil, utils.BusinessError{
+			Message:   fmt.Sprintf("Exchange rate of currency %s is not set", currency),
+			Code:      http.StatusBadRequest,
+			ErrorCode: errorcode.BAD_REQUEST,
+		}
+	}
+
+	return rates, nil
+}
+
+// convert returns price of currency from in currency to with currency of result,
+// price is kept in currency from when rate of from is not set
+func (r exchangeRates) convert(price float64, from, to string) (money.Money, string) {
+	return r.convertMoney(money.FromFloat(price), from, to)
+}
+
+// convertMoney is convert for amount which is already exact, it rounds like order server which charges amount
+func (r exchangeRates) convertMoney(amount money.Money, from, to string) (money.Money, string) {
+	converted, err := money.Rates(r).Convert(amount, from, to)
+
+	if err != nil {
+		return amount, from
+	}
+
+	return converted, to
+}
+
+// strings returns rates as decimal text for partner server
+func (r exchangeRates) strings() map[string]string {
+	result := make(map[string]string, len(r))
+
+	for currency, rate := range r {
+		result[currency] = rate.String()
+	}
+
+	return result
+}
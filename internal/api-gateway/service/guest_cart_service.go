@@ -0,0 +1,374 @@
+package api_gateway_service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	api_gateway_dto "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/dto"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/env"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/utils"
+	"github.com/TienMinh25/ecommerce-platform/internal/utils/errorcode"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// guestCartItem is item of guest cart, product variant id is used as cart item id
+type guestCartItem struct {
+	ProductID        string `json:"product_id"`
+	ProductVariantID string `json:"product_variant_id"`
+	Quantity         int64  `json:"quantity"`
+}
+
+type guestCartService struct {
+	tracer        pkg.Tracer
+	redis         pkg.ICache
+	env           *env.EnvManager
+	orderClient   order_proto_gen.OrderServiceClient
+	partnerClient partner_proto_gen.PartnerServiceClient
+}
+
+func NewGuestCartService(tracer pkg.Tracer, redis pkg.ICache, env *env.EnvManager,
+	orderClient order_proto_gen.OrderServiceClient, partnerClient partner_proto_gen.PartnerServiceClient) IGuestCartService {
+	return &guestCartService{
+		tracer:        tracer,
+		redis:         redis,
+		env:           env,
+		orderClient:   orderClient,
+		partnerClient: partnerClient,
+	}
+}
+
+func (g *guestCartService) AddItem(ctx context.Context, token string, data api_gateway_dto.AddItemToCartRequest) (string, error) {
+	ctx, span := g.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "AddItem"))
+	defer span.End()
+
+	// token which is missing or not signed by gateway starts new guest cart
+	cartID, ok := g.parseToken(token)
+
+	if !ok {
+		cartID = uuid.NewString()
+		token = g.newToken(cartID)
+	}
+
+	items, err := g.getItems(ctx, cartID)
+
+	if err != nil {
+		return "", err
+	}
+
+	idx := slices.IndexFunc(items, func(item guestCartItem) bool {
+		return item.ProductVariantID == data.ProductVariantID
+	})
+
+	if idx < 0 {
+		items = append(items, guestCartItem{
+			ProductID:        data.ProductID,
+			ProductVariantID: data.ProductVariantID,
+		})
+		idx = len(items) - 1
+	}
+
+	items[idx].Quantity += data.Quantity
+
+	if err = g.checkAvailable(ctx, items[idx]); err != nil {
+		return "", err
+	}
+
+	if err = g.saveItems(ctx, cartID, items); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (g *guestCartService) GetItems(ctx context.Context, token string, currency string) ([]api_gateway_dto.GetCartItemsResponse, error) {
+	ctx, span := g.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetItems"))
+	defer span.End()
+
+	if currency == "" {
+		currency = common.DefaultCurrency
+	}
+
+	rates, err := getExchangeRates(ctx, g.orderClient, currency)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cartID, ok := g.parseToken(token)
+
+	if !ok {
+		return []api_gateway_dto.GetCartItemsResponse{}, nil
+	}
+
+	items, err := g.getItems(ctx, cartID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cartItems := make([]*order_proto_gen.CartResponse, 0, len(items))
+
+	for _, item := range items {
+		cartItems = append(cartItems, &order_proto_gen.CartResponse{
+			CartItemId:       item.ProductVariantID,
+			ProductId:        item.ProductID,
+			ProductVariantId: item.ProductVariantID,
+			Quantity:         item.Quantity,
+		})
+	}
+
+	return toCartItemsResponse(ctx, g.partnerClient, cartItems, rates, currency)
+}
+
+func (g *guestCartService) UpdateItem(ctx context.Context, token string, productVariantID string, quantity int64) (*api_gateway_dto.UpdateGuestCartItemResponse, error) {
+	ctx, span := g.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "UpdateItem"))
+	defer span.End()
+
+	notFoundErr := utils.BusinessError{
+		Message:   "Cart item not found",
+		Code:      http.StatusNotFound,
+		ErrorCode: errorcode.NOT_FOUND,
+	}
+
+	cartID, ok := g.parseToken(token)
+
+	if !ok {
+		return nil, notFoundErr
+	}
+
+	items, err := g.getItems(ctx, cartID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	idx := slices.IndexFunc(items, func(item guestCartItem) bool {
+		return item.ProductVariantID == productVariantID
+	})
+
+	if idx < 0 {
+		return nil, notFoundErr
+	}
+
+	if quantity == 0 {
+		items = slices.Delete(items, idx, idx+1)
+	} else {
+		items[idx].Quantity = quantity
+
+		if err = g.checkAvailable(ctx, items[idx]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = g.saveItems(ctx, cartID, items); err != nil {
+		return nil, err
+	}
+
+	return &api_gateway_dto.UpdateGuestCartItemResponse{
+		ProductVariantID: productVariantID,
+		Quantity:         quantity,
+	}, nil
+}
+
+func (g *guestCartService) RemoveItems(ctx context.Context, token string, productVariantIDs []string) error {
+	ctx, span := g.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "RemoveItems"))
+	defer span.End()
+
+	cartID, ok := g.parseToken(token)
+
+	if !ok {
+		return nil
+	}
+
+	items, err := g.getItems(ctx, cartID)
+
+	if err != nil {
+		return err
+	}
+
+	items = slices.DeleteFunc(items, func(item guestCartItem) bool {
+		return slices.Contains(productVariantIDs, item.ProductVariantID)
+	})
+
+	return g.saveItems(ctx, cartID, items)
+}
+
+func (g *guestCartService) MergeIntoUserCart(ctx context.Context, token string, userID int) error {
+	ctx, span := g.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "MergeIntoUserCart"))
+	defer span.End()
+
+	cartID, ok := g.parseToken(token)
+
+	if !ok {
+		return nil
+	}
+
+	items, err := g.getItems(ctx, cartID)
+
+	if err != nil {
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	in := &order_proto_gen.MergeGuestCartRequest{
+		UserId: int64(userID),
+		Items:  make([]*order_proto_gen.GuestCartItemRequest, 0, len(items)),
+	}
+
+	for _, item := range items {
+		in.Items = append(in.Items, &order_proto_gen.GuestCartItemRequest{
+			ProductVariantId: item.ProductVariantID,
+			Quantity:         item.Quantity,
+		})
+	}
+
+	if _, err = g.orderClient.MergeGuestCart(ctx, in); err != nil {
+		span.RecordError(err)
+		return utils.TechnicalError{
+			Message: common.MSG_INTERNAL_ERROR,
+			Code:    http.StatusInternalServerError,
+		}
+	}
+
+	// guest cart is removed after merge, so it is not merged again by next login
+	if err = g.redis.Delete(ctx, guestCartKey(cartID)); err != nil {
+		span.RecordError(err)
+		return utils.TechnicalError{
+			Message: common.MSG_INTERNAL_ERROR,
+			Code:    http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
+// checkAvailable checks inventory of product is enough for quantity of item
+func (g *guestCartService) checkAvailable(ctx context.Context, item guestCartItem) error {
+	_, err := g.partnerClient.CheckAvailableProduct(ctx, &partner_proto_gen.CheckAvailableProductRequest{
+		ProductVariantId: item.ProductVariantID,
+		Quantity:         item.Quantity,
+	})
+
+	if err == nil {
+		return nil
+	}
+
+	st, _ := status.FromError(err)
+
+	switch st.Code() {
+	case codes.NotFound:
+		return utils.BusinessError{
+			Message:   "Product not found",
+			Code:      http.StatusNotFound,
+			ErrorCode: errorcode.NOT_FOUND,
+		}
+	case codes.Canceled:
+		return utils.BusinessError{
+			Message:   st.Message(),
+			Code:      http.StatusBadRequest,
+			ErrorCode: errorcode.BAD_REQUEST,
+		}
+	}
+
+	return utils.TechnicalError{
+		Message: common.MSG_INTERNAL_ERROR,
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func (g *guestCartService) getItems(ctx context.Context, cartID string) ([]guestCartItem, error) {
+	raw, err := g.redis.Get(ctx, guestCartKey(cartID))
+
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return []guestCartItem{}, nil
+		}
+
+		return nil, utils.TechnicalError{
+			Message: common.MSG_INTERNAL_ERROR,
+			Code:    http.StatusInternalServerError,
+		}
+	}
+
+	var items []guestCartItem
+
+	if err = json.Unmarshal([]byte(raw), &items); err != nil {
+		return nil, utils.TechnicalError{
+			Message: common.MSG_INTERNAL_ERROR,
+			Code:    http.StatusInternalServerError,
+		}
+	}
+
+	return items, nil
+}
+
+// saveItems saves items of guest cart, ttl is renewed on every change
+func (g *guestCartService) saveItems(ctx context.Context, cartID string, items []guestCartItem) error {
+	raw, err := json.Marshal(items)
+
+	if err != nil {
+		return utils.TechnicalError{
+			Message: common.MSG_INTERNAL_ERROR,
+			Code:    http.StatusInternalServerError,
+		}
+	}
+
+	if err = g.redis.Set(ctx, guestCartKey(cartID), raw, time.Duration(g.env.GuestCartTTL)*24*time.Hour); err != nil {
+		return utils.TechnicalError{
+			Message: common.MSG_INTERNAL_ERROR,
+			Code:    http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
+// newToken returns token of guest cart as id.signature, signature is hmac sha256 of id
+func (g *guestCartService) newToken(cartID string) string {
+	return cartID + "." + g.sign(cartID)
+}
+
+// parseToken returns id of guest cart when token is signed by gateway
+func (g *guestCartService) parseToken(token string) (string, bool) {
+	cartID, signature, found := strings.Cut(token, ".")
+
+	if !found || cartID == "" {
+		return "", false
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(g.sign(cartID))) {
+		return "", false
+	}
+
+	return cartID, true
+}
+
+func (g *guestCartService) sign(cartID string) string {
+	mac := hmac.New(sha256.New, []byte(g.env.GuestCartSecret))
+	mac.Write([]byte(cartID))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func guestCartKey(cartID string) string {
+	return fmt.Sprintf("guest_cart:%v", cartID)
+}
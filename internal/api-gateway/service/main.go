@@ -16,8 +16,11 @@ type IAdminAddressTypeService interface {
 }
 
 type IAuthenticationService interface {
+	// Register keeps guest cart, it is merged by first login after email is verified
 	Register(ctx context.Context, data api_gateway_dto.RegisterRequest) (*api_gateway_dto.RegisterResponse, error)
-	Login(ctx context.Context, data api_gateway_dto.LoginRequest) (*api_gateway_dto.LoginResponse, error)
+	// Login and ExchangeOAuthCode merge guest cart of guestCartToken into cart of user who has verified email,
+	// bool result reports whether guest cart was merged
+	Login(ctx context.Context, data api_gateway_dto.LoginRequest, guestCartToken string) (*api_gateway_dto.LoginResponse, bool, error)
 	VerifyEmail(ctx context.Context, data api_gateway_dto.VerifyEmailRequest) error
 	Logout(ctx context.Context, data api_gateway_dto.LogoutRequest, userID int) error
 	ResendVerifyEmail(ctx context.Context, data api_gateway_dto.ResendVerifyEmailRequest) error
@@ -27,7 +30,7 @@ type IAuthenticationService interface {
 	ChangePassword(ctx context.Context, data api_gateway_dto.ChangePasswordRequest, userID int) error
 	CheckToken(ctx context.Context, email string) (*api_gateway_dto.CheckTokenResponse, error)
 	GetAuthorizationURL(ctx context.Context, data api_gateway_dto.GetAuthorizationURLRequest) (string, error)
-	ExchangeOAuthCode(ctx context.Context, data api_gateway_dto.ExchangeOauthCodeRequest) (*api_gateway_dto.ExchangeOauthCodeResponse, error)
+	ExchangeOAuthCode(ctx context.Context, data api_gateway_dto.ExchangeOauthCodeRequest, guestCartToken string) (*api_gateway_dto.ExchangeOauthCodeResponse, bool, error)
 }
 
 type IModuleService interface {
@@ -95,8 +98,26 @@ type IUserMeService interface {
 	AddCartItem(ctx context.Context, data api_gateway_dto.AddItemToCartRequest, userID int) error
 	DeleteCartItems(ctx context.Context, cartItemIDs []string, userID int) error
 	UpdateCartItem(ctx context.Context, data api_gateway_dto.UpdateCartItemRequest, cartItemID string, userID int) (*api_gateway_dto.UpdateCartItemResponse, error)
-	GetCartItems(ctx context.Context, userID int) ([]api_gateway_dto.GetCartItemsResponse, error)
+	GetCartItems(ctx context.Context, userID int, currency string) ([]api_gateway_dto.GetCartItemsResponse, error)
+	// AcknowledgeCartItemChanges clears price change warnings of cart items
+	AcknowledgeCartItemChanges(ctx context.Context, cartItemIDs []string, userID int) error
 	GetMyOrders(ctx context.Context, data api_gateway_servicedto.GetMyOrdersRequest) ([]api_gateway_dto.GetMyOrdersResponse, int, int, bool, bool, error)
+	CancelOrderItem(ctx context.Context, data api_gateway_dto.CancelOrderItemRequest, orderItemID string, userID int) error
+	GetOrderItemTimeline(ctx context.Context, orderItemID string, userID int) ([]api_gateway_dto.OrderItemTimelineResponse, error)
+	ReorderToCart(ctx context.Context, orderID string, userID int) (*api_gateway_dto.ReorderToCartResponse, error)
+
+	// wishlist
+	AddWishlistItem(ctx context.Context, data api_gateway_dto.AddWishlistItemRequest, userID int) (*api_gateway_dto.AddWishlistItemResponse, error)
+	GetWishlistItems(ctx context.Context, userID int, currency string) ([]api_gateway_dto.GetWishlistItemsResponse, error)
+	DeleteWishlistItems(ctx context.Context, wishlistItemIDs []string, userID int) error
+	MoveWishlistItemToCart(ctx context.Context, wishlistItemID string, quantity int64, userID int) error
+	MoveCartItemToWishlist(ctx context.Context, cartItemID string, userID int) (*api_gateway_dto.MoveCartItemToWishlistResponse, error)
+
+	// saved payment methods
+	GetUserPaymentMethods(ctx context.Context, userID int) ([]api_gateway_dto.UserPaymentMethodResponse, error)
+	CreateUserPaymentMethod(ctx context.Context, data api_gateway_dto.CreateUserPaymentMethodRequest, userID int) (*api_gateway_dto.UserPaymentMethodResponse, error)
+	SetDefaultUserPaymentMethod(ctx context.Context, userPaymentMethodID int64, userID int) error
+	DeleteUserPaymentMethod(ctx context.Context, userPaymentMethodID int64, userID int) error
 }
 
 type IRoleService interface {
@@ -120,23 +141,53 @@ type ICategoryService interface {
 
 type IProductService interface {
 	GetProducts(ctx context.Context, data *api_gateway_dto.GetProductsRequest) ([]api_gateway_dto.GetProductsResponse, int, int, bool, bool, error)
-	GetProductByID(ctx context.Context, productID string) (*api_gateway_dto.GetProductDetailResponse, error)
+	GetProductByID(ctx context.Context, productID string, currency string) (*api_gateway_dto.GetProductDetailResponse, error)
 	GetProductReviews(ctx context.Context, data api_gateway_dto.GetProductReviewsRequest) ([]api_gateway_dto.GetProductReviewsResponse, int, int, bool, bool, error)
 }
 
 type ICouponService interface {
 	GetCoupons(ctx context.Context, data *api_gateway_dto.GetCouponsRequest) ([]api_gateway_dto.GetCouponsResponse, int, int, bool, bool, error)
-	GetCouponByClient(ctx context.Context, data *api_gateway_dto.GetCouponsByClientRequest) ([]api_gateway_dto.GetCouponsResponse, int, int, bool, bool, error)
+	GetCouponByClient(ctx context.Context, data *api_gateway_dto.GetCouponsByClientRequest, userID int) ([]api_gateway_dto.GetCouponsResponse, int, int, bool, bool, error)
 	CreateCoupon(ctx context.Context, data *api_gateway_dto.CreateCouponRequest) error
 	GetDetailCouponByID(ctx context.Context, couponID string) (*api_gateway_dto.GetDetailCouponResponse, error)
 	UpdateCoupon(ctx context.Context, data *api_gateway_dto.UpdateCouponRequest, couponID string) error
 	DeleteCouponByID(ctx context.Context, couponID string) error
+	CreateCouponCampaign(ctx context.Context, data *api_gateway_dto.CreateCouponCampaignRequest, userID int) (*api_gateway_dto.CreateCouponCampaignResponse, error)
+	GetCouponCampaigns(ctx context.Context, data *api_gateway_dto.GetCouponCampaignsRequest) ([]api_gateway_dto.GetCouponCampaignsResponse, int, int, bool, bool, error)
+	ExportCouponCampaignCodes(ctx context.Context, campaignID string) (*api_gateway_dto.ExportCouponCampaignCodesResponse, error)
+	RevokeCouponCampaignCodes(ctx context.Context, campaignID string, data *api_gateway_dto.RevokeCouponCampaignCodesRequest) (*api_gateway_dto.RevokeCouponCampaignCodesResponse, error)
 }
 
 type IPaymentService interface {
 	GetPaymentMethods(ctx context.Context) ([]api_gateway_dto.GetPaymentMethodsResponse, error)
 	CreateOrder(ctx context.Context, data api_gateway_dto.CheckoutRequest, userID int) (*api_gateway_dto.CheckoutResponse, error)
-	UpdateOrderIPNMomo(ctx context.Context, data api_gateway_dto.UpdateOrderIPNMomoRequest) error
+	QuoteCheckout(ctx context.Context, data api_gateway_dto.CheckoutRequest, userID int) (*api_gateway_dto.QuoteCheckoutResponse, error)
+	HandlePaymentCallback(ctx context.Context, methodCode string, rawData []byte) (*api_gateway_dto.HandlePaymentCallbackResponse, error)
+	CreateRefund(ctx context.Context, data api_gateway_dto.CreateRefundRequest, userID int) (*api_gateway_dto.RefundResponse, error)
+	ProcessRefund(ctx context.Context, refundID string) (*api_gateway_dto.RefundResponse, error)
+	GetRefunds(ctx context.Context, paymentHistoryID string) ([]api_gateway_dto.RefundResponse, error)
+}
+
+type IShippingRateService interface {
+	GetShippingRates(ctx context.Context, data *api_gateway_dto.GetShippingRatesRequest) ([]api_gateway_dto.ShippingRateResponse, error)
+	CreateShippingRate(ctx context.Context, data *api_gateway_dto.CreateShippingRateRequest) (*api_gateway_dto.CreateShippingRateResponse, error)
+	UpdateShippingRate(ctx context.Context, data *api_gateway_dto.UpdateShippingRateRequest, shippingRateID int64) error
+	DeleteShippingRate(ctx context.Context, shippingRateID int64) error
+}
+
+type IGuestCartService interface {
+	// AddItem returns token of guest cart, new guest cart is started when token is empty or invalid
+	AddItem(ctx context.Context, token string, data api_gateway_dto.AddItemToCartRequest) (string, error)
+	GetItems(ctx context.Context, token string, currency string) ([]api_gateway_dto.GetCartItemsResponse, error)
+	UpdateItem(ctx context.Context, token string, productVariantID string, quantity int64) (*api_gateway_dto.UpdateGuestCartItemResponse, error)
+	RemoveItems(ctx context.Context, token string, productVariantIDs []string) error
+	// MergeIntoUserCart moves items of guest cart into cart of user, it does nothing when token is empty or invalid
+	MergeIntoUserCart(ctx context.Context, token string, userID int) error
+}
+
+type IExchangeRateService interface {
+	GetExchangeRates(ctx context.Context) (*api_gateway_dto.GetExchangeRatesResponse, error)
+	UpdateExchangeRates(ctx context.Context, data *api_gateway_dto.UpdateExchangeRatesRequest) error
 }
 
 type ISupplierService interface {
@@ -148,6 +199,7 @@ type ISupplierService interface {
 	UpdateRoleForUserRegisterSupplier(ctx context.Context, userID int) error
 	GetSupplierOrders(ctx context.Context, data api_gateway_dto.GetSupplierOrdersRequest, userID int) ([]api_gateway_dto.GetSupplierOrdersResponse, int, int, bool, bool, error)
 	UpdateOrderItem(ctx context.Context, data api_gateway_dto.UpdateOrderItemRequest, userID int, orderItemID string) error
+	GetOrderItemTimeline(ctx context.Context, orderItemID string, userID int) ([]api_gateway_dto.OrderItemTimelineResponse, error)
 }
 
 type IS3Service interface {
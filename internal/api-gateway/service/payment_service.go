@@ -2,8 +2,11 @@ package api_gateway_service
 
 import (
 	"context"
+	"fmt"
 	api_gateway_dto "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/dto"
+	api_gateway_repository "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/repository"
 	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/utils"
 	"github.com/TienMinh25/ecommerce-platform/internal/utils/errorcode"
@@ -13,17 +16,22 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"net/http"
+	"strings"
+	"time"
 )
 
 type paymentService struct {
-	tracer      pkg.Tracer
-	orderClient order_proto_gen.OrderServiceClient
+	tracer                           pkg.Tracer
+	orderClient                      order_proto_gen.OrderServiceClient
+	administrativeDivisionRepository api_gateway_repository.IAdministrativeDivisionRepository
 }
 
-func NewPaymentService(tracer pkg.Tracer, orderClient order_proto_gen.OrderServiceClient) IPaymentService {
+func NewPaymentService(tracer pkg.Tracer, orderClient order_proto_gen.OrderServiceClient,
+	administrativeDivisionRepository api_gateway_repository.IAdministrativeDivisionRepository) IPaymentService {
 	return &paymentService{
-		tracer:      tracer,
-		orderClient: orderClient,
+		tracer:                           tracer,
+		orderClient:                      orderClient,
+		administrativeDivisionRepository: administrativeDivisionRepository,
 	}
 }
 
@@ -61,6 +69,138 @@ func (s *paymentService) CreateOrder(ctx context.Context, data api_gateway_dto.C
 	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CreateOrder"))
 	defer span.End()
 
+	if err := s.validateShippingDestination(ctx, data.ShippingProvince, data.ShippingDistrict, data.ShippingWard); err != nil {
+		return nil, err
+	}
+
+	res, err := s.orderClient.CreateOrder(ctx, toCheckoutRequestProto(data, userID))
+
+	if err != nil {
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.InvalidArgument:
+			return nil, utils.BusinessError{
+				Code:      http.StatusBadRequest,
+				Message:   st.Message(),
+				ErrorCode: errorcode.BAD_REQUEST,
+			}
+		case codes.AlreadyExists, codes.Aborted:
+			// idempotency key is used by other checkout, or first checkout with it is in progress
+			return nil, utils.BusinessError{
+				Code:      http.StatusConflict,
+				Message:   st.Message(),
+				ErrorCode: errorcode.ALREADY_EXISTS,
+			}
+		case codes.FailedPrecondition:
+			return nil, utils.BusinessError{
+				Code:      http.StatusBadRequest,
+				Message:   st.Message(),
+				ErrorCode: errorcode.BAD_REQUEST,
+			}
+		case codes.NotFound:
+			return nil, utils.BusinessError{
+				Code:      http.StatusBadRequest,
+				Message:   st.Message(),
+				ErrorCode: errorcode.BAD_REQUEST,
+			}
+		}
+
+		return nil, utils.TechnicalError{
+			Code:    http.StatusInternalServerError,
+			Message: common.MSG_INTERNAL_ERROR,
+		}
+	}
+
+	return &api_gateway_dto.CheckoutResponse{
+		OrderID:    res.OrderId,
+		Status:     res.Status,
+		PaymentURL: res.PaymentUrl,
+	}, nil
+}
+
+func (s *paymentService) QuoteCheckout(ctx context.Context, data api_gateway_dto.CheckoutRequest, userID int) (*api_gateway_dto.QuoteCheckoutResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "QuoteCheckout"))
+	defer span.End()
+
+	if err := s.validateShippingDestination(ctx, data.ShippingProvince, data.ShippingDistrict, data.ShippingWard); err != nil {
+		return nil, err
+	}
+
+	res, err := s.orderClient.QuoteCheckout(ctx, toCheckoutRequestProto(data, userID))
+
+	if err != nil {
+		span.RecordError(err)
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.InvalidArgument, codes.FailedPrecondition, codes.NotFound:
+			return nil, utils.BusinessError{
+				Code:      http.StatusBadRequest,
+				Message:   st.Message(),
+				ErrorCode: errorcode.BAD_REQUEST,
+			}
+		}
+
+		return nil, utils.TechnicalError{
+			Code:    http.StatusInternalServerError,
+			Message: common.MSG_INTERNAL_ERROR,
+		}
+	}
+
+	result := &api_gateway_dto.QuoteCheckoutResponse{
+		Items:            make([]api_gateway_dto.QuoteCheckoutItemResponse, 0, len(res.Items)),
+		Suppliers:        make([]api_gateway_dto.QuoteCheckoutSupplierResponse, 0, len(res.Suppliers)),
+		SubTotal:         fromMoneyProto(res.SubTotal),
+		DiscountAmount:   fromMoneyProto(res.DiscountAmount),
+		TaxAmount:        fromMoneyProto(res.TaxAmount),
+		ShippingFee:      fromMoneyProto(res.ShippingFee),
+		TotalAmount:      fromMoneyProto(res.TotalAmount),
+		Currency:         res.TotalAmount.GetCurrencyCode(),
+		PricesIncludeTax: res.PricesIncludeTax,
+		Problems:         make([]api_gateway_dto.CheckoutProblemResponse, 0, len(res.Problems)),
+	}
+
+	for _, item := range res.Items {
+		result.Items = append(result.Items, api_gateway_dto.QuoteCheckoutItemResponse{
+			ProductVariantID: item.ProductVariantId,
+			SupplierID:       item.SupplierId,
+			Quantity:         item.Quantity,
+			UnitPrice:        fromMoneyProto(item.UnitPrice),
+			TotalPrice:       fromMoneyProto(item.TotalPrice),
+			DiscountAmount:   fromMoneyProto(item.DiscountAmount),
+			TaxAmount:        fromMoneyProto(item.TaxAmount),
+			ShippingFee:      fromMoneyProto(item.ShippingFee),
+			TotalAmount:      fromMoneyProto(item.TotalAmount),
+			CouponID:         item.CouponId,
+		})
+	}
+
+	for _, supplier := range res.Suppliers {
+		result.Suppliers = append(result.Suppliers, api_gateway_dto.QuoteCheckoutSupplierResponse{
+			SupplierID:     supplier.SupplierId,
+			SubTotal:       fromMoneyProto(supplier.SubTotal),
+			DiscountAmount: fromMoneyProto(supplier.DiscountAmount),
+			TaxAmount:      fromMoneyProto(supplier.TaxAmount),
+			ShippingFee:    fromMoneyProto(supplier.ShippingFee),
+			TotalAmount:    fromMoneyProto(supplier.TotalAmount),
+		})
+	}
+
+	for _, problem := range res.Problems {
+		result.Problems = append(result.Problems, api_gateway_dto.CheckoutProblemResponse{
+			Code:             problem.Code,
+			Message:          problem.Message,
+			ProductVariantID: problem.ProductVariantId,
+			CouponID:         problem.CouponId,
+		})
+	}
+
+	return result, nil
+}
+
+// toCheckoutRequestProto converts checkout of buyer into request of order service, it is shared by checkout and its quote
+func toCheckoutRequestProto(data api_gateway_dto.CheckoutRequest, userID int) *order_proto_gen.CheckoutRequest {
 	in := new(order_proto_gen.CheckoutRequest)
 
 	in.MethodType = string(data.MethodType)
@@ -68,6 +208,13 @@ func (s *paymentService) CreateOrder(ctx context.Context, data api_gateway_dto.C
 	in.RecipientPhone = data.RecipientPhone
 	in.RecipientName = data.RecipientName
 	in.UserId = int64(userID)
+	in.ClientIp = data.ClientIP
+	in.UserPaymentMethodId = data.UserPaymentMethodID
+	in.OrderCouponId = data.OrderCouponID
+	in.ShippingProvince = data.ShippingProvince
+	in.ShippingDistrict = data.ShippingDistrict
+	in.ShippingWard = data.ShippingWard
+	in.IdempotencyKey = data.IdempotencyKey
 
 	for _, item := range data.Items {
 		in.Items = append(in.Items, &order_proto_gen.CheckoutItemRequest{
@@ -78,24 +225,30 @@ func (s *paymentService) CreateOrder(ctx context.Context, data api_gateway_dto.C
 			ProductVariantImageUrl: item.ProductVariantImageURL,
 			Quantity:               item.Quantity,
 			EstimatedDeliveryDate:  timestamppb.New(item.EstimatedDeliveryDate),
-			ShippingFee:            item.ShippingFee,
 			CouponId:               item.CouponID,
 		})
 	}
 
-	res, err := s.orderClient.CreateOrder(ctx, in)
+	return in
+}
+
+func (s *paymentService) HandlePaymentCallback(ctx context.Context, methodCode string, rawData []byte) (*api_gateway_dto.HandlePaymentCallbackResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "HandlePaymentCallback"))
+	defer span.End()
+
+	// order service verifies signature by payment gateway, checks amount and ignore repeated callback,
+	// result of it is acknowledged in the way payment gateway expects
+	res, err := s.orderClient.HandlePaymentCallback(ctx, &order_proto_gen.HandlePaymentCallbackRequest{
+		MethodCode: methodCode,
+		RawData:    rawData,
+	})
 
 	if err != nil {
+		span.RecordError(err)
 		st, _ := status.FromError(err)
 
 		switch st.Code() {
-		case codes.FailedPrecondition:
-			return nil, utils.BusinessError{
-				Code:      http.StatusBadRequest,
-				Message:   st.Message(),
-				ErrorCode: errorcode.BAD_REQUEST,
-			}
-		case codes.NotFound:
+		case codes.InvalidArgument, codes.FailedPrecondition, codes.NotFound:
 			return nil, utils.BusinessError{
 				Code:      http.StatusBadRequest,
 				Message:   st.Message(),
@@ -109,37 +262,188 @@ func (s *paymentService) CreateOrder(ctx context.Context, data api_gateway_dto.C
 		}
 	}
 
-	return &api_gateway_dto.CheckoutResponse{
-		OrderID:    res.OrderId,
-		Status:     res.Status,
-		PaymentURL: res.PaymentUrl,
+	return &api_gateway_dto.HandlePaymentCallbackResponse{
+		StatusCode:  int(res.StatusCode),
+		ContentType: res.ContentType,
+		Body:        res.Body,
 	}, nil
 }
 
-func (s *paymentService) UpdateOrderIPNMomo(ctx context.Context, data api_gateway_dto.UpdateOrderIPNMomoRequest) error {
-	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "UpdateOrderIPNMomo"))
+func (s *paymentService) CreateRefund(ctx context.Context, data api_gateway_dto.CreateRefundRequest, userID int) (*api_gateway_dto.RefundResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CreateRefund"))
+	defer span.End()
+
+	res, err := s.orderClient.CreateRefund(ctx, &order_proto_gen.CreateRefundRequest{
+		PaymentHistoryId: data.PaymentHistoryID,
+		Amount:           toMoneyProto(data.Amount, common.DefaultCurrency),
+		ProcessedBy:      int64(userID),
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, s.handleRefundError(err)
+	}
+
+	return s.toRefundResponse(res.Refund), nil
+}
+
+func (s *paymentService) ProcessRefund(ctx context.Context, refundID string) (*api_gateway_dto.RefundResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "ProcessRefund"))
 	defer span.End()
 
-	var statusOrder string
+	res, err := s.orderClient.ProcessRefund(ctx, &order_proto_gen.ProcessRefundRequest{
+		RefundId: refundID,
+	})
 
-	if data.ResultCode != 0 && data.ResultCode != 9000 {
-		statusOrder = string(common.PaymentFailed)
-	} else {
-		statusOrder = string(common.Pending)
+	if err != nil {
+		span.RecordError(err)
+		return nil, s.handleRefundError(err)
 	}
 
-	// get order id and result code
-	_, err := s.orderClient.UpdateOrderStatusFromMomo(ctx, &order_proto_gen.UpdateOrderStatusFromMomoRequest{
-		OrderId: data.OrderID,
-		Status:  statusOrder,
+	return s.toRefundResponse(res.Refund), nil
+}
+
+func (s *paymentService) GetRefunds(ctx context.Context, paymentHistoryID string) ([]api_gateway_dto.RefundResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetRefunds"))
+	defer span.End()
+
+	res, err := s.orderClient.GetRefunds(ctx, &order_proto_gen.GetRefundsRequest{
+		PaymentHistoryId: paymentHistoryID,
 	})
 
 	if err != nil {
+		span.RecordError(err)
+		return nil, utils.TechnicalError{
+			Code:    http.StatusInternalServerError,
+			Message: common.MSG_INTERNAL_ERROR,
+		}
+	}
+
+	result := make([]api_gateway_dto.RefundResponse, 0, len(res.Refunds))
+
+	for _, refund := range res.Refunds {
+		result = append(result, *s.toRefundResponse(refund))
+	}
+
+	return result, nil
+}
+
+func (s *paymentService) handleRefundError(err error) error {
+	st, _ := status.FromError(err)
+
+	switch st.Code() {
+	case codes.NotFound:
+		return utils.BusinessError{
+			Code:      http.StatusNotFound,
+			Message:   st.Message(),
+			ErrorCode: errorcode.NOT_FOUND,
+		}
+	case codes.FailedPrecondition:
+		return utils.BusinessError{
+			Code:      http.StatusBadRequest,
+			Message:   st.Message(),
+			ErrorCode: errorcode.BAD_REQUEST,
+		}
+	}
+
+	return utils.TechnicalError{
+		Code:    http.StatusInternalServerError,
+		Message: common.MSG_INTERNAL_ERROR,
+	}
+}
+
+func (s *paymentService) toRefundResponse(refund *order_proto_gen.RefundResponse) *api_gateway_dto.RefundResponse {
+	var refundedAt *time.Time
+
+	if refund.RefundedAt != nil {
+		t := refund.RefundedAt.AsTime()
+		refundedAt = &t
+	}
+
+	return &api_gateway_dto.RefundResponse{
+		ID:               refund.Id,
+		PaymentHistoryID: refund.PaymentHistoryId,
+		Amount:           fromMoneyProto(refund.Amount),
+		Status:           refund.Status,
+		TransactionID:    refund.TransactionId,
+		ProcessedBy:      refund.ProcessedBy,
+		RefundedAt:       refundedAt,
+	}
+}
+
+// validateShippingDestination checks province, district and ward of shipping address with administrative divisions,
+// shipping fee is calculated by them, so they must be real divisions
+func (s *paymentService) validateShippingDestination(ctx context.Context, provinceName, districtName, wardName string) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "validateShippingDestination"))
+	defer span.End()
+
+	provinces, err := s.administrativeDivisionRepository.GetProvinces(ctx)
+
+	if err != nil {
+		span.RecordError(err)
 		return utils.TechnicalError{
 			Code:    http.StatusInternalServerError,
 			Message: common.MSG_INTERNAL_ERROR,
 		}
 	}
 
-	return nil
+	for _, province := range provinces {
+		if !strings.EqualFold(province.Name, strings.TrimSpace(provinceName)) {
+			continue
+		}
+
+		districts, err := s.administrativeDivisionRepository.GetDistrictsByProvinceID(ctx, province.ID)
+
+		if err != nil {
+			span.RecordError(err)
+			return utils.TechnicalError{
+				Code:    http.StatusInternalServerError,
+				Message: common.MSG_INTERNAL_ERROR,
+			}
+		}
+
+		for _, district := range districts {
+			if !strings.EqualFold(district.Name, strings.TrimSpace(districtName)) {
+				continue
+			}
+
+			wards, err := s.administrativeDivisionRepository.GetWardsByDistrictID(ctx, province.ID, district.ID)
+
+			if err != nil {
+				span.RecordError(err)
+				return utils.TechnicalError{
+					Code:    http.StatusInternalServerError,
+					Message: common.MSG_INTERNAL_ERROR,
+				}
+			}
+
+			for _, ward := range wards {
+				if strings.EqualFold(ward.Name, strings.TrimSpace(wardName)) {
+					return nil
+				}
+			}
+		}
+	}
+
+	return utils.BusinessError{
+		Code:      http.StatusBadRequest,
+		Message:   fmt.Sprintf("Shipping address %s, %s, %s is not found", wardName, districtName, provinceName),
+		ErrorCode: errorcode.BAD_REQUEST,
+	}
+}
+
+// toMoneyProto converts amount into money of order service
+func toMoneyProto(amount money.Money, currency string) *order_proto_gen.Money {
+	units, nanos := amount.UnitsNanos()
+
+	return &order_proto_gen.Money{
+		CurrencyCode: currency,
+		Units:        units,
+		Nanos:        nanos,
+	}
+}
+
+// fromMoneyProto converts money of order service into amount, missing money is zero
+func fromMoneyProto(amount *order_proto_gen.Money) money.Money {
+	return money.FromUnitsNanos(amount.GetUnits(), amount.GetNanos())
 }
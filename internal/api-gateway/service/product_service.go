@@ -5,6 +5,8 @@ import (
 	api_gateway_dto "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/dto"
 	api_gateway_repository "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/repository"
 	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/utils"
 	"github.com/TienMinh25/ecommerce-platform/internal/utils/errorcode"
@@ -18,14 +20,16 @@ import (
 type productService struct {
 	tracer        pkg.Tracer
 	partnerClient partner_proto_gen.PartnerServiceClient
+	orderClient   order_proto_gen.OrderServiceClient
 	userRepo      api_gateway_repository.IUserRepository
 }
 
 func NewProductService(tracer pkg.Tracer, partnerClient partner_proto_gen.PartnerServiceClient,
-	userRepo api_gateway_repository.IUserRepository) IProductService {
+	orderClient order_proto_gen.OrderServiceClient, userRepo api_gateway_repository.IUserRepository) IProductService {
 	return &productService{
 		tracer:        tracer,
 		partnerClient: partnerClient,
+		orderClient:   orderClient,
 		userRepo:      userRepo,
 	}
 }
@@ -34,12 +38,25 @@ func (p *productService) GetProducts(ctx context.Context, data *api_gateway_dto.
 	ctx, span := p.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetProducts"))
 	defer span.End()
 
+	if data.Currency == "" {
+		data.Currency = common.DefaultCurrency
+	}
+
+	// partner server converts prices in query, so products with variants in many currencies are compared correctly
+	rates, err := getExchangeRates(ctx, p.orderClient, data.Currency)
+
+	if err != nil {
+		return nil, 0, 0, false, false, err
+	}
+
 	in := &partner_proto_gen.GetProductsRequest{
-		Limit:       data.Limit,
-		Page:        data.Page,
-		Keyword:     data.Keyword,
-		CategoryIds: data.CategoryIDs,
-		MinRating:   data.MinRating,
+		Limit:         data.Limit,
+		Page:          data.Page,
+		Keyword:       data.Keyword,
+		CategoryIds:   data.CategoryIDs,
+		MinRating:     data.MinRating,
+		Currency:      data.Currency,
+		ExchangeRates: rates.strings(),
 	}
 
 	products, err := p.partnerClient.GetProducts(ctx, in)
@@ -61,8 +78,8 @@ func (p *productService) GetProducts(ctx context.Context, data *api_gateway_dto.
 			ProductAverageRating: product.ProductAverageRating,
 			ProductTotalReviews:  product.ProductTotalReviews,
 			ProductCategoryID:    product.ProductCategoryId,
-			ProductPrice:         product.ProductPrice,
-			ProductDiscountPrice: product.ProductDiscountPrice,
+			ProductPrice:         money.FromFloat(product.ProductPrice),
+			ProductDiscountPrice: money.FromFloat(product.ProductDiscountPrice),
 			ProductCurrency:      product.ProductCurrency,
 		}
 	}
@@ -71,10 +88,20 @@ func (p *productService) GetProducts(ctx context.Context, data *api_gateway_dto.
 		products.Metadata.HasPrevious, nil
 }
 
-func (p *productService) GetProductByID(ctx context.Context, productID string) (*api_gateway_dto.GetProductDetailResponse, error) {
+func (p *productService) GetProductByID(ctx context.Context, productID string, currency string) (*api_gateway_dto.GetProductDetailResponse, error) {
 	ctx, span := p.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetProductByID"))
 	defer span.End()
 
+	if currency == "" {
+		currency = common.DefaultCurrency
+	}
+
+	rates, err := getExchangeRates(ctx, p.orderClient, currency)
+
+	if err != nil {
+		return nil, err
+	}
+
 	productAdaptRes, err := p.partnerClient.GetProductByID(ctx, &partner_proto_gen.GetProductDetailRequest{
 		ProductId: productID,
 	})
@@ -121,18 +148,21 @@ func (p *productService) GetProductByID(ctx context.Context, productID string) (
 				AttributeValue: attrValue.AttributeValue,
 			})
 		}
+		price, priceCurrency := rates.convert(variant.Price, variant.Currency, currency)
+		discountPrice, _ := rates.convert(variant.DiscountPrice, variant.Currency, currency)
+
 		variants = append(variants, api_gateway_dto.GetProductDetailVariantResponse{
 			ProductVariantID: variant.ProductVariantId,
 			SKU:              variant.Sku,
 			VariantName:      variant.VariantName,
-			Price:            variant.Price,
-			DiscountPrice:    variant.DiscountPrice,
+			Price:            price,
+			DiscountPrice:    discountPrice,
 			Quantity:         variant.Quantity,
 			IsDefault:        variant.IsDefault,
 			ShippingClass:    variant.ShippingClass,
 			ThumbnailURL:     variant.ThumbnailUrl,
 			AltTextThumbnail: variant.AltText,
-			Currency:         variant.Currency,
+			Currency:         priceCurrency,
 			AttributeValues:  attrValues,
 		})
 	}
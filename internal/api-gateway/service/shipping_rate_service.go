@@ -0,0 +1,144 @@
+package api_gateway_service
+
+import (
+	"context"
+	api_gateway_dto "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/dto"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/utils"
+	"github.com/TienMinh25/ecommerce-platform/internal/utils/errorcode"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+)
+
+type shippingRateService struct {
+	tracer      pkg.Tracer
+	orderClient order_proto_gen.OrderServiceClient
+}
+
+func NewShippingRateService(tracer pkg.Tracer, orderClient order_proto_gen.OrderServiceClient) IShippingRateService {
+	return &shippingRateService{
+		tracer:      tracer,
+		orderClient: orderClient,
+	}
+}
+
+func (s *shippingRateService) GetShippingRates(ctx context.Context, data *api_gateway_dto.GetShippingRatesRequest) ([]api_gateway_dto.ShippingRateResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetShippingRates"))
+	defer span.End()
+
+	res, err := s.orderClient.GetShippingRates(ctx, &order_proto_gen.GetShippingRatesRequest{
+		ShippingClass: data.ShippingClass,
+	})
+
+	if err != nil {
+		return nil, utils.TechnicalError{
+			Code:    http.StatusInternalServerError,
+			Message: common.MSG_INTERNAL_ERROR,
+		}
+	}
+
+	result := make([]api_gateway_dto.ShippingRateResponse, 0)
+
+	for _, shippingRate := range res.Data {
+		result = append(result, api_gateway_dto.ShippingRateResponse{
+			ID:              shippingRate.Id,
+			ShippingClass:   shippingRate.ShippingClass,
+			Zone:            shippingRate.Zone,
+			BaseFee:         fromMoneyProto(shippingRate.BaseFee),
+			BaseWeightGrams: shippingRate.BaseWeightGrams,
+			FeePerExtraKg:   fromMoneyProto(shippingRate.FeePerExtraKg),
+			CreatedAt:       shippingRate.CreatedAt.AsTime(),
+			UpdatedAt:       shippingRate.UpdatedAt.AsTime(),
+		})
+	}
+
+	return result, nil
+}
+
+func (s *shippingRateService) CreateShippingRate(ctx context.Context, data *api_gateway_dto.CreateShippingRateRequest) (*api_gateway_dto.CreateShippingRateResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CreateShippingRate"))
+	defer span.End()
+
+	res, err := s.orderClient.CreateShippingRate(ctx, &order_proto_gen.CreateShippingRateRequest{
+		ShippingClass:   data.ShippingClass,
+		Zone:            data.Zone,
+		BaseFee:         toMoneyProto(data.BaseFee, common.DefaultCurrency),
+		BaseWeightGrams: data.BaseWeightGrams,
+		FeePerExtraKg:   toMoneyProto(data.FeePerExtraKg, common.DefaultCurrency),
+	})
+
+	if err != nil {
+		return nil, handleShippingRateError(err)
+	}
+
+	return &api_gateway_dto.CreateShippingRateResponse{
+		ID: res.Id,
+	}, nil
+}
+
+func (s *shippingRateService) UpdateShippingRate(ctx context.Context, data *api_gateway_dto.UpdateShippingRateRequest, shippingRateID int64) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "UpdateShippingRate"))
+	defer span.End()
+
+	_, err := s.orderClient.UpdateShippingRate(ctx, &order_proto_gen.UpdateShippingRateRequest{
+		Id:              shippingRateID,
+		BaseFee:         toMoneyProto(data.BaseFee, common.DefaultCurrency),
+		BaseWeightGrams: data.BaseWeightGrams,
+		FeePerExtraKg:   toMoneyProto(data.FeePerExtraKg, common.DefaultCurrency),
+	})
+
+	if err != nil {
+		return handleShippingRateError(err)
+	}
+
+	return nil
+}
+
+func (s *shippingRateService) DeleteShippingRate(ctx context.Context, shippingRateID int64) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "DeleteShippingRate"))
+	defer span.End()
+
+	_, err := s.orderClient.DeleteShippingRate(ctx, &order_proto_gen.DeleteShippingRateRequest{
+		Id: shippingRateID,
+	})
+
+	if err != nil {
+		return handleShippingRateError(err)
+	}
+
+	return nil
+}
+
+func handleShippingRateError(err error) error {
+	st, _ := status.FromError(err)
+
+	switch st.Code() {
+	case codes.NotFound:
+		return utils.BusinessError{
+			Message:   st.Message(),
+			Code:      http.StatusNotFound,
+			ErrorCode: errorcode.NOT_FOUND,
+		}
+	case codes.AlreadyExists:
+		return utils.BusinessError{
+			Message:   st.Message(),
+			Code:      http.StatusConflict,
+			ErrorCode: errorcode.ALREADY_EXISTS,
+		}
+	case codes.InvalidArgument:
+		return utils.BusinessError{
+			Message:   st.Message(),
+			Code:      http.StatusBadRequest,
+			ErrorCode: errorcode.BAD_REQUEST,
+		}
+	}
+
+	return utils.TechnicalError{
+		Message: common.MSG_INTERNAL_ERROR,
+		Code:    http.StatusInternalServerError,
+	}
+}
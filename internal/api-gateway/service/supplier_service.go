@@ -41,7 +41,14 @@ func (s *supplierService) RegisterSupplier(ctx context.Context, data api_gateway
 	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "RegisterSupplier"))
 	defer span.End()
 
-	_, err := s.partnerClient.RegisterSupplier(ctx, &partner_proto_gen.RegisterSupplierRequest{
+	// business address is origin of shipping, so it must be address of user
+	businessAddress, err := s.addressRepository.GetAddressByID(ctx, int(data.BusinessAddressID), userID)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = s.partnerClient.RegisterSupplier(ctx, &partner_proto_gen.RegisterSupplierRequest{
 		CompanyName:       data.CompanyName,
 		ContactPhone:      data.ContactPhone,
 		TaxId:             data.TaxID,
@@ -54,7 +61,9 @@ func (s *supplierService) RegisterSupplier(ctx context.Context, data api_gateway
 			IdCardBack:      data.Documents.IDCardBack,
 			BusinessLicense: data.Documents.BusinessLicense,
 		},
-		UserId: int64(userID),
+		UserId:           int64(userID),
+		BusinessProvince: businessAddress.Province,
+		BusinessDistrict: businessAddress.District,
 	})
 
 	if err != nil {
@@ -320,11 +329,12 @@ func (s *supplierService) GetSupplierOrders(ctx context.Context, data api_gatewa
 			ProductVariantName:      item.ProductVariantName,
 			ProductVariantThumbnail: item.ProductThumbnailUrl,
 			Quantity:                item.Quantity,
-			UnitPrice:               item.UnitPrice,
-			TotalPrice:              item.TotalPrice,
-			DiscountAmount:          item.DiscountAmount,
-			TaxAmount:               item.TaxAmount,
-			ShippingFee:             item.ShippingFee,
+			UnitPrice:               fromMoneyProto(item.UnitPrice),
+			Currency:                item.UnitPrice.GetCurrencyCode(),
+			TotalPrice:              fromMoneyProto(item.TotalPrice),
+			DiscountAmount:          fromMoneyProto(item.DiscountAmount),
+			TaxAmount:               fromMoneyProto(item.TaxAmount),
+			ShippingFee:             fromMoneyProto(item.ShippingFee),
 			Status:                  common.StatusOrder(item.Status),
 			TrackingNumber:          item.TrackingNumber,
 			ShippingMethod:          common.MethodType(item.ShippingMethod),
@@ -349,9 +359,28 @@ func (s *supplierService) UpdateOrderItem(ctx context.Context, data api_gateway_
 		OrderItemId: orderItemID,
 		Status:      string(data.Status),
 		UserId:      int64(userID),
+		Notes:       data.Notes,
 	})
 
 	if err != nil {
+		span.RecordError(err)
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return utils.BusinessError{
+				Code:      http.StatusNotFound,
+				Message:   st.Message(),
+				ErrorCode: errorcode.NOT_FOUND,
+			}
+		case codes.FailedPrecondition:
+			return utils.BusinessError{
+				Code:      http.StatusBadRequest,
+				Message:   st.Message(),
+				ErrorCode: errorcode.BAD_REQUEST,
+			}
+		}
+
 		return utils.TechnicalError{
 			Code:    http.StatusInternalServerError,
 			Message: common.MSG_INTERNAL_ERROR,
@@ -360,3 +389,21 @@ func (s *supplierService) UpdateOrderItem(ctx context.Context, data api_gateway_
 
 	return nil
 }
+
+func (s *supplierService) GetOrderItemTimeline(ctx context.Context, orderItemID string, userID int) ([]api_gateway_dto.OrderItemTimelineResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetOrderItemTimeline"))
+	defer span.End()
+
+	res, err := s.orderClient.GetOrderItemTimeline(ctx, &order_proto_gen.GetOrderItemTimelineRequest{
+		OrderItemId: orderItemID,
+		UserId:      int64(userID),
+		Role:        string(common.ActorSupplier),
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, handleOrderItemTimelineError(err)
+	}
+
+	return toOrderItemTimelineResponse(res), nil
+}
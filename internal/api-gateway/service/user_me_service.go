@@ -7,6 +7,7 @@ import (
 	api_gateway_repository "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/repository"
 	api_gateway_servicedto "github.com/TienMinh25/ecommerce-platform/internal/api-gateway/service/dto"
 	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
 	"github.com/TienMinh25/ecommerce-platform/internal/notifications/transport/grpc/proto/notification_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
@@ -534,10 +535,20 @@ func (u *userMeService) UpdateCartItem(ctx context.Context, data api_gateway_dto
 	}, nil
 }
 
-func (u *userMeService) GetCartItems(ctx context.Context, userID int) ([]api_gateway_dto.GetCartItemsResponse, error) {
+func (u *userMeService) GetCartItems(ctx context.Context, userID int, currency string) ([]api_gateway_dto.GetCartItemsResponse, error) {
 	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetCartItems"))
 	defer span.End()
 
+	if currency == "" {
+		currency = common.DefaultCurrency
+	}
+
+	rates, err := getExchangeRates(ctx, u.orderClient, currency)
+
+	if err != nil {
+		return nil, err
+	}
+
 	// call order server to get information about cart item
 	cartResAdapt, err := u.orderClient.GetCart(ctx, &order_proto_gen.GetCartRequest{
 		UserId: int64(userID),
@@ -551,7 +562,13 @@ func (u *userMeService) GetCartItems(ctx context.Context, userID int) ([]api_gat
 		}
 	}
 
-	if len(cartResAdapt.CartResponse) == 0 {
+	return toCartItemsResponse(ctx, u.partnerClient, cartResAdapt.CartResponse, rates, currency)
+}
+
+// toCartItemsResponse adds product info of partner to cart items, it is shared by cart of user and guest cart
+func toCartItemsResponse(ctx context.Context, partnerClient partner_proto_gen.PartnerServiceClient,
+	cartItems []*order_proto_gen.CartResponse, rates exchangeRates, currency string) ([]api_gateway_dto.GetCartItemsResponse, error) {
+	if len(cartItems) == 0 {
 		return []api_gateway_dto.GetCartItemsResponse{}, nil
 	}
 
@@ -559,7 +576,7 @@ func (u *userMeService) GetCartItems(ctx context.Context, userID int) ([]api_gat
 	// call partner to get information about product info of each cart item
 	in := make([]*partner_proto_gen.ProductInfoCart, 0)
 
-	for idx, cartItem := range cartResAdapt.CartResponse {
+	for idx, cartItem := range cartItems {
 		in = append(in, &partner_proto_gen.ProductInfoCart{
 			ProductId:        cartItem.ProductId,
 			ProductVariantId: cartItem.ProductVariantId,
@@ -567,7 +584,7 @@ func (u *userMeService) GetCartItems(ctx context.Context, userID int) ([]api_gat
 		mapCartItem[cartItem.ProductVariantId] = idx
 	}
 
-	partnerProdCart, err := u.partnerClient.GetProductInfoCart(ctx, &partner_proto_gen.GetProductInfoCartRequest{
+	partnerProdCart, _ := partnerClient.GetProductInfoCart(ctx, &partner_proto_gen.GetProductInfoCartRequest{
 		Request: in,
 	})
 
@@ -578,28 +595,109 @@ func (u *userMeService) GetCartItems(ctx context.Context, userID int) ([]api_gat
 		}
 	}
 
-	result := make([]api_gateway_dto.GetCartItemsResponse, len(cartResAdapt.CartResponse))
+	result := make([]api_gateway_dto.GetCartItemsResponse, len(cartItems))
+
+	// items whose product is not returned by partner are still shown with their warnings
+	for idx, cartItem := range cartItems {
+		result[idx] = api_gateway_dto.GetCartItemsResponse{
+			CartItemID:       cartItem.CartItemId,
+			Quantity:         cartItem.Quantity,
+			ProductID:        cartItem.ProductId,
+			ProductVariantID: cartItem.ProductVariantId,
+			Currency:         currency,
+			Warnings:         toCartItemWarningsResponse(cartItem.Warnings, rates, currency),
+		}
+	}
 
 	for _, partnerProd := range partnerProdCart.ProductInfo {
 		idx := mapCartItem[partnerProd.ProductVariantId]
+		partnerPrice, errPrice := money.Parse(partnerProd.Price)
+		partnerDiscountPrice, errDiscountPrice := money.Parse(partnerProd.DiscountPrice)
 
-		result[idx] = api_gateway_dto.GetCartItemsResponse{
-			CartItemID:              cartResAdapt.CartResponse[idx].CartItemId,
-			ProductName:             partnerProd.ProductName,
-			Quantity:                cartResAdapt.CartResponse[idx].Quantity,
-			Price:                   partnerProd.Price,
-			DiscountPrice:           partnerProd.DiscountPrice,
-			ProductID:               partnerProd.ProductId,
-			ProductVariantID:        partnerProd.ProductVariantId,
-			ProductVariantThumbnail: partnerProd.ProductVariantThumbnail,
-			Currency:                partnerProd.Currency,
-			VariantName:             partnerProd.VariantName,
+		if errPrice != nil || errDiscountPrice != nil {
+			return nil, utils.TechnicalError{
+				Message: common.MSG_INTERNAL_ERROR,
+				Code:    http.StatusInternalServerError,
+			}
 		}
+
+		price, priceCurrency := rates.convertMoney(partnerPrice, partnerProd.Currency, currency)
+		discountPrice, _ := rates.convertMoney(partnerDiscountPrice, partnerProd.Currency, currency)
+
+		result[idx].ProductName = partnerProd.ProductName
+		result[idx].Price = price
+		result[idx].DiscountPrice = discountPrice
+		result[idx].ProductID = partnerProd.ProductId
+		result[idx].ProductVariantID = partnerProd.ProductVariantId
+		result[idx].ProductVariantThumbnail = partnerProd.ProductVariantThumbnail
+		result[idx].Currency = priceCurrency
+		result[idx].VariantName = partnerProd.VariantName
 	}
 
 	return result, nil
 }
 
+// toCartItemWarningsResponse converts prices of warnings into display currency
+func toCartItemWarningsResponse(warnings []*order_proto_gen.CartItemWarning, rates exchangeRates, currency string) []api_gateway_dto.CartItemWarningResponse {
+	result := make([]api_gateway_dto.CartItemWarningResponse, 0, len(warnings))
+
+	for _, warning := range warnings {
+		warningRes := api_gateway_dto.CartItemWarningResponse{
+			Type:        common.CartWarningType(warning.Type),
+			MaxQuantity: warning.MaxQuantity,
+		}
+
+		if warning.OldPrice != nil && warning.NewPrice != nil {
+			oldPrice, priceCurrency := rates.convertMoney(fromMoneyProto(warning.OldPrice), warning.OldPrice.GetCurrencyCode(), currency)
+			newPrice, _ := rates.convertMoney(fromMoneyProto(warning.NewPrice), warning.NewPrice.GetCurrencyCode(), priceCurrency)
+			warningRes.OldPrice = &oldPrice
+			warningRes.NewPrice = &newPrice
+			warningRes.Currency = priceCurrency
+		}
+
+		result = append(result, warningRes)
+	}
+
+	return result
+}
+
+func (u *userMeService) AcknowledgeCartItemChanges(ctx context.Context, cartItemIDs []string, userID int) error {
+	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "AcknowledgeCartItemChanges"))
+	defer span.End()
+
+	_, err := u.orderClient.AcknowledgeCartItemChanges(ctx, &order_proto_gen.AcknowledgeCartItemChangesRequest{
+		UserId:      int64(userID),
+		CartItemIds: cartItemIDs,
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		st, _ := status.FromError(err)
+
+		switch st.Code() {
+		case codes.NotFound:
+			return utils.BusinessError{
+				Message:   st.Message(),
+				Code:      http.StatusNotFound,
+				ErrorCode: errorcode.NOT_FOUND,
+			}
+		case codes.InvalidArgument:
+			return utils.BusinessError{
+				Message:   st.Message(),
+				Code:      http.StatusBadRequest,
+				ErrorCode: errorcode.BAD_REQUEST,
+			}
+		}
+
+		return utils.TechnicalError{
+			Message: common.MSG_INTERNAL_ERROR,
+			Code:    http.StatusInternalServerError,
+		}
+	}
+
+	return nil
+}
+
 func (u *userMeService) GetMyOrders(ctx context.Context, data api_gateway_servicedto.GetMyOrdersRequest) ([]api_gateway_dto.GetMyOrdersResponse, int, int, bool, bool, error) {
 	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetMyOrders"))
 	defer span.End()
@@ -647,11 +745,12 @@ func (u *userMeService) GetMyOrders(ctx context.Context, data api_gateway_servic
 			ProductVariantName:      item.ProductVariantName,
 			ProductVariantThumbnail: item.ProductThumbnailUrl,
 			Quantity:                item.Quantity,
-			UnitPrice:               item.UnitPrice,
-			TotalPrice:              item.TotalPrice,
-			DiscountAmount:          item.DiscountAmount,
-			TaxAmount:               item.TaxAmount,
-			ShippingFee:             item.ShippingFee,
+			UnitPrice:               fromMoneyProto(item.UnitPrice),
+			Currency:                item.UnitPrice.GetCurrencyCode(),
+			TotalPrice:              fromMoneyProto(item.TotalPrice),
+			DiscountAmount:          fromMoneyProto(item.DiscountAmount),
+			TaxAmount:               fromMoneyProto(item.TaxAmount),
+			ShippingFee:             fromMoneyProto(item.ShippingFee),
 			Status:                  common.StatusOrder(item.Status),
 			TrackingNumber:          item.TrackingNumber,
 			ShippingMethod:          common.MethodType(item.ShippingMethod),
@@ -667,3 +766,428 @@ func (u *userMeService) GetMyOrders(ctx context.Context, data api_gateway_servic
 
 	return result, int(resOrderClient.Metadata.TotalItems), int(resOrderClient.Metadata.TotalPages), resOrderClient.Metadata.HasNext, resOrderClient.Metadata.HasPrevious, nil
 }
+
+func (u *userMeService) CancelOrderItem(ctx context.Context, data api_gateway_dto.CancelOrderItemRequest, orderItemID string, userID int) error {
+	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CancelOrderItem"))
+	defer span.End()
+
+	_, err := u.orderClient.CancelOrderItem(ctx, &order_proto_gen.CancelOrderItemRequest{
+		UserId:          int64(userID),
+		OrderItemId:     orderItemID,
+		CancelledReason: data.CancelledReason,
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		st, _ := status.FromError(err)
+		switch st.Code() {
+		case codes.NotFound:
+			return utils.BusinessError{
+				Message:   st.Message(),
+				Code:      http.StatusNotFound,
+				ErrorCode: errorcode.NOT_FOUND,
+			}
+		case codes.FailedPrecondition:
+			return utils.BusinessError{
+				Message:   st.Message(),
+				Code:      http.StatusBadRequest,
+				ErrorCode: errorcode.BAD_REQUEST,
+			}
+		default:
+			return utils.TechnicalError{
+				Message: common.MSG_INTERNAL_ERROR,
+				Code:    http.StatusInternalServerError,
+			}
+		}
+	}
+
+	return nil
+}
+
+func (u *userMeService) GetOrderItemTimeline(ctx context.Context, orderItemID string, userID int) ([]api_gateway_dto.OrderItemTimelineResponse, error) {
+	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetOrderItemTimeline"))
+	defer span.End()
+
+	res, err := u.orderClient.GetOrderItemTimeline(ctx, &order_proto_gen.GetOrderItemTimelineRequest{
+		OrderItemId: orderItemID,
+		UserId:      int64(userID),
+		Role:        string(common.ActorCustomer),
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, handleOrderItemTimelineError(err)
+	}
+
+	return toOrderItemTimelineResponse(res), nil
+}
+
+// handleOrderItemTimelineError is shared by customer and supplier timeline
+func handleOrderItemTimelineError(err error) error {
+	st, _ := status.FromError(err)
+
+	if st.Code() == codes.NotFound {
+		return utils.BusinessError{
+			Message:   st.Message(),
+			Code:      http.StatusNotFound,
+			ErrorCode: errorcode.NOT_FOUND,
+		}
+	}
+
+	return utils.TechnicalError{
+		Message: common.MSG_INTERNAL_ERROR,
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func toOrderItemTimelineResponse(res *order_proto_gen.GetOrderItemTimelineResponse) []api_gateway_dto.OrderItemTimelineResponse {
+	result := make([]api_gateway_dto.OrderItemTimelineResponse, 0, len(res.Events))
+
+	for _, event := range res.Events {
+		result = append(result, api_gateway_dto.OrderItemTimelineResponse{
+			Status:    event.Status,
+			Actor:     event.Actor,
+			Notes:     event.Notes,
+			CreatedAt: event.CreatedAt.AsTime(),
+		})
+	}
+
+	return result
+}
+
+func (u *userMeService) ReorderToCart(ctx context.Context, orderID string, userID int) (*api_gateway_dto.ReorderToCartResponse, error) {
+	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "ReorderToCart"))
+	defer span.End()
+
+	res, err := u.orderClient.ReorderToCart(ctx, &order_proto_gen.ReorderToCartRequest{
+		UserId:  int64(userID),
+		OrderId: orderID,
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		st, _ := status.FromError(err)
+
+		if st.Code() == codes.NotFound {
+			return nil, utils.BusinessError{
+				Message:   st.Message(),
+				Code:      http.StatusNotFound,
+				ErrorCode: errorcode.NOT_FOUND,
+			}
+		}
+
+		return nil, utils.TechnicalError{
+			Message: common.MSG_INTERNAL_ERROR,
+			Code:    http.StatusInternalServerError,
+		}
+	}
+
+	return &api_gateway_dto.ReorderToCartResponse{
+		AddedItems:        toReorderItemsResponse(res.AddedItems),
+		UnavailableItems:  toReorderItemsResponse(res.UnavailableItems),
+		PriceChangedItems: toReorderItemsResponse(res.PriceChangedItems),
+	}, nil
+}
+
+func toReorderItemsResponse(items []*order_proto_gen.ReorderItemResponse) []api_gateway_dto.ReorderItemResponse {
+	result := make([]api_gateway_dto.ReorderItemResponse, 0, len(items))
+
+	for _, item := range items {
+		itemRes := api_gateway_dto.ReorderItemResponse{
+			ProductID:          item.ProductId,
+			ProductVariantID:   item.ProductVariantId,
+			ProductName:        item.ProductName,
+			ProductVariantName: item.ProductVariantName,
+			Quantity:           item.Quantity,
+			Reason:             item.Reason,
+			Currency:           item.OldUnitPrice.GetCurrencyCode(),
+			OldUnitPrice:       fromMoneyProto(item.OldUnitPrice),
+		}
+
+		if item.NewUnitPrice != nil {
+			newUnitPrice := fromMoneyProto(item.NewUnitPrice)
+			itemRes.NewUnitPrice = &newUnitPrice
+		}
+
+		result = append(result, itemRes)
+	}
+
+	return result
+}
+
+func (u *userMeService) AddWishlistItem(ctx context.Context, data api_gateway_dto.AddWishlistItemRequest, userID int) (*api_gateway_dto.AddWishlistItemResponse, error) {
+	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "AddWishlistItem"))
+	defer span.End()
+
+	res, err := u.orderClient.AddItemToWishlist(ctx, &order_proto_gen.AddItemToWishlistRequest{
+		UserId:           int64(userID),
+		ProductId:        data.ProductID,
+		ProductVariantId: data.ProductVariantID,
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, handleWishlistError(err)
+	}
+
+	return &api_gateway_dto.AddWishlistItemResponse{
+		WishlistItemID: res.WishlistItemId,
+	}, nil
+}
+
+func (u *userMeService) GetWishlistItems(ctx context.Context, userID int, currency string) ([]api_gateway_dto.GetWishlistItemsResponse, error) {
+	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetWishlistItems"))
+	defer span.End()
+
+	if currency == "" {
+		currency = common.DefaultCurrency
+	}
+
+	rates, err := getExchangeRates(ctx, u.orderClient, currency)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.orderClient.GetWishlist(ctx, &order_proto_gen.GetWishlistRequest{
+		UserId: int64(userID),
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, handleWishlistError(err)
+	}
+
+	result := make([]api_gateway_dto.GetWishlistItemsResponse, 0, len(res.Items))
+
+	for _, item := range res.Items {
+		// prices are shown in display currency, price drop is decided by order service in currency of saved price
+		savedPrice, priceCurrency := rates.convertMoney(fromMoneyProto(item.SavedPrice), item.SavedPrice.GetCurrencyCode(), currency)
+
+		itemRes := api_gateway_dto.GetWishlistItemsResponse{
+			WishlistItemID:          item.WishlistItemId,
+			ProductID:               item.ProductId,
+			ProductVariantID:        item.ProductVariantId,
+			ProductName:             item.ProductName,
+			VariantName:             item.VariantName,
+			ProductVariantThumbnail: item.ProductVariantThumbnail,
+			SavedPrice:              savedPrice,
+			Currency:                priceCurrency,
+			PriceDropped:            item.PriceDropped,
+			Available:               item.Available,
+			AddedAt:                 item.AddedAt.AsTime(),
+		}
+
+		if item.Available {
+			price, _ := rates.convertMoney(fromMoneyProto(item.Price), item.Price.GetCurrencyCode(), priceCurrency)
+			discountPrice, _ := rates.convertMoney(fromMoneyProto(item.DiscountPrice), item.DiscountPrice.GetCurrencyCode(), priceCurrency)
+			itemRes.Price = &price
+			itemRes.DiscountPrice = &discountPrice
+		}
+
+		result = append(result, itemRes)
+	}
+
+	return result, nil
+}
+
+func (u *userMeService) DeleteWishlistItems(ctx context.Context, wishlistItemIDs []string, userID int) error {
+	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "DeleteWishlistItems"))
+	defer span.End()
+
+	_, err := u.orderClient.RemoveWishlistItems(ctx, &order_proto_gen.RemoveWishlistItemsRequest{
+		UserId:          int64(userID),
+		WishlistItemIds: wishlistItemIDs,
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return handleWishlistError(err)
+	}
+
+	return nil
+}
+
+func (u *userMeService) MoveWishlistItemToCart(ctx context.Context, wishlistItemID string, quantity int64, userID int) error {
+	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "MoveWishlistItemToCart"))
+	defer span.End()
+
+	_, err := u.orderClient.MoveWishlistItemToCart(ctx, &order_proto_gen.MoveWishlistItemToCartRequest{
+		UserId:         int64(userID),
+		WishlistItemId: wishlistItemID,
+		Quantity:       quantity,
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return handleWishlistError(err)
+	}
+
+	return nil
+}
+
+func (u *userMeService) MoveCartItemToWishlist(ctx context.Context, cartItemID string, userID int) (*api_gateway_dto.MoveCartItemToWishlistResponse, error) {
+	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "MoveCartItemToWishlist"))
+	defer span.End()
+
+	res, err := u.orderClient.MoveCartItemToWishlist(ctx, &order_proto_gen.MoveCartItemToWishlistRequest{
+		UserId:     int64(userID),
+		CartItemId: cartItemID,
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, handleWishlistError(err)
+	}
+
+	return &api_gateway_dto.MoveCartItemToWishlistResponse{
+		WishlistItemID: res.WishlistItemId,
+	}, nil
+}
+
+func handleWishlistError(err error) error {
+	st, _ := status.FromError(err)
+
+	switch st.Code() {
+	case codes.NotFound:
+		return utils.BusinessError{
+			Message:   st.Message(),
+			Code:      http.StatusNotFound,
+			ErrorCode: errorcode.NOT_FOUND,
+		}
+	// canceled is returned when inventory is not enough for quantity which is moved to cart
+	case codes.InvalidArgument, codes.Canceled:
+		return utils.BusinessError{
+			Message:   st.Message(),
+			Code:      http.StatusBadRequest,
+			ErrorCode: errorcode.BAD_REQUEST,
+		}
+	}
+
+	return utils.TechnicalError{
+		Message: common.MSG_INTERNAL_ERROR,
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func (u *userMeService) GetUserPaymentMethods(ctx context.Context, userID int) ([]api_gateway_dto.UserPaymentMethodResponse, error) {
+	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetUserPaymentMethods"))
+	defer span.End()
+
+	res, err := u.orderClient.GetUserPaymentMethods(ctx, &order_proto_gen.GetUserPaymentMethodsRequest{
+		UserId: int64(userID),
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, handleUserPaymentMethodError(err)
+	}
+
+	result := make([]api_gateway_dto.UserPaymentMethodResponse, 0, len(res.UserPaymentMethods))
+
+	for _, userPaymentMethod := range res.UserPaymentMethods {
+		result = append(result, toUserPaymentMethodResponse(userPaymentMethod))
+	}
+
+	return result, nil
+}
+
+func (u *userMeService) CreateUserPaymentMethod(ctx context.Context, data api_gateway_dto.CreateUserPaymentMethodRequest, userID int) (*api_gateway_dto.UserPaymentMethodResponse, error) {
+	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CreateUserPaymentMethod"))
+	defer span.End()
+
+	res, err := u.orderClient.CreateUserPaymentMethod(ctx, &order_proto_gen.CreateUserPaymentMethodRequest{
+		UserId:            int64(userID),
+		PaymentMethodCode: data.PaymentMethodCode,
+		CardHolderName:    data.CardHolderName,
+		ProviderToken:     data.ProviderToken,
+		CardNumber:        data.CardNumber,
+		CardLast4:         data.CardLast4,
+		CardBrand:         data.CardBrand,
+		CardExpiryMonth:   data.CardExpiryMonth,
+		CardExpiryYear:    data.CardExpiryYear,
+		IsDefault:         data.IsDefault,
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, handleUserPaymentMethodError(err)
+	}
+
+	result := toUserPaymentMethodResponse(res.UserPaymentMethod)
+
+	return &result, nil
+}
+
+func (u *userMeService) SetDefaultUserPaymentMethod(ctx context.Context, userPaymentMethodID int64, userID int) error {
+	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "SetDefaultUserPaymentMethod"))
+	defer span.End()
+
+	_, err := u.orderClient.SetDefaultUserPaymentMethod(ctx, &order_proto_gen.SetDefaultUserPaymentMethodRequest{
+		UserId:              int64(userID),
+		UserPaymentMethodId: userPaymentMethodID,
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return handleUserPaymentMethodError(err)
+	}
+
+	return nil
+}
+
+func (u *userMeService) DeleteUserPaymentMethod(ctx context.Context, userPaymentMethodID int64, userID int) error {
+	ctx, span := u.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "DeleteUserPaymentMethod"))
+	defer span.End()
+
+	_, err := u.orderClient.DeleteUserPaymentMethod(ctx, &order_proto_gen.DeleteUserPaymentMethodRequest{
+		UserId:              int64(userID),
+		UserPaymentMethodId: userPaymentMethodID,
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return handleUserPaymentMethodError(err)
+	}
+
+	return nil
+}
+
+func handleUserPaymentMethodError(err error) error {
+	st, _ := status.FromError(err)
+
+	switch st.Code() {
+	case codes.NotFound:
+		return utils.BusinessError{
+			Message:   st.Message(),
+			Code:      http.StatusNotFound,
+			ErrorCode: errorcode.NOT_FOUND,
+		}
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		return utils.BusinessError{
+			Message:   st.Message(),
+			Code:      http.StatusBadRequest,
+			ErrorCode: errorcode.BAD_REQUEST,
+		}
+	}
+
+	return utils.TechnicalError{
+		Message: common.MSG_INTERNAL_ERROR,
+		Code:    http.StatusInternalServerError,
+	}
+}
+
+func toUserPaymentMethodResponse(userPaymentMethod *order_proto_gen.UserPaymentMethodResponse) api_gateway_dto.UserPaymentMethodResponse {
+	return api_gateway_dto.UserPaymentMethodResponse{
+		ID:                userPaymentMethod.Id,
+		PaymentMethodCode: userPaymentMethod.PaymentMethodCode,
+		PaymentMethodName: userPaymentMethod.PaymentMethodName,
+		CardHolderName:    userPaymentMethod.CardHolderName,
+		CardLast4:         userPaymentMethod.CardLast4,
+		CardBrand:         userPaymentMethod.CardBrand,
+		CardExpiryMonth:   userPaymentMethod.CardExpiryMonth,
+		CardExpiryYear:    userPaymentMethod.CardExpiryYear,
+		IsDefault:         userPaymentMethod.IsDefault,
+		CreatedAt:         userPaymentMethod.CreatedAt.AsTime(),
+	}
+}
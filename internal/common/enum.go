@@ -64,8 +64,9 @@ const (
 type MethodType string
 
 const (
-	Momo MethodType = "momo"
-	Cod  MethodType = "cod"
+	Momo  MethodType = "momo"
+	Vnpay MethodType = "vnpay"
+	Cod   MethodType = "cod"
 )
 
 type StatusOrder string
@@ -111,6 +112,65 @@ func (s StatusOrder) ErrorMessage() string {
 	return fmt.Sprintf("Status must be in the one of: [%v]", strings.Join(validArray, ", "))
 }
 
+// OrderActor is who change status of order item
+type OrderActor string
+
+const (
+	ActorCustomer  OrderActor = "customer"
+	ActorSupplier  OrderActor = "supplier"
+	ActorDeliverer OrderActor = "deliverer"
+	ActorSystem    OrderActor = "system"
+)
+
+// orderItemTransitions: current status -> next status -> actors are allowed to do it
+var orderItemTransitions = map[StatusOrder]map[StatusOrder][]OrderActor{
+	PendingPayment: {
+		Pending:       {ActorSystem},
+		PaymentFailed: {ActorSystem},
+		Cancelled:     {ActorCustomer, ActorSystem},
+	},
+	Pending: {
+		Confirmed: {ActorSupplier},
+		Cancelled: {ActorCustomer, ActorSupplier, ActorSystem},
+		Refunded:  {ActorSystem},
+	},
+	Confirmed: {
+		Processing: {ActorSupplier},
+		Cancelled:  {ActorCustomer, ActorSupplier},
+		Refunded:   {ActorSystem},
+	},
+	Processing: {
+		ReadyToShip: {ActorSupplier},
+		Cancelled:   {ActorSupplier},
+		Refunded:    {ActorSystem},
+	},
+	ReadyToShip: {
+		InTransit: {ActorDeliverer},
+	},
+	InTransit: {
+		OutForDelivery: {ActorDeliverer},
+	},
+	OutForDelivery: {
+		Delivered: {ActorDeliverer},
+	},
+	Delivered: {
+		Refunded: {ActorSystem},
+	},
+	Cancelled: {
+		Refunded: {ActorSystem},
+	},
+}
+
+// IsClosed reports whether order item was cancelled, failed or refunded, so it is not fulfilled anymore
+func (s StatusOrder) IsClosed() bool {
+	return s == Cancelled || s == PaymentFailed || s == Refunded
+}
+
+// CanTransitionTo reports whether actor is allowed to move order item from s to next
+func (s StatusOrder) CanTransitionTo(next StatusOrder, actor OrderActor) bool {
+	return slices.Contains(orderItemTransitions[s][next], actor)
+}
+
 type SupplierProfileStatus string
 
 const (
@@ -249,3 +309,152 @@ func (d DeliveryPersonApplicationStatus) ErrorMessage() string {
 
 	return fmt.Sprintf("Delivery person application must be in the one of: [%v]", strings.Join(validArray, ", "))
 }
+
+type PaymentStatus string
+
+const (
+	PaymentStatusPending           PaymentStatus = "pending"
+	PaymentStatusProcessing        PaymentStatus = "processing"
+	PaymentStatusCompleted         PaymentStatus = "completed"
+	PaymentStatusFailed            PaymentStatus = "failed"
+	PaymentStatusRefunded          PaymentStatus = "refunded"
+	PaymentStatusPartiallyRefunded PaymentStatus = "partially_refunded"
+)
+
+type RefundStatus string
+
+const (
+	RefundStatusPending    RefundStatus = "pending"
+	RefundStatusProcessing RefundStatus = "processing"
+	RefundStatusCompleted  RefundStatus = "completed"
+	RefundStatusFailed     RefundStatus = "failed"
+)
+
+type ReservationStatus string
+
+const (
+	ReservationStatusReserved  ReservationStatus = "reserved"
+	ReservationStatusCommitted ReservationStatus = "committed"
+	ReservationStatusReleased  ReservationStatus = "released"
+	ReservationStatusExpired   ReservationStatus = "expired"
+)
+
+type InventoryTransactionType string
+
+const (
+	InventoryTransactionSale    InventoryTransactionType = "sale"
+	InventoryTransactionReturn  InventoryTransactionType = "return"
+	InventoryTransactionReserve InventoryTransactionType = "reserve"
+	InventoryTransactionRelease InventoryTransactionType = "release"
+)
+
+// CouponScope is what coupon is applied to
+type CouponScope string
+
+const (
+	CouponScopeOrder    CouponScope = "order"    // whole order, across suppliers
+	CouponScopeProduct  CouponScope = "product"  // one order item
+	CouponScopeCategory CouponScope = "category" // one order item, its product must be in category of coupon
+)
+
+func (c CouponScope) IsValid() bool {
+	validArray := []CouponScope{CouponScopeOrder, CouponScopeProduct, CouponScopeCategory}
+
+	if slices.Contains(validArray, c) {
+		return true
+	}
+
+	return false
+}
+
+func (c CouponScope) ErrorMessage() string {
+	validArray := []string{string(CouponScopeOrder), string(CouponScopeProduct), string(CouponScopeCategory)}
+
+	return fmt.Sprintf("Coupon scope must be in the one of: [%v]", strings.Join(validArray, ", "))
+}
+
+type CouponRedemptionStatus string
+
+const (
+	CouponRedemptionRedeemed CouponRedemptionStatus = "redeemed"
+	CouponRedemptionReversed CouponRedemptionStatus = "reversed"
+)
+
+type CouponCampaignStatus string
+
+const (
+	CouponCampaignStatusPending   CouponCampaignStatus = "pending"   // codes are being generated
+	CouponCampaignStatusCompleted CouponCampaignStatus = "completed" // all codes were generated
+)
+
+type ShippingZone string
+
+const (
+	ShippingZoneIntraDistrict ShippingZone = "intra_district" // supplier and buyer are in same district
+	ShippingZoneIntraProvince ShippingZone = "intra_province" // supplier and buyer are in same province
+	ShippingZoneInterProvince ShippingZone = "inter_province"
+)
+
+// DefaultShippingClass is shipping class whose rates are used for shipping classes which have no rates
+const DefaultShippingClass = "default"
+
+// DefaultCurrency is currency of orders and payments, prices of products are in it
+const DefaultCurrency = "VND"
+
+// CheckoutProblemCode is why checkout would fail, it is returned by quote of checkout
+type CheckoutProblemCode string
+
+const (
+	CheckoutProblemOutOfStock          CheckoutProblemCode = "out_of_stock"
+	CheckoutProblemProductUnavailable  CheckoutProblemCode = "product_unavailable"
+	CheckoutProblemCouponNotFound      CheckoutProblemCode = "coupon_not_found"
+	CheckoutProblemCouponInvalid       CheckoutProblemCode = "coupon_invalid"        // not active, expired, over usage limit or of other user
+	CheckoutProblemCouponNotApplicable CheckoutProblemCode = "coupon_not_applicable" // scope of coupon does not match item
+	CheckoutProblemCouponBelowMinimum  CheckoutProblemCode = "coupon_below_minimum"
+	CheckoutProblemShippingUnavailable CheckoutProblemCode = "shipping_unavailable"
+)
+
+// OrderEventType is type of domain event of order, events are saved into outbox and published to kafka by relay
+type OrderEventType string
+
+const (
+	OrderEventOrderCreated           OrderEventType = "order.created"
+	OrderEventOrderItemStatusChanged OrderEventType = "order_item.status_changed"
+	OrderEventPaymentSucceeded       OrderEventType = "payment.succeeded"
+	OrderEventPaymentFailed          OrderEventType = "payment.failed"
+	OrderEventRefundCompleted        OrderEventType = "refund.completed"
+)
+
+// SagaType is type of saga which is run by saga coordinator of order and payment service
+type SagaType string
+
+const (
+	SagaTypeCheckout       SagaType = "checkout"         // reserve stock, create order, create payment
+	SagaTypeOrderItemStock SagaType = "order_item_stock" // change stock after status of order item was changed
+)
+
+type SagaStatus string
+
+const (
+	SagaStatusRunning      SagaStatus = "running"
+	SagaStatusCompensating SagaStatus = "compensating"
+	SagaStatusCompleted    SagaStatus = "completed"
+	SagaStatusCompensated  SagaStatus = "compensated"
+)
+
+// OrderItemStockAction is what is done with stock of order item after its status was changed
+type OrderItemStockAction string
+
+const (
+	OrderItemStockRelease OrderItemStockAction = "release" // order item was cancelled, stock is given back
+	OrderItemStockKeep    OrderItemStockAction = "keep"    // order item was paid, stock is held until supplier confirms it
+)
+
+// CartWarningType is change of cart item which buyer is warned about when cart is loaded
+type CartWarningType string
+
+const (
+	CartWarningUnavailable       CartWarningType = "unavailable"        // variant is deactivated
+	CartWarningInsufficientStock CartWarningType = "insufficient_stock" // inventory is less than quantity in cart
+	CartWarningPriceChanged      CartWarningType = "price_changed"      // price is different from last price seen by buyer
+)
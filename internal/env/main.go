@@ -31,6 +31,8 @@ type MinioConfig struct {
 	MinioBucketAvatars       string `envconfig:"MINIO_BUCKET_AVATARS"`
 	MinioRegion              string `envconfig:"MINIO_REGION"`
 	MinioBucketAvatarsPolicy string `envconfig:"MINIO_BUCKET_AVATARS_POLICY"`
+	// private bucket, exported codes of coupon campaigns are downloaded by presigned url
+	MinioBucketCoupons string `envconfig:"MINIO_BUCKET_COUPONS" default:"coupons"`
 }
 
 type JeagerConfig struct {
@@ -82,10 +84,32 @@ type NotificationServerConfig struct {
 
 type SupplierAndProductServerConfig struct {
 	ServerAddress string `envconfig:"SUPPLIER_AND_PRODUCT_ADDRESS"`
+	// seconds between two checks of expired inventory reservations
+	ReservationExpireInterval int `envconfig:"RESERVATION_EXPIRE_INTERVAL" default:"30"`
 }
 
 type OrderAndPaymentServerConfig struct {
 	ServerAddress string `envconfig:"ORDER_AND_PAYMENT_ADDRESS"`
+	ConsumeGroup  string `envconfig:"ORDER_AND_PAYMENT_CONSUME_GROUP"`
+	// CardEncryptionKey is base64 of 32 bytes key, card numbers are encrypted by it before they are saved
+	CardEncryptionKey string `envconfig:"CARD_ENCRYPTION_KEY"`
+	// codes of coupon campaigns are generated in background, batch by batch
+	CouponCampaignGenerateInterval  int `envconfig:"COUPON_CAMPAIGN_GENERATE_INTERVAL" default:"10"` // seconds
+	CouponCampaignGenerateBatchSize int `envconfig:"COUPON_CAMPAIGN_GENERATE_BATCH_SIZE" default:"1000"`
+	// idempotency key of checkout can be used for other checkout after ttl
+	CheckoutIdempotencyKeyTTL int `envconfig:"CHECKOUT_IDEMPOTENCY_KEY_TTL" default:"24"` // hours
+	// retry can take over key of checkout which is still in progress after lease, it must be longer than checkout
+	CheckoutIdempotencyLease int `envconfig:"CHECKOUT_IDEMPOTENCY_LEASE" default:"120"` // seconds
+	// events in outbox are published to kafka by relay, published events are deleted after retention
+	OutboxRelayInterval  int `envconfig:"OUTBOX_RELAY_INTERVAL" default:"1"` // seconds
+	OutboxRelayBatchSize int `envconfig:"OUTBOX_RELAY_BATCH_SIZE" default:"100"`
+	OutboxRetention      int `envconfig:"OUTBOX_RETENTION" default:"168"` // hours
+	// sagas which are not updated for stale timeout (service crashed in the middle) are resumed by recoverer
+	SagaRecoverInterval  int `envconfig:"SAGA_RECOVER_INTERVAL" default:"30"` // seconds
+	SagaStaleTimeout     int `envconfig:"SAGA_STALE_TIMEOUT" default:"120"`   // seconds
+	SagaRecoverBatchSize int `envconfig:"SAGA_RECOVER_BATCH_SIZE" default:"50"`
+	// ExchangeRateFile is json file like {"USD": "25350.5"}, rates in it are saved on start when it is set
+	ExchangeRateFile string `envconfig:"EXCHANGE_RATE_FILE"`
 }
 
 type GoogleOAuthConfig struct {
@@ -116,6 +140,36 @@ type MomoConfig struct {
 	MomoNotifyURL   string `envconfig:"MOMO_NOTIFY_URL"`
 }
 
+// PaymentConfig is shared by every payment gateway
+type PaymentConfig struct {
+	// unpaid orders are expired after PaymentTTL minutes, checked every PaymentExpireInterval minutes
+	PaymentTTL            int `envconfig:"PAYMENT_TTL" default:"15"`
+	PaymentExpireInterval int `envconfig:"PAYMENT_EXPIRE_INTERVAL" default:"1"`
+	// PaymentQueryStatusOnExpired asks payment gateway for result before order is expired, so late ipn is not lost
+	PaymentQueryStatusOnExpired bool `envconfig:"PAYMENT_QUERY_STATUS_ON_EXPIRED" default:"true"`
+}
+
+type VNPayConfig struct {
+	VNPayTmnCode    string `envconfig:"VNPAY_TMN_CODE"`
+	VNPayHashSecret string `envconfig:"VNPAY_HASH_SECRET"`
+	// VNPayPayURL is page buyer is redirected to, VNPayAPIURL is used for querydr and refund
+	VNPayPayURL    string `envconfig:"VNPAY_PAY_URL"`
+	VNPayAPIURL    string `envconfig:"VNPAY_API_URL"`
+	VNPayReturnURL string `envconfig:"VNPAY_RETURN_URL"`
+	VNPayVersion   string `envconfig:"VNPAY_VERSION" default:"2.1.0"`
+	// VNPayServerIP is ip of order service sent with querydr and refund
+	VNPayServerIP string `envconfig:"VNPAY_SERVER_IP" default:"127.0.0.1"`
+}
+
+type TaxConfig struct {
+	// TaxRates is rate (percent) of each tax class of products, e.g. vat_10:10
+	TaxRates map[string]float64 `envconfig:"TAX_RATES" default:"vat_0:0,vat_5:5,vat_8:8,vat_10:10"`
+	// products which have tax class out of TaxRates are taxed by rate of TaxDefaultClass
+	TaxDefaultClass string `envconfig:"TAX_DEFAULT_CLASS" default:"vat_10"`
+	// TaxPricesIncludeTax is true when prices of products already contain tax (tax-inclusive pricing)
+	TaxPricesIncludeTax bool `envconfig:"TAX_PRICES_INCLUDE_TAX" default:"true"`
+}
+
 type EnvManager struct {
 	ServerConfig                   *ServerConfig
 	PostgreSQL                     *PostgreSQLConfig
@@ -132,7 +186,10 @@ type EnvManager struct {
 	GoogleOAuth                    *GoogleOAuthConfig
 	FacebookOAuth                  *FacebookOAuthConfig
 	Client                         *ClientConfig
+	PaymentConfig                  *PaymentConfig
 	MomoConfig                     *MomoConfig
+	VNPayConfig                    *VNPayConfig
+	TaxConfig                      *TaxConfig
 
 	RedirectURI string `envconfig:"REDIRECT_URI"`
 
@@ -144,7 +201,13 @@ type EnvManager struct {
 	ExpireAccessToken  int `envconfig:"EXPIRE_ACCESS_TOKEN"`
 	ExpireRefreshToken int `envconfig:"EXPIRE_REFRESH_TOKEN"`
 
-	TopicVerifyOTP string `envconfig:"TOPIC_VERIFY_OTP"`
+	// GuestCartSecret signs tokens of guest carts, so ids of guest carts can not be guessed or changed
+	GuestCartSecret string `envconfig:"GUEST_CART_SECRET"`
+	GuestCartTTL    int    `envconfig:"GUEST_CART_TTL" default:"30"` // days
+
+	TopicVerifyOTP         string `envconfig:"TOPIC_VERIFY_OTP"`
+	TopicOrderNotification string `envconfig:"TOPIC_ORDER_NOTIFICATION"`
+	TopicOrderEvents       string `envconfig:"TOPIC_ORDER_EVENTS"`
 }
 
 func NewEnvManager() *EnvManager {
@@ -0,0 +1,361 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Scale is number of decimal places of amounts, it is same as scale of numeric(14,2) columns
+const Scale = 2
+
+// minorPerUnit is number of minor units in one unit of currency
+const minorPerUnit = 100
+
+// nanosPerMinor converts minor units into nanos of protobuf money
+const nanosPerMinor = 10_000_000
+
+// Money is exact amount with 2 decimal places, it is kept as integer number of minor units (hundredths),
+// so sums, discounts and splits of amounts do not drift like float64.
+//
+// Money has no currency on purpose: amounts are stored in one numeric column next to their own currency
+// column (orders, cart items, wishlist items, exchange rates), and messages send currency in its own field.
+// Keeping Money a plain comparable value lets it be scanned from and written to that single column.
+// Caller keeps currency beside amount and only adds amounts of same currency,
+// amount moves to other currency only through Convert with Rate between both currencies.
+type Money struct {
+	minor int64
+}
+
+var Zero = Money{}
+
+func FromMinor(minor int64) Money {
+	return Money{minor: minor}
+}
+
+func FromUnits(units int64) Money {
+	return Money{minor: units * minorPerUnit}
+}
+
+// FromFloat rounds float to 2 decimal places, it is only used for catalog prices which are only displayed
+// and for tax rates of config, prices which are charged are sent as decimal text and read by Parse
+func FromFloat(value float64) Money {
+	return Money{minor: int64(math.Round(value * minorPerUnit))}
+}
+
+// FromUnitsNanos converts protobuf money, nanos finer than minor unit are rounded half away from zero
+func FromUnitsNanos(units int64, nanos int32) Money {
+	return Money{minor: units*minorPerUnit + roundDiv(int64(nanos), nanosPerMinor)}
+}
+
+// Parse parses decimal text like "-12.5" or "100.00", more than 2 decimal places are rejected
+func Parse(text string) (Money, error) {
+	minor, err := parseDecimal(text, Scale)
+
+	if err != nil {
+		return Zero, err
+	}
+
+	return Money{minor: minor}, nil
+}
+
+func MustParse(text string) Money {
+	m, err := Parse(text)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// Minor returns amount in minor units (hundredths)
+func (m Money) Minor() int64 {
+	return m.minor
+}
+
+// UnitsNanos returns amount as whole units and nanos with same sign, like google.type.Money
+func (m Money) UnitsNanos() (int64, int32) {
+	return m.minor / minorPerUnit, int32(m.minor%minorPerUnit) * nanosPerMinor
+}
+
+// Ceil returns amount rounded up to whole units
+func (m Money) Ceil() int64 {
+	units := m.minor / minorPerUnit
+
+	if m.minor%minorPerUnit > 0 {
+		units++
+	}
+
+	return units
+}
+
+// Floor returns amount rounded down to whole units
+func (m Money) Floor() int64 {
+	units := m.minor / minorPerUnit
+
+	if m.minor%minorPerUnit < 0 {
+		units--
+	}
+
+	return units
+}
+
+// GatewayUnits returns amount in whole units which payment gateways charge and refund, part of unit is rounded up.
+// Charge, refund and check of paid amount all use it, so full refund gives back exactly what was charged
+func (m Money) GatewayUnits() int64 {
+	return m.Ceil()
+}
+
+func (m Money) String() string {
+	return formatDecimal(m.minor, Scale)
+}
+
+func (m Money) Add(other Money) Money {
+	return Money{minor: m.minor + other.minor}
+}
+
+func (m Money) Sub(other Money) Money {
+	return Money{minor: m.minor - other.minor}
+}
+
+func (m Money) Neg() Money {
+	return Money{minor: -m.minor}
+}
+
+// Mul multiplies amount by quantity
+func (m Money) Mul(quantity int64) Money {
+	return Money{minor: m.minor * quantity}
+}
+
+// MulRatio returns amount * numerator / denominator rounded half away from zero to minor unit,
+// it is used to split amount by weight of parts. It panics when denominator is 0
+func (m Money) MulRatio(numerator, denominator int64) Money {
+	value := new(big.Int).Mul(big.NewInt(m.minor), big.NewInt(numerator))
+
+	return Money{minor: roundDivBig(value, big.NewInt(denominator))}
+}
+
+// Percent returns rate percent of amount rounded half away from zero to minor unit, rate is percentage
+// with 2 decimal places like 10.5 (%)
+func (m Money) Percent(rate Money) Money {
+	value := new(big.Int).Mul(big.NewInt(m.minor), big.NewInt(rate.minor))
+
+	return Money{minor: roundDivBig(value, big.NewInt(100*minorPerUnit))}
+}
+
+func (m Money) Cmp(other Money) int {
+	switch {
+	case m.minor < other.minor:
+		return -1
+	case m.minor > other.minor:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (m Money) IsZero() bool {
+	return m.minor == 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.minor > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.minor < 0
+}
+
+func Min(a, b Money) Money {
+	if a.minor < b.minor {
+		return a
+	}
+
+	return b
+}
+
+func Max(a, b Money) Money {
+	if a.minor > b.minor {
+		return a
+	}
+
+	return b
+}
+
+// ScanNumeric scans numeric column, value with more decimal places is rounded half away from zero
+func (m *Money) ScanNumeric(v pgtype.Numeric) error {
+	minor, err := scanDecimal(v, Scale)
+
+	if err != nil {
+		return err
+	}
+
+	m.minor = minor
+
+	return nil
+}
+
+func (m Money) NumericValue() (pgtype.Numeric, error) {
+	return pgtype.Numeric{Int: big.NewInt(m.minor), Exp: -Scale, Valid: true}, nil
+}
+
+// MarshalJSON writes amount as json number with 2 decimal places, so clients read it without float rounding
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON reads amount from json number or string
+func (m *Money) UnmarshalJSON(data []byte) error {
+	text := strings.Trim(string(data), `"`)
+
+	if text == "null" {
+		return nil
+	}
+
+	parsed, err := Parse(text)
+
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+
+	return nil
+}
+
+// parseDecimal parses decimal text into integer with scale decimal places, more decimal places are rejected
+func parseDecimal(text string, scale int) (int64, error) {
+	text = strings.TrimSpace(text)
+
+	if text == "" {
+		return 0, errors.New("money: empty amount")
+	}
+
+	negative := false
+
+	switch text[0] {
+	case '-':
+		negative = true
+		text = text[1:]
+	case '+':
+		text = text[1:]
+	}
+
+	unitsText, fractionText, _ := strings.Cut(text, ".")
+
+	if unitsText == "" && fractionText == "" {
+		return 0, fmt.Errorf("money: invalid amount %q", text)
+	}
+
+	fractionText = strings.TrimRight(fractionText, "0")
+
+	if len(fractionText) > scale {
+		return 0, fmt.Errorf("money: amount %q has more than %d decimal places", text, scale)
+	}
+
+	var units, fraction int64
+	var err error
+
+	if unitsText != "" {
+		if units, err = strconv.ParseInt(unitsText, 10, 64); err != nil || units < 0 {
+			return 0, fmt.Errorf("money: invalid amount %q", text)
+		}
+	}
+
+	if fractionText != "" {
+		fractionText += strings.Repeat("0", scale-len(fractionText))
+
+		if fraction, err = strconv.ParseInt(fractionText, 10, 64); err != nil || fraction < 0 {
+			return 0, fmt.Errorf("money: invalid amount %q", text)
+		}
+	}
+
+	perUnit := pow10(scale)
+
+	if units > (math.MaxInt64-fraction)/perUnit {
+		return 0, fmt.Errorf("money: amount %q is too large", text)
+	}
+
+	value := units*perUnit + fraction
+
+	if negative {
+		value = -value
+	}
+
+	return value, nil
+}
+
+// scanDecimal converts numeric into integer with scale decimal places, rounded half away from zero
+func scanDecimal(v pgtype.Numeric, scale int) (int64, error) {
+	if !v.Valid {
+		return 0, errors.New("money: can not scan NULL")
+	}
+
+	if v.NaN || v.InfinityModifier != pgtype.Finite {
+		return 0, errors.New("money: can not scan NaN or infinity")
+	}
+
+	value := new(big.Int).Set(v.Int)
+	exp := v.Exp + int32(scale)
+
+	if exp >= 0 {
+		value.Mul(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil))
+	} else {
+		value = big.NewInt(roundDivBig(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-exp)), nil)))
+	}
+
+	if !value.IsInt64() {
+		return 0, errors.New("money: amount is too large")
+	}
+
+	return value.Int64(), nil
+}
+
+// formatDecimal writes integer with scale decimal places as decimal text
+func formatDecimal(value int64, scale int) string {
+	sign := ""
+
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+
+	perUnit := pow10(scale)
+
+	return fmt.Sprintf("%s%d.%0*d", sign, value/perUnit, scale, value%perUnit)
+}
+
+func pow10(exp int) int64 {
+	value := int64(1)
+
+	for range exp {
+		value *= 10
+	}
+
+	return value
+}
+
+// roundDiv divides rounding half away from zero
+func roundDiv(value, divisor int64) int64 {
+	return roundDivBig(big.NewInt(value), big.NewInt(divisor))
+}
+
+func roundDivBig(value, divisor *big.Int) int64 {
+	quotient, remainder := new(big.Int).QuoRem(value, divisor, new(big.Int))
+
+	// |remainder| * 2 >= |divisor| -> round away from zero
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(new(big.Int).Abs(divisor)) >= 0 {
+		if value.Sign()*divisor.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+
+	return quotient.Int64()
+}
@@ -0,0 +1,213 @@
+package money
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestFromMinorAndFromUnits(t *testing.T) {
+	tests := []struct {
+		name      string
+		got       Money
+		wantMinor int64
+		wantText  string
+	}{
+		{name: "minor", got: FromMinor(1250), wantMinor: 1250, wantText: "12.50"},
+		{name: "negative minor", got: FromMinor(-5), wantMinor: -5, wantText: "-0.05"},
+		{name: "units", got: FromUnits(150000), wantMinor: 15000000, wantText: "150000.00"},
+		{name: "negative units", got: FromUnits(-3), wantMinor: -300, wantText: "-3.00"},
+		{name: "zero", got: FromUnits(0), wantMinor: 0, wantText: "0.00"},
+		{name: "largest minor", got: FromMinor(math.MaxInt64), wantMinor: math.MaxInt64, wantText: "92233720368547758.07"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.Minor() != tt.wantMinor {
+				t.Errorf("Minor() = %d, want %d", tt.got.Minor(), tt.wantMinor)
+			}
+
+			if tt.got.String() != tt.wantText {
+				t.Errorf("String() = %q, want %q", tt.got.String(), tt.wantText)
+			}
+		})
+	}
+}
+
+func TestFromUnitsNanos(t *testing.T) {
+	tests := []struct {
+		name      string
+		units     int64
+		nanos     int32
+		wantMinor int64
+	}{
+		{name: "whole minor units", units: 12, nanos: 500_000_000, wantMinor: 1250},
+		{name: "half of minor unit is rounded up", units: 0, nanos: 5_000_000, wantMinor: 1},
+		{name: "less than half of minor unit is rounded down", units: 0, nanos: 4_999_999, wantMinor: 0},
+		{name: "negative half of minor unit is rounded away from zero", units: 0, nanos: -5_000_000, wantMinor: -1},
+		{name: "negative units and nanos", units: -1, nanos: -500_000_000, wantMinor: -150},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromUnitsNanos(tt.units, tt.nanos)
+
+			if got.Minor() != tt.wantMinor {
+				t.Errorf("FromUnitsNanos(%d, %d) = %d minor units, want %d", tt.units, tt.nanos, got.Minor(), tt.wantMinor)
+			}
+
+			units, nanos := got.UnitsNanos()
+
+			if back := FromUnitsNanos(units, nanos); back != got {
+				t.Errorf("UnitsNanos() = (%d, %d), it converts back to %v, want %v", units, nanos, back, got)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		wantMinor int64
+		wantErr   bool
+	}{
+		{name: "two decimal places", text: "199000.00", wantMinor: 19900000},
+		{name: "one decimal place", text: "12.5", wantMinor: 1250},
+		{name: "no decimal places", text: "7", wantMinor: 700},
+		{name: "trailing zeros", text: "1.010", wantMinor: 101},
+		{name: "only fraction", text: ".5", wantMinor: 50},
+		{name: "sign plus", text: "+1", wantMinor: 100},
+		{name: "negative", text: "-12.5", wantMinor: -1250},
+		{name: "spaces", text: " 3.25 ", wantMinor: 325},
+		{name: "largest amount", text: "92233720368547758.07", wantMinor: math.MaxInt64},
+		{name: "overflow", text: "92233720368547758.08", wantErr: true},
+		{name: "overflow of units", text: "100000000000000000000", wantErr: true},
+		{name: "more than two decimal places", text: "1.001", wantErr: true},
+		{name: "empty", text: "", wantErr: true},
+		{name: "only sign", text: "-", wantErr: true},
+		{name: "not number", text: "abc", wantErr: true},
+		{name: "sign in fraction", text: "1.-5", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.text)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Parse(%q) = %v, want error", tt.text, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.text, err)
+			}
+
+			if got.Minor() != tt.wantMinor {
+				t.Errorf("Parse(%q) = %d minor units, want %d", tt.text, got.Minor(), tt.wantMinor)
+			}
+		})
+	}
+}
+
+func TestCeilAndFloor(t *testing.T) {
+	tests := []struct {
+		amount    string
+		wantCeil  int64
+		wantFloor int64
+	}{
+		{amount: "150000.40", wantCeil: 150001, wantFloor: 150000},
+		{amount: "1.01", wantCeil: 2, wantFloor: 1},
+		{amount: "1.00", wantCeil: 1, wantFloor: 1},
+		{amount: "0.00", wantCeil: 0, wantFloor: 0},
+		{amount: "0.50", wantCeil: 1, wantFloor: 0},
+		{amount: "-0.50", wantCeil: 0, wantFloor: -1},
+		{amount: "-1.01", wantCeil: -1, wantFloor: -2},
+		{amount: "-1.00", wantCeil: -1, wantFloor: -1},
+		{amount: "92233720368547758.07", wantCeil: 92233720368547759, wantFloor: 92233720368547758},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.amount, func(t *testing.T) {
+			m := MustParse(tt.amount)
+
+			if got := m.Ceil(); got != tt.wantCeil {
+				t.Errorf("Ceil() = %d, want %d", got, tt.wantCeil)
+			}
+
+			if got := m.Floor(); got != tt.wantFloor {
+				t.Errorf("Floor() = %d, want %d", got, tt.wantFloor)
+			}
+
+			// charge, refund and check of paid amount must agree on amount which gateway takes
+			if got := m.GatewayUnits(); got != tt.wantCeil {
+				t.Errorf("GatewayUnits() = %d, want %d", got, tt.wantCeil)
+			}
+		})
+	}
+}
+
+func TestMulRatioAndPercent(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Money
+		want string
+	}{
+		{name: "split in three", got: FromUnits(100).MulRatio(1, 3), want: "33.33"},
+		{name: "split half of minor unit", got: MustParse("0.05").MulRatio(1, 2), want: "0.03"},
+		{name: "split negative half of minor unit", got: MustParse("-0.05").MulRatio(1, 2), want: "-0.03"},
+		{name: "large amount does not overflow", got: FromMinor(math.MaxInt64).MulRatio(2, 4), want: "46116860184273879.04"},
+		{name: "percent", got: FromUnits(200).Percent(MustParse("10.5")), want: "21.00"},
+		{name: "percent of half minor unit", got: MustParse("0.05").Percent(FromUnits(10)), want: "0.01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.String() != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScanNumeric(t *testing.T) {
+	tests := []struct {
+		name      string
+		numeric   pgtype.Numeric
+		wantMinor int64
+		wantErr   bool
+	}{
+		{name: "two decimal places", numeric: pgtype.Numeric{Int: big.NewInt(12345), Exp: -2, Valid: true}, wantMinor: 12345},
+		{name: "no decimal places", numeric: pgtype.Numeric{Int: big.NewInt(7), Exp: 0, Valid: true}, wantMinor: 700},
+		{name: "more decimal places are rounded", numeric: pgtype.Numeric{Int: big.NewInt(12345), Exp: -3, Valid: true}, wantMinor: 1235},
+		{name: "negative is rounded away from zero", numeric: pgtype.Numeric{Int: big.NewInt(-12345), Exp: -3, Valid: true}, wantMinor: -1235},
+		{name: "overflow", numeric: pgtype.Numeric{Int: big.NewInt(math.MaxInt64), Exp: 0, Valid: true}, wantErr: true},
+		{name: "null", numeric: pgtype.Numeric{}, wantErr: true},
+		{name: "NaN", numeric: pgtype.Numeric{NaN: true, Valid: true}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Money
+			err := got.ScanNumeric(tt.numeric)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ScanNumeric() = %v, want error", got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ScanNumeric() error = %v", err)
+			}
+
+			if got.Minor() != tt.wantMinor {
+				t.Errorf("ScanNumeric() = %d minor units, want %d", got.Minor(), tt.wantMinor)
+			}
+		})
+	}
+}
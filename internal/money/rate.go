@@ -0,0 +1,149 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5/pgtype"
+	"math/big"
+	"strings"
+)
+
+// RateScale is number of decimal places of exchange rates, it is same as scale of numeric(18,8) columns
+const RateScale = 8
+
+// ratePerUnit is scaled value of rate 1
+const ratePerUnit = 100_000_000
+
+// Rate is exchange rate with 8 decimal places, amount in target currency = amount in source currency * rate.
+// It is kept as integer like Money, so converting amounts is exact up to rounding of result to minor unit
+type Rate struct {
+	scaled int64
+}
+
+// OneRate converts currency into itself
+var OneRate = Rate{scaled: ratePerUnit}
+
+// ParseRate parses decimal text like "25350.5", more than 8 decimal places are rejected
+func ParseRate(text string) (Rate, error) {
+	scaled, err := parseDecimal(text, RateScale)
+
+	if err != nil {
+		return Rate{}, err
+	}
+
+	return Rate{scaled: scaled}, nil
+}
+
+// CrossRate returns rate from currency of rate from into currency of rate to, both rates are to same base currency,
+// it is rounded half away from zero to 8 decimal places. It panics when to is 0
+func CrossRate(from, to Rate) Rate {
+	value := new(big.Int).Mul(big.NewInt(from.scaled), big.NewInt(ratePerUnit))
+
+	return Rate{scaled: roundDivBig(value, big.NewInt(to.scaled))}
+}
+
+// Convert returns amount * rate rounded half away from zero to minor unit
+func (m Money) Convert(rate Rate) Money {
+	value := new(big.Int).Mul(big.NewInt(m.minor), big.NewInt(rate.scaled))
+
+	return Money{minor: roundDivBig(value, big.NewInt(ratePerUnit))}
+}
+
+// Rates maps currency to amount of base currency for one unit of it, rate of base currency is OneRate.
+// Every service converts amounts between currencies through it, so same amount is converted to same result everywhere
+type Rates map[string]Rate
+
+// MissingRateError is returned when currency has no rate in Rates
+type MissingRateError struct {
+	Currency string
+}
+
+func (e *MissingRateError) Error() string {
+	return fmt.Sprintf("money: exchange rate of currency %s is not set", e.Currency)
+}
+
+// Rate returns rate which converts amount in currency from into currency to, it is CrossRate of both rates,
+// so amounts which are converted by it can be converted again by rate which is saved
+func (r Rates) Rate(from, to string) (Rate, error) {
+	if from == to {
+		return OneRate, nil
+	}
+
+	fromRate, ok := r[from]
+
+	if !ok {
+		return Rate{}, &MissingRateError{Currency: from}
+	}
+
+	toRate, ok := r[to]
+
+	if !ok {
+		return Rate{}, &MissingRateError{Currency: to}
+	}
+
+	return CrossRate(fromRate, toRate), nil
+}
+
+// Convert returns amount in currency from converted into currency to by Rate
+func (r Rates) Convert(amount Money, from, to string) (Money, error) {
+	rate, err := r.Rate(from, to)
+
+	if err != nil {
+		return Zero, err
+	}
+
+	return amount.Convert(rate), nil
+}
+
+func (r Rate) String() string {
+	return strings.TrimRight(strings.TrimRight(formatDecimal(r.scaled, RateScale), "0"), ".")
+}
+
+func (r Rate) IsPositive() bool {
+	return r.scaled > 0
+}
+
+// ScanNumeric scans numeric column, value with more decimal places is rounded half away from zero
+func (r *Rate) ScanNumeric(v pgtype.Numeric) error {
+	scaled, err := scanDecimal(v, RateScale)
+
+	if err != nil {
+		return err
+	}
+
+	r.scaled = scaled
+
+	return nil
+}
+
+func (r Rate) NumericValue() (pgtype.Numeric, error) {
+	return pgtype.Numeric{Int: big.NewInt(r.scaled), Exp: -RateScale, Valid: true}, nil
+}
+
+// MarshalJSON writes rate as json number
+func (r Rate) MarshalJSON() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalJSON reads rate from json number or string
+func (r *Rate) UnmarshalJSON(data []byte) error {
+	text := strings.Trim(string(data), `"`)
+
+	if text == "null" {
+		return nil
+	}
+
+	parsed, err := ParseRate(text)
+
+	if err != nil {
+		return err
+	}
+
+	if !parsed.IsPositive() {
+		return errors.New("money: rate must be greater than 0")
+	}
+
+	*r = parsed
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{text: "25350.5", want: "25350.5"},
+		{text: "0.00003945", want: "0.00003945"},
+		{text: "1", want: "1"},
+		{text: "92233720368.54775807", want: "92233720368.54775807"},
+		{text: "92233720368.54775808", wantErr: true},
+		{text: "0.000000001", wantErr: true},
+		{text: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseRate(tt.text)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseRate(%q) = %v, want error", tt.text, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseRate(%q) error = %v", tt.text, err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("ParseRate(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrossRate(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want string
+	}{
+		{name: "same rate", from: "25350", to: "25350", want: "1"},
+		{name: "into base currency", from: "25350", to: "1", want: "25350"},
+		{name: "rounded down at 8 decimal places", from: "1", to: "3", want: "0.33333333"},
+		{name: "rounded up at 8 decimal places", from: "2", to: "3", want: "0.66666667"},
+		{name: "half is rounded up at 8 decimal places", from: "0.00000001", to: "2", want: "0.00000001"},
+		{name: "from base currency", from: "1", to: "25350", want: "0.00003945"},
+		{name: "cross of two currencies", from: "25000", to: "27000", want: "0.92592593"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CrossRate(mustParseRate(t, tt.from), mustParseRate(t, tt.to))
+
+			if got.String() != tt.want {
+				t.Errorf("CrossRate(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount string
+		rate   string
+		want   string
+	}{
+		{name: "whole result", amount: "100.00", rate: "25350", want: "2535000.00"},
+		{name: "half of minor unit is rounded up", amount: "0.01", rate: "0.5", want: "0.01"},
+		{name: "less than half of minor unit is rounded down", amount: "0.01", rate: "0.49999999", want: "0.00"},
+		{name: "negative half of minor unit is rounded away from zero", amount: "-0.01", rate: "0.5", want: "-0.01"},
+		{name: "large amount does not overflow", amount: "92233720368547758.07", rate: "0.5", want: "46116860184273879.04"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MustParse(tt.amount).Convert(mustParseRate(t, tt.rate))
+
+			if got.String() != tt.want {
+				t.Errorf("%s.Convert(%s) = %v, want %v", tt.amount, tt.rate, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRatesConvert(t *testing.T) {
+	rates := Rates{
+		"VND": OneRate,
+		"USD": mustParseRate(t, "25000"),
+		"EUR": mustParseRate(t, "27000"),
+	}
+
+	tests := []struct {
+		name        string
+		amount      string
+		from        string
+		to          string
+		want        string
+		wantMissing string
+	}{
+		{name: "same currency", amount: "10.25", from: "JPY", to: "JPY", want: "10.25"},
+		{name: "into base currency", amount: "10.25", from: "USD", to: "VND", want: "256250.00"},
+		{name: "from base currency", amount: "256250.00", from: "VND", to: "USD", want: "10.25"},
+		// cross rate is rounded to 0.92592593 before amount is converted, unrounded rate would give 925925925.93
+		{name: "cross rate is rounded first", amount: "1000000000.00", from: "USD", to: "EUR", want: "925925930.00"},
+		{name: "missing rate of from", amount: "1.00", from: "JPY", to: "USD", wantMissing: "JPY"},
+		{name: "missing rate of to", amount: "1.00", from: "USD", to: "JPY", wantMissing: "JPY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rates.Convert(MustParse(tt.amount), tt.from, tt.to)
+
+			if tt.wantMissing != "" {
+				var missingRate *MissingRateError
+
+				if !errors.As(err, &missingRate) || missingRate.Currency != tt.wantMissing {
+					t.Errorf("Convert() error = %v, want missing rate of %s", err, tt.wantMissing)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("Convert(%s %s into %s) = %v, want %v", tt.amount, tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func mustParseRate(t *testing.T, text string) Rate {
+	t.Helper()
+
+	rate, err := ParseRate(text)
+
+	if err != nil {
+		t.Fatalf("ParseRate(%q) error = %v", text, err)
+	}
+
+	return rate
+}
@@ -0,0 +1,26 @@
+package adaptor
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+)
+
+// IPaymentGateway is implemented by every payment provider, Code must match code column of payment_methods
+type IPaymentGateway interface {
+	Code() common.MethodType
+	// Currency is currency which provider settles payments in, orders paid by provider are in it
+	Currency() string
+	// CreatePayment returns url which buyer pays at, Attempt of result is filled even when payment can not be created
+	CreatePayment(ctx context.Context, data CreatePaymentRequest) (*CreatePaymentResult, error)
+	// VerifyCallback checks signature of callback (ipn) sent by provider and reads result of payment from it
+	VerifyCallback(ctx context.Context, rawData []byte) (*CallbackResult, error)
+	// CallbackAck builds response which provider expects for callback, so provider knows whether to send it again
+	CallbackAck(outcome CallbackOutcome) *CallbackAck
+	QueryStatus(ctx context.Context, data QueryStatusRequest) (*QueryStatusResult, error)
+	// Refund gives money back to buyer, RawResponse of result is filled even when provider rejects refund
+	Refund(ctx context.Context, data RefundRequest) (*RefundResult, error)
+}
+
+type IPaymentGatewayRegistry interface {
+	Get(code common.MethodType) (IPaymentGateway, error)
+}
@@ -0,0 +1,301 @@
+package adaptor
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/env"
+	"github.com/TienMinh25/ecommerce-platform/internal/httpclient"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strconv"
+)
+
+type momoGateway struct {
+	tracer     pkg.Tracer
+	envManager *env.EnvManager
+	httpClient pkg.HTTPClient
+}
+
+func NewMomoGateway(tracer pkg.Tracer, envManager *env.EnvManager, httpClient pkg.HTTPClient) IPaymentGateway {
+	return &momoGateway{
+		tracer:     tracer,
+		envManager: envManager,
+		httpClient: httpClient,
+	}
+}
+
+func (m *momoGateway) Code() common.MethodType {
+	return common.Momo
+}
+
+func (m *momoGateway) Currency() string {
+	return "VND"
+}
+
+func (m *momoGateway) CreatePayment(ctx context.Context, data CreatePaymentRequest) (*CreatePaymentResult, error) {
+	ctx, span := m.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.AdapterLayer, "momo.CreatePayment"))
+	defer span.End()
+
+	momoConfig := m.envManager.MomoConfig
+	total := data.TotalAmount.GatewayUnits()
+	requestId := uuid.New().String()
+	requestType := "payWithMethod"
+
+	rawSignature := fmt.Sprintf("accessKey=%v&amount=%v&extraData=%v&ipnUrl=%v&orderId=%v&orderInfo=%v&partnerCode=%v&redirectUrl=%v&requestId=%v&requestType=%v",
+		momoConfig.MomoAccessKey, total, "", momoConfig.MomoNotifyURL, data.OrderID, data.OrderInfo, momoConfig.MomoPartnerCode,
+		momoConfig.MomoRedirectURL, requestId, requestType)
+
+	payload := momoPaymentCreateRequest{
+		PartnerCode: momoConfig.MomoPartnerCode,
+		RequestID:   requestId,
+		Amount:      total,
+		OrderID:     data.OrderID,
+		OrderInfo:   data.OrderInfo,
+		RedirectUrl: momoConfig.MomoRedirectURL,
+		IpnUrl:      momoConfig.MomoNotifyURL,
+		RequestType: requestType,
+		ExtraData:   "",
+		Lang:        "vi",
+		AutoCapture: true,
+		Signature:   m.sign(rawSignature),
+	}
+
+	result := &CreatePaymentResult{}
+
+	resApi, err := m.httpClient.SendRequest(ctx, http.MethodPost, fmt.Sprintf("%v/v2/gateway/api/create", momoConfig.MomoHost),
+		httpclient.WithJSONBody(payload),
+		httpclient.WithHeader("Content-Type", "application/json; charset=UTF-8"))
+
+	if err != nil {
+		span.RecordError(err)
+		result.Attempt, _ = json.Marshal(momoPaymentAttempt{Request: payload})
+		return result, status.Error(codes.Internal, err.Error())
+	}
+
+	var response momoPaymentCreateResponse
+
+	if err = json.Unmarshal(resApi.RawBody, &response); err != nil {
+		span.RecordError(err)
+		result.Attempt, _ = json.Marshal(momoPaymentAttempt{Request: payload})
+		return result, status.Error(codes.Internal, err.Error())
+	}
+
+	result.Attempt, _ = json.Marshal(momoPaymentAttempt{Request: payload, Response: &response})
+
+	if err = m.checkPaymentCreateResponse(response, data.OrderID, requestId, total); err != nil {
+		span.RecordError(err)
+		return result, err
+	}
+
+	result.PaymentURL = response.PayURL
+
+	return result, nil
+}
+
+func (m *momoGateway) checkPaymentCreateResponse(response momoPaymentCreateResponse, orderID, requestId string, total int64) error {
+	if response.ResultCode != 0 && response.ResultCode != 9000 {
+		return status.Error(codes.FailedPrecondition, response.Message)
+	}
+
+	if response.OrderID != orderID {
+		return status.Error(codes.FailedPrecondition, "Order is is not match")
+	}
+
+	if response.RequestID != requestId {
+		return status.Error(codes.FailedPrecondition, "RequestId is not match")
+	}
+
+	if response.PartnerCode != m.envManager.MomoConfig.MomoPartnerCode {
+		return status.Error(codes.FailedPrecondition, "Partner code is not match")
+	}
+
+	if response.Amount != total {
+		return status.Error(codes.FailedPrecondition, "Amount money is not match")
+	}
+
+	return nil
+}
+
+func (m *momoGateway) VerifyCallback(ctx context.Context, rawData []byte) (*CallbackResult, error) {
+	_, span := m.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.AdapterLayer, "momo.VerifyCallback"))
+	defer span.End()
+
+	var data momoIPNRequest
+
+	if err := json.Unmarshal(rawData, &data); err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.InvalidArgument, "Callback data is invalid")
+	}
+
+	rawSignature := fmt.Sprintf("accessKey=%v&amount=%v&extraData=%v&message=%v&orderId=%v&orderInfo=%v&orderType=%v&partnerCode=%v&payType=%v&requestId=%v&responseTime=%v&resultCode=%v&transId=%v",
+		m.envManager.MomoConfig.MomoAccessKey, data.Amount, data.ExtraData, data.Message, data.OrderID, data.OrderInfo,
+		data.OrderType, data.PartnerCode, data.PayType, data.RequestID, data.ResponseTime, data.ResultCode,
+		data.TransId)
+
+	if !hmac.Equal([]byte(m.sign(rawSignature)), []byte(data.Signature)) {
+		return nil, status.Error(codes.InvalidArgument, "Signature does not match")
+	}
+
+	if data.PartnerCode != m.envManager.MomoConfig.MomoPartnerCode {
+		return nil, status.Error(codes.InvalidArgument, "Partner code is not match")
+	}
+
+	return &CallbackResult{
+		OrderID:       data.OrderID,
+		TransactionID: strconv.FormatInt(data.TransId, 10),
+		Amount:        money.FromUnits(data.Amount),
+		IsSuccess:     data.ResultCode == 0,
+		Message:       data.Message,
+		RawData:       rawData,
+	}, nil
+}
+
+// CallbackAck returns status which momo expects for ipn, momo only reads status code of response
+func (m *momoGateway) CallbackAck(outcome CallbackOutcome) *CallbackAck {
+	switch outcome {
+	case CallbackConfirmed, CallbackAlreadyConfirmed:
+		return &CallbackAck{StatusCode: http.StatusNoContent}
+	case CallbackFailed:
+		return &CallbackAck{StatusCode: http.StatusInternalServerError}
+	}
+
+	return &CallbackAck{StatusCode: http.StatusBadRequest}
+}
+
+func (m *momoGateway) QueryStatus(ctx context.Context, data QueryStatusRequest) (*QueryStatusResult, error) {
+	ctx, span := m.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.AdapterLayer, "momo.QueryStatus"))
+	defer span.End()
+
+	momoConfig := m.envManager.MomoConfig
+	requestId := uuid.New().String()
+	orderID := data.OrderID
+
+	rawSignature := fmt.Sprintf("accessKey=%v&orderId=%v&partnerCode=%v&requestId=%v",
+		momoConfig.MomoAccessKey, orderID, momoConfig.MomoPartnerCode, requestId)
+
+	payload := momoQueryStatusRequest{
+		PartnerCode: momoConfig.MomoPartnerCode,
+		RequestID:   requestId,
+		OrderID:     orderID,
+		Lang:        "vi",
+		Signature:   m.sign(rawSignature),
+	}
+
+	resApi, err := m.httpClient.SendRequest(ctx, http.MethodPost, fmt.Sprintf("%v/v2/gateway/api/query", momoConfig.MomoHost),
+		httpclient.WithJSONBody(payload),
+		httpclient.WithHeader("Content-Type", "application/json; charset=UTF-8"))
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var response momoQueryStatusResponse
+
+	if err = json.Unmarshal(resApi.RawBody, &response); err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if response.OrderID != orderID || response.RequestID != requestId {
+		return nil, status.Error(codes.FailedPrecondition, "Query status response is not match")
+	}
+
+	result := &QueryStatusResult{
+		Status:        QueryStatusFailed,
+		TransactionID: strconv.FormatInt(response.TransID, 10),
+		Amount:        money.FromUnits(response.Amount),
+		Message:       response.Message,
+		RawResponse:   resApi.RawBody,
+	}
+
+	switch response.ResultCode {
+	case 0:
+		result.Status = QueryStatusPaid
+	case 1000, 7000, 7002:
+		// transaction is still waiting for buyer
+		result.Status = QueryStatusPending
+	}
+
+	return result, nil
+}
+
+func (m *momoGateway) Refund(ctx context.Context, data RefundRequest) (*RefundResult, error) {
+	ctx, span := m.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.AdapterLayer, "momo.Refund"))
+	defer span.End()
+
+	transID, err := strconv.ParseInt(data.TransactionID, 10, 64)
+
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, "Transaction id of payment is invalid")
+	}
+
+	momoConfig := m.envManager.MomoConfig
+	amount := data.Amount.GatewayUnits()
+	description := fmt.Sprintf("Refund for order item %v", data.OrderItemID)
+	requestId := uuid.New().String()
+
+	// momo require orderId of refund is unique, so use id of refund
+	rawSignature := fmt.Sprintf("accessKey=%v&amount=%v&description=%v&orderId=%v&partnerCode=%v&requestId=%v&transId=%v",
+		momoConfig.MomoAccessKey, amount, description, data.RefundID, momoConfig.MomoPartnerCode, requestId, transID)
+
+	payload := momoRefundRequest{
+		PartnerCode: momoConfig.MomoPartnerCode,
+		OrderID:     data.RefundID,
+		RequestID:   requestId,
+		Amount:      amount,
+		TransID:     transID,
+		Lang:        "vi",
+		Description: description,
+		Signature:   m.sign(rawSignature),
+	}
+
+	resApi, err := m.httpClient.SendRequest(ctx, http.MethodPost, fmt.Sprintf("%v/v2/gateway/api/refund", momoConfig.MomoHost),
+		httpclient.WithJSONBody(payload),
+		httpclient.WithHeader("Content-Type", "application/json; charset=UTF-8"))
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := &RefundResult{
+		RawResponse: resApi.RawBody,
+	}
+
+	var response momoRefundResponse
+
+	if err = json.Unmarshal(resApi.RawBody, &response); err != nil {
+		span.RecordError(err)
+		return result, status.Error(codes.Internal, err.Error())
+	}
+
+	if response.ResultCode != 0 {
+		return result, status.Error(codes.FailedPrecondition, response.Message)
+	}
+
+	if response.OrderID != data.RefundID || response.RequestID != requestId || response.Amount != amount {
+		return result, status.Error(codes.FailedPrecondition, "Refund response is not match")
+	}
+
+	result.TransactionID = strconv.FormatInt(response.TransID, 10)
+
+	return result, nil
+}
+
+func (m *momoGateway) sign(rawSignature string) string {
+	hmacBuilder := hmac.New(sha256.New, []byte(m.envManager.MomoConfig.MomoSecretKey))
+	hmacBuilder.Write([]byte(rawSignature))
+
+	return hex.EncodeToString(hmacBuilder.Sum(nil))
+}
@@ -0,0 +1,39 @@
+package adaptor
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/env"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type paymentGatewayRegistry struct {
+	gateways map[common.MethodType]IPaymentGateway
+}
+
+func NewPaymentGatewayRegistry(tracer pkg.Tracer, envManager *env.EnvManager, httpClient pkg.HTTPClient) IPaymentGatewayRegistry {
+	registry := &paymentGatewayRegistry{
+		gateways: make(map[common.MethodType]IPaymentGateway),
+	}
+
+	// new providers are registered in here
+	registry.register(NewMomoGateway(tracer, envManager, httpClient))
+	registry.register(NewVNPayGateway(tracer, envManager, httpClient))
+
+	return registry
+}
+
+func (r *paymentGatewayRegistry) register(gateway IPaymentGateway) {
+	r.gateways[gateway.Code()] = gateway
+}
+
+func (r *paymentGatewayRegistry) Get(code common.MethodType) (IPaymentGateway, error) {
+	gateway, ok := r.gateways[code]
+
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "Payment method %v is not supported", code)
+	}
+
+	return gateway, nil
+}
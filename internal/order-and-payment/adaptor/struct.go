@@ -0,0 +1,94 @@
+package adaptor
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"time"
+)
+
+type CreatePaymentRequest struct {
+	OrderID     string
+	TotalAmount money.Money
+	OrderInfo   string
+	ClientIP    string
+	// CreatedAt is created_at of order, providers which look up transaction by creation time use it
+	CreatedAt time.Time
+	// ProviderToken is token of saved payment method picked by buyer, providers without tokenized payment ignore it
+	ProviderToken string
+}
+
+type CreatePaymentResult struct {
+	PaymentURL string
+	// request and response of provider, saved into payment_gateway_response of payment history
+	Attempt []byte
+}
+
+type CallbackResult struct {
+	OrderID       string
+	TransactionID string
+	// Amount is amount which is paid, payment gateways of whole units return it without fraction
+	Amount    money.Money
+	IsSuccess bool
+	Message   string
+	RawData   []byte
+}
+
+// CallbackOutcome is how callback of provider was handled
+type CallbackOutcome string
+
+const (
+	CallbackConfirmed        CallbackOutcome = "confirmed"
+	CallbackAlreadyConfirmed CallbackOutcome = "already_confirmed"
+	// CallbackInvalidSignature is outcome of callback which is not signed by provider or can not be read
+	CallbackInvalidSignature CallbackOutcome = "invalid_signature"
+	CallbackOrderNotFound    CallbackOutcome = "order_not_found"
+	CallbackInvalidAmount    CallbackOutcome = "invalid_amount"
+	CallbackFailed           CallbackOutcome = "failed"
+)
+
+// CallbackAck is http response which is sent back to provider as it is, Body is empty when provider only reads status
+type CallbackAck struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+type QueryStatus string
+
+const (
+	QueryStatusPaid    QueryStatus = "paid"
+	QueryStatusPending QueryStatus = "pending"
+	QueryStatusFailed  QueryStatus = "failed"
+)
+
+type QueryStatusRequest struct {
+	OrderID string
+	// CreatedAt is created_at of order, same as CreatedAt of CreatePaymentRequest
+	CreatedAt time.Time
+}
+
+type QueryStatusResult struct {
+	Status        QueryStatus
+	TransactionID string
+	Amount        money.Money
+	Message       string
+	RawResponse   []byte
+}
+
+type RefundRequest struct {
+	RefundID      string
+	OrderID       string
+	OrderItemID   string
+	TransactionID string
+	Amount        money.Money
+	// OrderCreatedAt is created_at of order, same as CreatedAt of CreatePaymentRequest
+	OrderCreatedAt time.Time
+	// PaymentAmount is amount of payment which is refunded, used to tell full refund from partial refund
+	PaymentAmount money.Money
+	// ProcessedBy is id of user who refunds
+	ProcessedBy int64
+}
+
+type RefundResult struct {
+	TransactionID string
+	RawResponse   []byte
+}
@@ -0,0 +1,375 @@
+package adaptor
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/env"
+	"github.com/TienMinh25/ecommerce-platform/internal/httpclient"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// vnpayTimeLayout is format of every date sent to and received from vnpay (yyyyMMddHHmmss, GMT+7)
+	vnpayTimeLayout = "20060102150405"
+
+	vnpaySuccessCode = "00"
+	// vnpayNotFoundCode is returned by querydr when buyer never submitted payment
+	vnpayNotFoundCode = "91"
+	// vnpayPendingStatus is transaction status of payment which buyer has not completed
+	vnpayPendingStatus = "01"
+)
+
+var vnpayLocation = time.FixedZone("GMT+7", 7*60*60)
+
+type vnpayGateway struct {
+	tracer     pkg.Tracer
+	envManager *env.EnvManager
+	httpClient pkg.HTTPClient
+}
+
+func NewVNPayGateway(tracer pkg.Tracer, envManager *env.EnvManager, httpClient pkg.HTTPClient) IPaymentGateway {
+	return &vnpayGateway{
+		tracer:     tracer,
+		envManager: envManager,
+		httpClient: httpClient,
+	}
+}
+
+func (v *vnpayGateway) Code() common.MethodType {
+	return common.Vnpay
+}
+
+func (v *vnpayGateway) Currency() string {
+	return "VND"
+}
+
+// CreatePayment builds signed url of vnpay, vnpay is not called until buyer opens the url
+func (v *vnpayGateway) CreatePayment(ctx context.Context, data CreatePaymentRequest) (*CreatePaymentResult, error) {
+	_, span := v.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.AdapterLayer, "vnpay.CreatePayment"))
+	defer span.End()
+
+	vnpayConfig := v.envManager.VNPayConfig
+	createdAt := data.CreatedAt.In(vnpayLocation)
+	expiredAt := createdAt.Add(time.Duration(v.envManager.PaymentConfig.PaymentTTL) * time.Minute)
+
+	params := url.Values{}
+	params.Set("vnp_Version", vnpayConfig.VNPayVersion)
+	params.Set("vnp_Command", "pay")
+	params.Set("vnp_TmnCode", vnpayConfig.VNPayTmnCode)
+	// vnpay amount is multiplied by 100
+	params.Set("vnp_Amount", strconv.FormatInt(data.TotalAmount.GatewayUnits()*100, 10))
+	params.Set("vnp_CurrCode", "VND")
+	params.Set("vnp_TxnRef", data.OrderID)
+	params.Set("vnp_OrderInfo", data.OrderInfo)
+	params.Set("vnp_OrderType", "other")
+	params.Set("vnp_Locale", "vn")
+	params.Set("vnp_ReturnUrl", vnpayConfig.VNPayReturnURL)
+	params.Set("vnp_IpAddr", v.clientIP(data.ClientIP))
+	params.Set("vnp_CreateDate", createdAt.Format(vnpayTimeLayout))
+	params.Set("vnp_ExpireDate", expiredAt.Format(vnpayTimeLayout))
+
+	// Encode sorts params by key, which is the order vnpay signs them
+	query := params.Encode()
+	signature := v.sign(query)
+
+	request := make(map[string]string, len(params))
+
+	for key := range params {
+		request[key] = params.Get(key)
+	}
+
+	attempt, err := json.Marshal(vnpayPaymentAttempt{Request: request})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &CreatePaymentResult{
+		PaymentURL: fmt.Sprintf("%v?%v&vnp_SecureHash=%v", vnpayConfig.VNPayPayURL, query, signature),
+		Attempt:    attempt,
+	}, nil
+}
+
+// VerifyCallback reads ipn of vnpay, rawData is query string which vnpay sends to ipn url
+func (v *vnpayGateway) VerifyCallback(ctx context.Context, rawData []byte) (*CallbackResult, error) {
+	_, span := v.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.AdapterLayer, "vnpay.VerifyCallback"))
+	defer span.End()
+
+	params, err := url.ParseQuery(string(rawData))
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.InvalidArgument, "Callback data is invalid")
+	}
+
+	signature := params.Get("vnp_SecureHash")
+
+	// every vnp_ param except hash itself is signed
+	signed := url.Values{}
+
+	for key := range params {
+		if strings.HasPrefix(key, "vnp_") && key != "vnp_SecureHash" && key != "vnp_SecureHashType" {
+			signed.Set(key, params.Get(key))
+		}
+	}
+
+	if !hmac.Equal([]byte(v.sign(signed.Encode())), []byte(strings.ToLower(signature))) {
+		return nil, status.Error(codes.InvalidArgument, "Signature does not match")
+	}
+
+	if params.Get("vnp_TmnCode") != v.envManager.VNPayConfig.VNPayTmnCode {
+		return nil, status.Error(codes.InvalidArgument, "Tmn code is not match")
+	}
+
+	// vnp_Amount is amount multiplied by 100, so it is amount in minor units
+	amount, err := strconv.ParseInt(params.Get("vnp_Amount"), 10, 64)
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Amount is invalid")
+	}
+
+	// payment_gateway_response is jsonb, so query string is saved as json object
+	data := make(map[string]string, len(params))
+
+	for key := range params {
+		data[key] = params.Get(key)
+	}
+
+	rawJSON, err := json.Marshal(data)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	responseCode := params.Get("vnp_ResponseCode")
+
+	return &CallbackResult{
+		OrderID:       params.Get("vnp_TxnRef"),
+		TransactionID: params.Get("vnp_TransactionNo"),
+		Amount:        money.FromMinor(amount),
+		IsSuccess:     responseCode == vnpaySuccessCode && params.Get("vnp_TransactionStatus") == vnpaySuccessCode,
+		Message:       fmt.Sprintf("VNPay response code %v", responseCode),
+		RawData:       rawJSON,
+	}, nil
+}
+
+// CallbackAck returns RspCode of ipn which vnpay expects, vnpay reads it from body of http 200
+func (v *vnpayGateway) CallbackAck(outcome CallbackOutcome) *CallbackAck {
+	response := vnpayIPNResponse{RspCode: "99", Message: "Unknown error"}
+
+	switch outcome {
+	case CallbackConfirmed:
+		response = vnpayIPNResponse{RspCode: vnpaySuccessCode, Message: "Confirm Success"}
+	case CallbackAlreadyConfirmed:
+		response = vnpayIPNResponse{RspCode: "02", Message: "Order already confirmed"}
+	case CallbackOrderNotFound:
+		response = vnpayIPNResponse{RspCode: "01", Message: "Order not found"}
+	case CallbackInvalidAmount:
+		response = vnpayIPNResponse{RspCode: "04", Message: "Invalid amount"}
+	case CallbackInvalidSignature:
+		response = vnpayIPNResponse{RspCode: "97", Message: "Invalid signature"}
+	}
+
+	body, _ := json.Marshal(response)
+
+	return &CallbackAck{
+		StatusCode:  http.StatusOK,
+		ContentType: "application/json; charset=utf-8",
+		Body:        body,
+	}
+}
+
+// QueryStatus calls querydr api of vnpay
+func (v *vnpayGateway) QueryStatus(ctx context.Context, data QueryStatusRequest) (*QueryStatusResult, error) {
+	ctx, span := v.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.AdapterLayer, "vnpay.QueryStatus"))
+	defer span.End()
+
+	vnpayConfig := v.envManager.VNPayConfig
+
+	payload := vnpayQueryStatusRequest{
+		RequestID:       v.requestID(),
+		Version:         vnpayConfig.VNPayVersion,
+		Command:         "querydr",
+		TmnCode:         vnpayConfig.VNPayTmnCode,
+		TxnRef:          data.OrderID,
+		OrderInfo:       fmt.Sprintf("Query status of order %v", data.OrderID),
+		TransactionDate: data.CreatedAt.In(vnpayLocation).Format(vnpayTimeLayout),
+		CreateDate:      time.Now().In(vnpayLocation).Format(vnpayTimeLayout),
+		IpAddr:          vnpayConfig.VNPayServerIP,
+	}
+
+	payload.SecureHash = v.sign(strings.Join([]string{payload.RequestID, payload.Version, payload.Command,
+		payload.TmnCode, payload.TxnRef, payload.TransactionDate, payload.CreateDate, payload.IpAddr,
+		payload.OrderInfo}, "|"))
+
+	resApi, err := v.httpClient.SendRequest(ctx, http.MethodPost, vnpayConfig.VNPayAPIURL,
+		httpclient.WithJSONBody(payload),
+		httpclient.WithHeader("Content-Type", "application/json; charset=UTF-8"))
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var response vnpayQueryStatusResponse
+
+	if err = json.Unmarshal(resApi.RawBody, &response); err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := &QueryStatusResult{
+		Status:        QueryStatusFailed,
+		TransactionID: response.TransactionNo,
+		Message:       response.Message,
+		RawResponse:   resApi.RawBody,
+	}
+
+	switch response.ResponseCode {
+	case vnpaySuccessCode:
+	case vnpayNotFoundCode:
+		// buyer did not submit payment at vnpay
+		result.Status = QueryStatusPending
+		return result, nil
+	default:
+		return nil, status.Error(codes.FailedPrecondition, response.Message)
+	}
+
+	expectedSignature := v.sign(strings.Join([]string{response.ResponseID, response.Command, response.ResponseCode,
+		response.Message, response.TmnCode, response.TxnRef, response.Amount, response.BankCode, response.PayDate,
+		response.TransactionNo, response.TransactionType, response.TransactionStatus, response.OrderInfo,
+		response.PromotionCode, response.PromotionAmount}, "|"))
+
+	if !hmac.Equal([]byte(expectedSignature), []byte(strings.ToLower(response.SecureHash))) {
+		return nil, status.Error(codes.FailedPrecondition, "Signature of query status response does not match")
+	}
+
+	if response.TxnRef != data.OrderID {
+		return nil, status.Error(codes.FailedPrecondition, "Query status response is not match")
+	}
+
+	if amount, errParse := strconv.ParseInt(response.Amount, 10, 64); errParse == nil {
+		result.Amount = money.FromMinor(amount)
+	}
+
+	switch response.TransactionStatus {
+	case vnpaySuccessCode:
+		result.Status = QueryStatusPaid
+	case vnpayPendingStatus:
+		result.Status = QueryStatusPending
+	}
+
+	return result, nil
+}
+
+func (v *vnpayGateway) Refund(ctx context.Context, data RefundRequest) (*RefundResult, error) {
+	ctx, span := v.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.AdapterLayer, "vnpay.Refund"))
+	defer span.End()
+
+	vnpayConfig := v.envManager.VNPayConfig
+
+	// 02 is full refund, 03 is partial refund
+	transactionType := "03"
+
+	if data.Amount.Cmp(data.PaymentAmount) >= 0 {
+		transactionType = "02"
+	}
+
+	payload := vnpayRefundRequest{
+		RequestID:       v.requestID(),
+		Version:         vnpayConfig.VNPayVersion,
+		Command:         "refund",
+		TmnCode:         vnpayConfig.VNPayTmnCode,
+		TransactionType: transactionType,
+		TxnRef:          data.OrderID,
+		Amount:          data.Amount.GatewayUnits() * 100,
+		TransactionNo:   data.TransactionID,
+		TransactionDate: data.OrderCreatedAt.In(vnpayLocation).Format(vnpayTimeLayout),
+		CreateBy:        strconv.FormatInt(data.ProcessedBy, 10),
+		CreateDate:      time.Now().In(vnpayLocation).Format(vnpayTimeLayout),
+		IpAddr:          vnpayConfig.VNPayServerIP,
+		OrderInfo:       fmt.Sprintf("Refund for order item %v", data.OrderItemID),
+	}
+
+	payload.SecureHash = v.sign(strings.Join([]string{payload.RequestID, payload.Version, payload.Command,
+		payload.TmnCode, payload.TransactionType, payload.TxnRef, strconv.FormatInt(payload.Amount, 10),
+		payload.TransactionNo, payload.TransactionDate, payload.CreateBy, payload.CreateDate, payload.IpAddr,
+		payload.OrderInfo}, "|"))
+
+	resApi, err := v.httpClient.SendRequest(ctx, http.MethodPost, vnpayConfig.VNPayAPIURL,
+		httpclient.WithJSONBody(payload),
+		httpclient.WithHeader("Content-Type", "application/json; charset=UTF-8"))
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	result := &RefundResult{
+		RawResponse: resApi.RawBody,
+	}
+
+	var response vnpayRefundResponse
+
+	if err = json.Unmarshal(resApi.RawBody, &response); err != nil {
+		span.RecordError(err)
+		return result, status.Error(codes.Internal, err.Error())
+	}
+
+	if response.ResponseCode != vnpaySuccessCode {
+		return result, status.Error(codes.FailedPrecondition, response.Message)
+	}
+
+	expectedSignature := v.sign(strings.Join([]string{response.ResponseID, response.Command, response.ResponseCode,
+		response.Message, response.TmnCode, response.TxnRef, response.Amount, response.BankCode, response.PayDate,
+		response.TransactionNo, response.TransactionType, response.TransactionStatus, response.OrderInfo}, "|"))
+
+	if !hmac.Equal([]byte(expectedSignature), []byte(strings.ToLower(response.SecureHash))) {
+		return result, status.Error(codes.FailedPrecondition, "Signature of refund response does not match")
+	}
+
+	if response.TxnRef != data.OrderID || response.Amount != strconv.FormatInt(payload.Amount, 10) {
+		return result, status.Error(codes.FailedPrecondition, "Refund response is not match")
+	}
+
+	result.TransactionID = response.TransactionNo
+
+	return result, nil
+}
+
+func (v *vnpayGateway) sign(data string) string {
+	hmacBuilder := hmac.New(sha512.New, []byte(v.envManager.VNPayConfig.VNPayHashSecret))
+	hmacBuilder.Write([]byte(data))
+
+	return hex.EncodeToString(hmacBuilder.Sum(nil))
+}
+
+// requestID returns unique id of api request, vnpay limits it to 32 characters
+func (v *vnpayGateway) requestID() string {
+	return strings.ReplaceAll(uuid.New().String(), "-", "")
+}
+
+func (v *vnpayGateway) clientIP(ip string) string {
+	if ip == "" {
+		return v.envManager.VNPayConfig.VNPayServerIP
+	}
+
+	return ip
+}
@@ -0,0 +1,79 @@
+package adaptor
+
+// vnpayPaymentAttempt is saved into payment_gateway_response of payment history,
+// vnpay does not respond when payment url is built, so only params of url are saved
+type vnpayPaymentAttempt struct {
+	Request map[string]string `json:"request"`
+}
+
+type vnpayQueryStatusRequest struct {
+	RequestID       string `json:"vnp_RequestId"`
+	Version         string `json:"vnp_Version"`
+	Command         string `json:"vnp_Command"`
+	TmnCode         string `json:"vnp_TmnCode"`
+	TxnRef          string `json:"vnp_TxnRef"`
+	OrderInfo       string `json:"vnp_OrderInfo"`
+	TransactionDate string `json:"vnp_TransactionDate"`
+	CreateDate      string `json:"vnp_CreateDate"`
+	IpAddr          string `json:"vnp_IpAddr"`
+	SecureHash      string `json:"vnp_SecureHash"`
+}
+
+type vnpayQueryStatusResponse struct {
+	ResponseID        string `json:"vnp_ResponseId"`
+	Command           string `json:"vnp_Command"`
+	ResponseCode      string `json:"vnp_ResponseCode"`
+	Message           string `json:"vnp_Message"`
+	TmnCode           string `json:"vnp_TmnCode"`
+	TxnRef            string `json:"vnp_TxnRef"`
+	Amount            string `json:"vnp_Amount"`
+	BankCode          string `json:"vnp_BankCode"`
+	PayDate           string `json:"vnp_PayDate"`
+	TransactionNo     string `json:"vnp_TransactionNo"`
+	TransactionType   string `json:"vnp_TransactionType"`
+	TransactionStatus string `json:"vnp_TransactionStatus"`
+	OrderInfo         string `json:"vnp_OrderInfo"`
+	PromotionCode     string `json:"vnp_PromotionCode"`
+	PromotionAmount   string `json:"vnp_PromotionAmount"`
+	SecureHash        string `json:"vnp_SecureHash"`
+}
+
+type vnpayRefundRequest struct {
+	RequestID       string `json:"vnp_RequestId"`
+	Version         string `json:"vnp_Version"`
+	Command         string `json:"vnp_Command"`
+	TmnCode         string `json:"vnp_TmnCode"`
+	TransactionType string `json:"vnp_TransactionType"`
+	TxnRef          string `json:"vnp_TxnRef"`
+	Amount          int64  `json:"vnp_Amount"`
+	TransactionNo   string `json:"vnp_TransactionNo"`
+	TransactionDate string `json:"vnp_TransactionDate"`
+	CreateBy        string `json:"vnp_CreateBy"`
+	CreateDate      string `json:"vnp_CreateDate"`
+	IpAddr          string `json:"vnp_IpAddr"`
+	OrderInfo       string `json:"vnp_OrderInfo"`
+	SecureHash      string `json:"vnp_SecureHash"`
+}
+
+type vnpayRefundResponse struct {
+	ResponseID        string `json:"vnp_ResponseId"`
+	Command           string `json:"vnp_Command"`
+	ResponseCode      string `json:"vnp_ResponseCode"`
+	Message           string `json:"vnp_Message"`
+	TmnCode           string `json:"vnp_TmnCode"`
+	TxnRef            string `json:"vnp_TxnRef"`
+	Amount            string `json:"vnp_Amount"`
+	BankCode          string `json:"vnp_BankCode"`
+	PayDate           string `json:"vnp_PayDate"`
+	TransactionNo     string `json:"vnp_TransactionNo"`
+	TransactionType   string `json:"vnp_TransactionType"`
+	TransactionStatus string `json:"vnp_TransactionStatus"`
+	OrderInfo         string `json:"vnp_OrderInfo"`
+	SecureHash        string `json:"vnp_SecureHash"`
+}
+
+// vnpayIPNResponse is response of ipn, vnpay sends ipn again until it gets RspCode 00 or 02
+type vnpayIPNResponse struct {
+	RspCode string `json:"RspCode"`
+	Message string `json:"Message"`
+}
@@ -0,0 +1,502 @@
+package adaptor
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/TienMinh25/ecommerce-platform/internal/env"
+	"github.com/TienMinh25/ecommerce-platform/internal/httpclient"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testVNPayTmnCode    = "TESTTMN1"
+	testVNPayHashSecret = "TESTSECRETKEY"
+	testVNPayPayURL     = "https://sandbox.vnpayment.vn/paymentv2/vpcpay.html"
+	testOrderID         = "0b9c4b6e-3f51-4f0c-9f54-3c0a3b8e1d2a"
+)
+
+type noopTracer struct{}
+
+func (noopTracer) StartFromContext(ctx context.Context, name string) (context.Context, pkg.Span) {
+	return ctx, noopSpan{}
+}
+
+func (noopTracer) StartFromSpan(ctx context.Context, span pkg.Span, name string) (context.Context, pkg.Span) {
+	return ctx, noopSpan{}
+}
+
+func (noopTracer) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+func (noopTracer) Inject(ctx context.Context, carrier pkg.TextMapCarrier) {}
+
+func (noopTracer) Extract(ctx context.Context, carrier pkg.TextMapCarrier) pkg.Span {
+	return noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) End() {}
+
+func (noopSpan) RecordError(err error) {}
+
+func (noopSpan) SetAttributes(key string, value string) {}
+
+func (noopSpan) Context(ctx context.Context) context.Context {
+	return ctx
+}
+
+// newTestVNPayGateway returns vnpay gateway which calls apiURL instead of vnpay sandbox
+func newTestVNPayGateway(apiURL string) *vnpayGateway {
+	tracer := noopTracer{}
+
+	return &vnpayGateway{
+		tracer: tracer,
+		envManager: &env.EnvManager{
+			PaymentConfig: &env.PaymentConfig{
+				PaymentTTL:            15,
+				PaymentExpireInterval: 1,
+			},
+			VNPayConfig: &env.VNPayConfig{
+				VNPayTmnCode:    testVNPayTmnCode,
+				VNPayHashSecret: testVNPayHashSecret,
+				VNPayPayURL:     testVNPayPayURL,
+				VNPayAPIURL:     apiURL,
+				VNPayReturnURL:  "http://localhost:5173/user/account/orders",
+				VNPayVersion:    "2.1.0",
+				VNPayServerIP:   "127.0.0.1",
+			},
+		},
+		httpClient: httpclient.NewHTTPClient(tracer),
+	}
+}
+
+// signVNPay signs data like vnpay does, it does not use gateway, so signature of gateway is checked against it
+func signVNPay(data string) string {
+	hmacBuilder := hmac.New(sha512.New, []byte(testVNPayHashSecret))
+	hmacBuilder.Write([]byte(data))
+
+	return hex.EncodeToString(hmacBuilder.Sum(nil))
+}
+
+// newVNPaySandbox starts server which stands for api of vnpay, it checks signature of request before respond is called
+func newVNPaySandbox(t *testing.T, respond func(request map[string]string) map[string]string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var raw map[string]any
+
+		if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+			t.Errorf("decode request: %v", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		request := make(map[string]string, len(raw))
+
+		for key, value := range raw {
+			switch v := value.(type) {
+			case string:
+				request[key] = v
+			case float64:
+				request[key] = strconv.FormatInt(int64(v), 10)
+			}
+		}
+
+		var signedFields []string
+
+		switch request["vnp_Command"] {
+		case "querydr":
+			signedFields = []string{"vnp_RequestId", "vnp_Version", "vnp_Command", "vnp_TmnCode", "vnp_TxnRef",
+				"vnp_TransactionDate", "vnp_CreateDate", "vnp_IpAddr", "vnp_OrderInfo"}
+		case "refund":
+			signedFields = []string{"vnp_RequestId", "vnp_Version", "vnp_Command", "vnp_TmnCode", "vnp_TransactionType",
+				"vnp_TxnRef", "vnp_Amount", "vnp_TransactionNo", "vnp_TransactionDate", "vnp_CreateBy", "vnp_CreateDate",
+				"vnp_IpAddr", "vnp_OrderInfo"}
+		default:
+			t.Errorf("unexpected command %q", request["vnp_Command"])
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		values := make([]string, 0, len(signedFields))
+
+		for _, field := range signedFields {
+			values = append(values, request[field])
+		}
+
+		if request["vnp_SecureHash"] != signVNPay(strings.Join(values, "|")) {
+			t.Errorf("signature of %s request does not match", request["vnp_Command"])
+		}
+
+		if request["vnp_TmnCode"] != testVNPayTmnCode {
+			t.Errorf("tmn code = %q, want %q", request["vnp_TmnCode"], testVNPayTmnCode)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(respond(request))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// signVNPayResponse sets vnp_SecureHash of response from fields which vnpay signs, in order
+func signVNPayResponse(response map[string]string, fields []string) map[string]string {
+	values := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		values = append(values, response[field])
+	}
+
+	response["vnp_SecureHash"] = signVNPay(strings.Join(values, "|"))
+
+	return response
+}
+
+var (
+	vnpayQueryStatusResponseFields = []string{"vnp_ResponseId", "vnp_Command", "vnp_ResponseCode", "vnp_Message",
+		"vnp_TmnCode", "vnp_TxnRef", "vnp_Amount", "vnp_BankCode", "vnp_PayDate", "vnp_TransactionNo",
+		"vnp_TransactionType", "vnp_TransactionStatus", "vnp_OrderInfo", "vnp_PromotionCode", "vnp_PromotionAmount"}
+	vnpayRefundResponseFields = []string{"vnp_ResponseId", "vnp_Command", "vnp_ResponseCode", "vnp_Message",
+		"vnp_TmnCode", "vnp_TxnRef", "vnp_Amount", "vnp_BankCode", "vnp_PayDate", "vnp_TransactionNo",
+		"vnp_TransactionType", "vnp_TransactionStatus", "vnp_OrderInfo"}
+)
+
+func TestVNPayCreatePayment(t *testing.T) {
+	gateway := newTestVNPayGateway("")
+	createdAt := time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC)
+
+	result, err := gateway.CreatePayment(context.Background(), CreatePaymentRequest{
+		OrderID:     testOrderID,
+		TotalAmount: money.MustParse("150000.40"),
+		OrderInfo:   "Pay with Minh Plaza",
+		ClientIP:    "10.0.0.1",
+		CreatedAt:   createdAt,
+	})
+
+	if err != nil {
+		t.Fatalf("CreatePayment() error = %v", err)
+	}
+
+	if !strings.HasPrefix(result.PaymentURL, testVNPayPayURL+"?") {
+		t.Fatalf("payment url = %q, want prefix %q", result.PaymentURL, testVNPayPayURL)
+	}
+
+	query, signature, found := strings.Cut(strings.TrimPrefix(result.PaymentURL, testVNPayPayURL+"?"), "&vnp_SecureHash=")
+
+	if !found {
+		t.Fatalf("payment url %q has no vnp_SecureHash", result.PaymentURL)
+	}
+
+	if signature != signVNPay(query) {
+		t.Errorf("vnp_SecureHash = %q, want %q", signature, signVNPay(query))
+	}
+
+	params, err := url.ParseQuery(query)
+
+	if err != nil {
+		t.Fatalf("parse payment url: %v", err)
+	}
+
+	want := map[string]string{
+		"vnp_Command":    "pay",
+		"vnp_TmnCode":    testVNPayTmnCode,
+		"vnp_Amount":     "15000100",
+		"vnp_CurrCode":   "VND",
+		"vnp_TxnRef":     testOrderID,
+		"vnp_IpAddr":     "10.0.0.1",
+		"vnp_CreateDate": "20261018100000",
+		"vnp_ExpireDate": "20261018101500",
+	}
+
+	for key, value := range want {
+		if got := params.Get(key); got != value {
+			t.Errorf("%s = %q, want %q", key, got, value)
+		}
+	}
+
+	if len(result.Attempt) == 0 {
+		t.Error("attempt of payment is empty")
+	}
+}
+
+func TestVNPayVerifyCallback(t *testing.T) {
+	gateway := newTestVNPayGateway("")
+
+	params := url.Values{}
+	params.Set("vnp_TmnCode", testVNPayTmnCode)
+	params.Set("vnp_Amount", "15000100")
+	params.Set("vnp_BankCode", "NCB")
+	params.Set("vnp_OrderInfo", "Pay with Minh Plaza")
+	params.Set("vnp_PayDate", "20261018101000")
+	params.Set("vnp_ResponseCode", "00")
+	params.Set("vnp_TransactionNo", "14512345")
+	params.Set("vnp_TransactionStatus", "00")
+	params.Set("vnp_TxnRef", testOrderID)
+
+	signature := signVNPay(params.Encode())
+
+	t.Run("good hash", func(t *testing.T) {
+		signed := url.Values{}
+
+		for key := range params {
+			signed.Set(key, params.Get(key))
+		}
+
+		// vnpay sends hash in upper case and hash type next to it, both are not signed
+		signed.Set("vnp_SecureHash", strings.ToUpper(signature))
+		signed.Set("vnp_SecureHashType", "HmacSHA512")
+
+		result, err := gateway.VerifyCallback(context.Background(), []byte(signed.Encode()))
+
+		if err != nil {
+			t.Fatalf("VerifyCallback() error = %v", err)
+		}
+
+		if result.OrderID != testOrderID || result.TransactionID != "14512345" || !result.IsSuccess {
+			t.Errorf("VerifyCallback() = %+v", result)
+		}
+
+		if result.Amount != money.FromUnits(150001) {
+			t.Errorf("amount = %s, want 150001", result.Amount)
+		}
+	})
+
+	t.Run("bad hash", func(t *testing.T) {
+		tampered := url.Values{}
+
+		for key := range params {
+			tampered.Set(key, params.Get(key))
+		}
+
+		// amount is changed after vnpay signed callback
+		tampered.Set("vnp_Amount", "100")
+		tampered.Set("vnp_SecureHash", signature)
+
+		_, err := gateway.VerifyCallback(context.Background(), []byte(tampered.Encode()))
+
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("VerifyCallback() error = %v, want InvalidArgument", err)
+		}
+	})
+}
+
+func TestVNPayQueryStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		responseCode string
+		txnStatus    string
+		badSignature bool
+		wantStatus   QueryStatus
+		wantCode     codes.Code
+	}{
+		{name: "paid", responseCode: "00", txnStatus: "00", wantStatus: QueryStatusPaid},
+		{name: "waiting for buyer", responseCode: "00", txnStatus: "01", wantStatus: QueryStatusPending},
+		{name: "failed transaction", responseCode: "00", txnStatus: "02", wantStatus: QueryStatusFailed},
+		{name: "bad signature", responseCode: "00", txnStatus: "00", badSignature: true, wantCode: codes.FailedPrecondition},
+		{name: "not submitted", responseCode: "91", wantStatus: QueryStatusPending},
+		{name: "other error", responseCode: "94", wantCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newVNPaySandbox(t, func(request map[string]string) map[string]string {
+				response := map[string]string{
+					"vnp_ResponseId":        "resp01",
+					"vnp_Command":           "querydr",
+					"vnp_ResponseCode":      tt.responseCode,
+					"vnp_Message":           "QueryDR Success",
+					"vnp_TmnCode":           testVNPayTmnCode,
+					"vnp_TxnRef":            request["vnp_TxnRef"],
+					"vnp_Amount":            "15000100",
+					"vnp_BankCode":          "NCB",
+					"vnp_PayDate":           "20261018101000",
+					"vnp_TransactionNo":     "14512345",
+					"vnp_TransactionType":   "01",
+					"vnp_TransactionStatus": tt.txnStatus,
+					"vnp_OrderInfo":         request["vnp_OrderInfo"],
+				}
+
+				if tt.responseCode != "00" {
+					// vnpay does not sign error responses
+					return response
+				}
+
+				signVNPayResponse(response, vnpayQueryStatusResponseFields)
+
+				if tt.badSignature {
+					response["vnp_Amount"] = "100"
+				}
+
+				return response
+			})
+
+			gateway := newTestVNPayGateway(server.URL)
+
+			result, err := gateway.QueryStatus(context.Background(), QueryStatusRequest{
+				OrderID:   testOrderID,
+				CreatedAt: time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC),
+			})
+
+			if tt.wantCode != codes.OK {
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("QueryStatus() error = %v, want %v", err, tt.wantCode)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("QueryStatus() error = %v", err)
+			}
+
+			if result.Status != tt.wantStatus {
+				t.Errorf("status = %v, want %v", result.Status, tt.wantStatus)
+			}
+
+			if tt.wantStatus == QueryStatusPaid && result.Amount != money.FromUnits(150001) {
+				t.Errorf("amount = %s, want 150001", result.Amount)
+			}
+		})
+	}
+}
+
+func TestVNPayRefund(t *testing.T) {
+	tests := []struct {
+		name          string
+		amount        money.Money
+		paymentAmount money.Money
+		badSignature  bool
+		otherAmount   bool
+		wantType      string
+		wantAmount    string
+		wantCode      codes.Code
+	}{
+		{name: "signed response", amount: money.FromUnits(50000), paymentAmount: money.FromUnits(150001),
+			wantType: "03", wantAmount: "5000000"},
+		{name: "bad signature", amount: money.FromUnits(50000), paymentAmount: money.FromUnits(150001),
+			badSignature: true, wantType: "03", wantAmount: "5000000", wantCode: codes.FailedPrecondition},
+		{name: "amount is not match", amount: money.FromUnits(50000), paymentAmount: money.FromUnits(150001),
+			otherAmount: true, wantType: "03", wantAmount: "5000000", wantCode: codes.FailedPrecondition},
+		// 150000.40 was charged as 150001, so full refund gives back the same amount
+		{name: "full refund of fractional amount", amount: money.MustParse("150000.40"),
+			paymentAmount: money.MustParse("150000.40"), wantType: "02", wantAmount: "15000100"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newVNPaySandbox(t, func(request map[string]string) map[string]string {
+				if request["vnp_TransactionType"] != tt.wantType || request["vnp_Amount"] != tt.wantAmount {
+					t.Errorf("refund request = %v, want refund type %s of %s", request, tt.wantType, tt.wantAmount)
+				}
+
+				response := map[string]string{
+					"vnp_ResponseId":        "resp02",
+					"vnp_Command":           "refund",
+					"vnp_ResponseCode":      "00",
+					"vnp_Message":           "Refund success",
+					"vnp_TmnCode":           testVNPayTmnCode,
+					"vnp_TxnRef":            request["vnp_TxnRef"],
+					"vnp_Amount":            request["vnp_Amount"],
+					"vnp_BankCode":          "NCB",
+					"vnp_PayDate":           "20261018110000",
+					"vnp_TransactionNo":     "14519999",
+					"vnp_TransactionType":   request["vnp_TransactionType"],
+					"vnp_TransactionStatus": "05",
+					"vnp_OrderInfo":         request["vnp_OrderInfo"],
+				}
+
+				if tt.otherAmount {
+					response["vnp_Amount"] = "15000100"
+				}
+
+				signVNPayResponse(response, vnpayRefundResponseFields)
+
+				if tt.badSignature {
+					response["vnp_TransactionNo"] = "1"
+				}
+
+				return response
+			})
+
+			gateway := newTestVNPayGateway(server.URL)
+
+			result, err := gateway.Refund(context.Background(), RefundRequest{
+				RefundID:       "refund-1",
+				OrderID:        testOrderID,
+				OrderItemID:    "item-1",
+				TransactionID:  "14512345",
+				Amount:         tt.amount,
+				OrderCreatedAt: time.Date(2026, 10, 18, 3, 0, 0, 0, time.UTC),
+				PaymentAmount:  tt.paymentAmount,
+				ProcessedBy:    1,
+			})
+
+			if result == nil || len(result.RawResponse) == 0 {
+				t.Fatal("raw response of refund is not returned")
+			}
+
+			if tt.wantCode != codes.OK {
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("Refund() error = %v, want %v", err, tt.wantCode)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Refund() error = %v", err)
+			}
+
+			if result.TransactionID != "14519999" {
+				t.Errorf("transaction id = %q, want 14519999", result.TransactionID)
+			}
+		})
+	}
+}
+
+func TestVNPayCallbackAck(t *testing.T) {
+	gateway := newTestVNPayGateway("")
+
+	tests := []struct {
+		outcome CallbackOutcome
+		want    string
+	}{
+		{outcome: CallbackConfirmed, want: `{"RspCode":"00","Message":"Confirm Success"}`},
+		{outcome: CallbackOrderNotFound, want: `{"RspCode":"01","Message":"Order not found"}`},
+		{outcome: CallbackAlreadyConfirmed, want: `{"RspCode":"02","Message":"Order already confirmed"}`},
+		{outcome: CallbackInvalidAmount, want: `{"RspCode":"04","Message":"Invalid amount"}`},
+		{outcome: CallbackInvalidSignature, want: `{"RspCode":"97","Message":"Invalid signature"}`},
+		{outcome: CallbackFailed, want: `{"RspCode":"99","Message":"Unknown error"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.outcome), func(t *testing.T) {
+			ack := gateway.CallbackAck(tt.outcome)
+
+			if ack.StatusCode != http.StatusOK {
+				t.Errorf("status code = %d, want %d", ack.StatusCode, http.StatusOK)
+			}
+
+			if string(ack.Body) != tt.want {
+				t.Errorf("body = %s, want %s", ack.Body, tt.want)
+			}
+		})
+	}
+}
@@ -19,24 +19,52 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_AddItemToCart_FullMethodName             = "/OrderService/AddItemToCart"
-	OrderService_GetCart_FullMethodName                   = "/OrderService/GetCart"
-	OrderService_UpdateCart_FullMethodName                = "/OrderService/UpdateCart"
-	OrderService_RemoveCartItem_FullMethodName            = "/OrderService/RemoveCartItem"
-	OrderService_GetCoupons_FullMethodName                = "/OrderService/GetCoupons"
-	OrderService_CreateCoupon_FullMethodName              = "/OrderService/CreateCoupon"
-	OrderService_GetCouponsByClient_FullMethodName        = "/OrderService/GetCouponsByClient"
-	OrderService_GetDetailCoupon_FullMethodName           = "/OrderService/GetDetailCoupon"
-	OrderService_UpdateCoupon_FullMethodName              = "/OrderService/UpdateCoupon"
-	OrderService_DeleteCoupon_FullMethodName              = "/OrderService/DeleteCoupon"
-	OrderService_GetPaymentMethods_FullMethodName         = "/OrderService/GetPaymentMethods"
-	OrderService_CreateOrder_FullMethodName               = "/OrderService/CreateOrder"
-	OrderService_GetMyOrders_FullMethodName               = "/OrderService/GetMyOrders"
-	OrderService_UpdateOrderStatusFromMomo_FullMethodName = "/OrderService/UpdateOrderStatusFromMomo"
-	OrderService_RegisterDeliverer_FullMethodName         = "/OrderService/RegisterDeliverer"
-	OrderService_CreateCartForRegister_FullMethodName     = "/OrderService/CreateCartForRegister"
-	OrderService_GetSupplierOrders_FullMethodName         = "/OrderService/GetSupplierOrders"
-	OrderService_UpdateOrderItem_FullMethodName           = "/OrderService/UpdateOrderItem"
+	OrderService_AddItemToCart_FullMethodName               = "/OrderService/AddItemToCart"
+	OrderService_GetCart_FullMethodName                     = "/OrderService/GetCart"
+	OrderService_UpdateCart_FullMethodName                  = "/OrderService/UpdateCart"
+	OrderService_RemoveCartItem_FullMethodName              = "/OrderService/RemoveCartItem"
+	OrderService_ReorderToCart_FullMethodName               = "/OrderService/ReorderToCart"
+	OrderService_MergeGuestCart_FullMethodName              = "/OrderService/MergeGuestCart"
+	OrderService_AcknowledgeCartItemChanges_FullMethodName  = "/OrderService/AcknowledgeCartItemChanges"
+	OrderService_AddItemToWishlist_FullMethodName           = "/OrderService/AddItemToWishlist"
+	OrderService_GetWishlist_FullMethodName                 = "/OrderService/GetWishlist"
+	OrderService_RemoveWishlistItems_FullMethodName         = "/OrderService/RemoveWishlistItems"
+	OrderService_MoveWishlistItemToCart_FullMethodName      = "/OrderService/MoveWishlistItemToCart"
+	OrderService_MoveCartItemToWishlist_FullMethodName      = "/OrderService/MoveCartItemToWishlist"
+	OrderService_GetCoupons_FullMethodName                  = "/OrderService/GetCoupons"
+	OrderService_CreateCoupon_FullMethodName                = "/OrderService/CreateCoupon"
+	OrderService_GetCouponsByClient_FullMethodName          = "/OrderService/GetCouponsByClient"
+	OrderService_GetDetailCoupon_FullMethodName             = "/OrderService/GetDetailCoupon"
+	OrderService_UpdateCoupon_FullMethodName                = "/OrderService/UpdateCoupon"
+	OrderService_DeleteCoupon_FullMethodName                = "/OrderService/DeleteCoupon"
+	OrderService_CreateCouponCampaign_FullMethodName        = "/OrderService/CreateCouponCampaign"
+	OrderService_GetCouponCampaigns_FullMethodName          = "/OrderService/GetCouponCampaigns"
+	OrderService_ExportCouponCampaignCodes_FullMethodName   = "/OrderService/ExportCouponCampaignCodes"
+	OrderService_RevokeCouponCampaignCodes_FullMethodName   = "/OrderService/RevokeCouponCampaignCodes"
+	OrderService_GetPaymentMethods_FullMethodName           = "/OrderService/GetPaymentMethods"
+	OrderService_CreateOrder_FullMethodName                 = "/OrderService/CreateOrder"
+	OrderService_QuoteCheckout_FullMethodName               = "/OrderService/QuoteCheckout"
+	OrderService_GetMyOrders_FullMethodName                 = "/OrderService/GetMyOrders"
+	OrderService_HandlePaymentCallback_FullMethodName       = "/OrderService/HandlePaymentCallback"
+	OrderService_RegisterDeliverer_FullMethodName           = "/OrderService/RegisterDeliverer"
+	OrderService_CreateCartForRegister_FullMethodName       = "/OrderService/CreateCartForRegister"
+	OrderService_GetSupplierOrders_FullMethodName           = "/OrderService/GetSupplierOrders"
+	OrderService_UpdateOrderItem_FullMethodName             = "/OrderService/UpdateOrderItem"
+	OrderService_CancelOrderItem_FullMethodName             = "/OrderService/CancelOrderItem"
+	OrderService_GetOrderItemTimeline_FullMethodName        = "/OrderService/GetOrderItemTimeline"
+	OrderService_CreateRefund_FullMethodName                = "/OrderService/CreateRefund"
+	OrderService_ProcessRefund_FullMethodName               = "/OrderService/ProcessRefund"
+	OrderService_GetRefunds_FullMethodName                  = "/OrderService/GetRefunds"
+	OrderService_GetUserPaymentMethods_FullMethodName       = "/OrderService/GetUserPaymentMethods"
+	OrderService_CreateUserPaymentMethod_FullMethodName     = "/OrderService/CreateUserPaymentMethod"
+	OrderService_SetDefaultUserPaymentMethod_FullMethodName = "/OrderService/SetDefaultUserPaymentMethod"
+	OrderService_DeleteUserPaymentMethod_FullMethodName     = "/OrderService/DeleteUserPaymentMethod"
+	OrderService_GetShippingRates_FullMethodName            = "/OrderService/GetShippingRates"
+	OrderService_CreateShippingRate_FullMethodName          = "/OrderService/CreateShippingRate"
+	OrderService_UpdateShippingRate_FullMethodName          = "/OrderService/UpdateShippingRate"
+	OrderService_DeleteShippingRate_FullMethodName          = "/OrderService/DeleteShippingRate"
+	OrderService_GetExchangeRates_FullMethodName            = "/OrderService/GetExchangeRates"
+	OrderService_UpdateExchangeRates_FullMethodName         = "/OrderService/UpdateExchangeRates"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -47,20 +75,48 @@ type OrderServiceClient interface {
 	GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
 	UpdateCart(ctx context.Context, in *UpdateCartItemRequest, opts ...grpc.CallOption) (*UpdateCartItemResponse, error)
 	RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, opts ...grpc.CallOption) (*RemoveCartItemResponse, error)
+	ReorderToCart(ctx context.Context, in *ReorderToCartRequest, opts ...grpc.CallOption) (*ReorderToCartResponse, error)
+	MergeGuestCart(ctx context.Context, in *MergeGuestCartRequest, opts ...grpc.CallOption) (*MergeGuestCartResponse, error)
+	AcknowledgeCartItemChanges(ctx context.Context, in *AcknowledgeCartItemChangesRequest, opts ...grpc.CallOption) (*AcknowledgeCartItemChangesResponse, error)
+	AddItemToWishlist(ctx context.Context, in *AddItemToWishlistRequest, opts ...grpc.CallOption) (*AddItemToWishlistResponse, error)
+	GetWishlist(ctx context.Context, in *GetWishlistRequest, opts ...grpc.CallOption) (*GetWishlistResponse, error)
+	RemoveWishlistItems(ctx context.Context, in *RemoveWishlistItemsRequest, opts ...grpc.CallOption) (*RemoveWishlistItemsResponse, error)
+	MoveWishlistItemToCart(ctx context.Context, in *MoveWishlistItemToCartRequest, opts ...grpc.CallOption) (*MoveWishlistItemToCartResponse, error)
+	MoveCartItemToWishlist(ctx context.Context, in *MoveCartItemToWishlistRequest, opts ...grpc.CallOption) (*MoveCartItemToWishlistResponse, error)
 	GetCoupons(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*GetCouponResponse, error)
 	CreateCoupon(ctx context.Context, in *CreateCouponRequest, opts ...grpc.CallOption) (*CreateCouponResponse, error)
 	GetCouponsByClient(ctx context.Context, in *GetCouponByClientRequest, opts ...grpc.CallOption) (*GetCouponResponse, error)
 	GetDetailCoupon(ctx context.Context, in *GetDetailCouponRequest, opts ...grpc.CallOption) (*GetDetailCouponResponse, error)
 	UpdateCoupon(ctx context.Context, in *UpdateCouponRequest, opts ...grpc.CallOption) (*UpdateCouponResponse, error)
 	DeleteCoupon(ctx context.Context, in *DeleteCouponRequest, opts ...grpc.CallOption) (*DeleteCouponResponse, error)
+	CreateCouponCampaign(ctx context.Context, in *CreateCouponCampaignRequest, opts ...grpc.CallOption) (*CreateCouponCampaignResponse, error)
+	GetCouponCampaigns(ctx context.Context, in *GetCouponCampaignsRequest, opts ...grpc.CallOption) (*GetCouponCampaignsResponse, error)
+	ExportCouponCampaignCodes(ctx context.Context, in *ExportCouponCampaignCodesRequest, opts ...grpc.CallOption) (*ExportCouponCampaignCodesResponse, error)
+	RevokeCouponCampaignCodes(ctx context.Context, in *RevokeCouponCampaignCodesRequest, opts ...grpc.CallOption) (*RevokeCouponCampaignCodesResponse, error)
 	GetPaymentMethods(ctx context.Context, in *GetPaymentMethodsRequest, opts ...grpc.CallOption) (*GetPaymentMethodsResponse, error)
 	CreateOrder(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*CheckoutResponse, error)
+	QuoteCheckout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*QuoteCheckoutResponse, error)
 	GetMyOrders(ctx context.Context, in *GetMyOrdersRequest, opts ...grpc.CallOption) (*GetMyOrdersResponse, error)
-	UpdateOrderStatusFromMomo(ctx context.Context, in *UpdateOrderStatusFromMomoRequest, opts ...grpc.CallOption) (*UpdateOrderStatusFromMomoResponse, error)
+	HandlePaymentCallback(ctx context.Context, in *HandlePaymentCallbackRequest, opts ...grpc.CallOption) (*HandlePaymentCallbackResponse, error)
 	RegisterDeliverer(ctx context.Context, in *RegisterDelivererRequest, opts ...grpc.CallOption) (*RegisterDelivererResponse, error)
 	CreateCartForRegister(ctx context.Context, in *CreateCartForRegisterRequest, opts ...grpc.CallOption) (*CreateCartForRegisterResponse, error)
 	GetSupplierOrders(ctx context.Context, in *GetSupplierOrdersRequest, opts ...grpc.CallOption) (*GetSupplierOrdersResponse, error)
 	UpdateOrderItem(ctx context.Context, in *UpdateOrderItemRequest, opts ...grpc.CallOption) (*UpdateOrderItemResponse, error)
+	CancelOrderItem(ctx context.Context, in *CancelOrderItemRequest, opts ...grpc.CallOption) (*CancelOrderItemResponse, error)
+	GetOrderItemTimeline(ctx context.Context, in *GetOrderItemTimelineRequest, opts ...grpc.CallOption) (*GetOrderItemTimelineResponse, error)
+	CreateRefund(ctx context.Context, in *CreateRefundRequest, opts ...grpc.CallOption) (*CreateRefundResponse, error)
+	ProcessRefund(ctx context.Context, in *ProcessRefundRequest, opts ...grpc.CallOption) (*ProcessRefundResponse, error)
+	GetRefunds(ctx context.Context, in *GetRefundsRequest, opts ...grpc.CallOption) (*GetRefundsResponse, error)
+	GetUserPaymentMethods(ctx context.Context, in *GetUserPaymentMethodsRequest, opts ...grpc.CallOption) (*GetUserPaymentMethodsResponse, error)
+	CreateUserPaymentMethod(ctx context.Context, in *CreateUserPaymentMethodRequest, opts ...grpc.CallOption) (*CreateUserPaymentMethodResponse, error)
+	SetDefaultUserPaymentMethod(ctx context.Context, in *SetDefaultUserPaymentMethodRequest, opts ...grpc.CallOption) (*SetDefaultUserPaymentMethodResponse, error)
+	DeleteUserPaymentMethod(ctx context.Context, in *DeleteUserPaymentMethodRequest, opts ...grpc.CallOption) (*DeleteUserPaymentMethodResponse, error)
+	GetShippingRates(ctx context.Context, in *GetShippingRatesRequest, opts ...grpc.CallOption) (*GetShippingRatesResponse, error)
+	CreateShippingRate(ctx context.Context, in *CreateShippingRateRequest, opts ...grpc.CallOption) (*CreateShippingRateResponse, error)
+	UpdateShippingRate(ctx context.Context, in *UpdateShippingRateRequest, opts ...grpc.CallOption) (*UpdateShippingRateResponse, error)
+	DeleteShippingRate(ctx context.Context, in *DeleteShippingRateRequest, opts ...grpc.CallOption) (*DeleteShippingRateResponse, error)
+	GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest, opts ...grpc.CallOption) (*GetExchangeRatesResponse, error)
+	UpdateExchangeRates(ctx context.Context, in *UpdateExchangeRatesRequest, opts ...grpc.CallOption) (*UpdateExchangeRatesResponse, error)
 }
 
 type orderServiceClient struct {
@@ -111,6 +167,86 @@ func (c *orderServiceClient) RemoveCartItem(ctx context.Context, in *RemoveCartI
 	return out, nil
 }
 
+func (c *orderServiceClient) ReorderToCart(ctx context.Context, in *ReorderToCartRequest, opts ...grpc.CallOption) (*ReorderToCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderToCartResponse)
+	err := c.cc.Invoke(ctx, OrderService_ReorderToCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) MergeGuestCart(ctx context.Context, in *MergeGuestCartRequest, opts ...grpc.CallOption) (*MergeGuestCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeGuestCartResponse)
+	err := c.cc.Invoke(ctx, OrderService_MergeGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) AcknowledgeCartItemChanges(ctx context.Context, in *AcknowledgeCartItemChangesRequest, opts ...grpc.CallOption) (*AcknowledgeCartItemChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcknowledgeCartItemChangesResponse)
+	err := c.cc.Invoke(ctx, OrderService_AcknowledgeCartItemChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) AddItemToWishlist(ctx context.Context, in *AddItemToWishlistRequest, opts ...grpc.CallOption) (*AddItemToWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddItemToWishlistResponse)
+	err := c.cc.Invoke(ctx, OrderService_AddItemToWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetWishlist(ctx context.Context, in *GetWishlistRequest, opts ...grpc.CallOption) (*GetWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWishlistResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RemoveWishlistItems(ctx context.Context, in *RemoveWishlistItemsRequest, opts ...grpc.CallOption) (*RemoveWishlistItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveWishlistItemsResponse)
+	err := c.cc.Invoke(ctx, OrderService_RemoveWishlistItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) MoveWishlistItemToCart(ctx context.Context, in *MoveWishlistItemToCartRequest, opts ...grpc.CallOption) (*MoveWishlistItemToCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveWishlistItemToCartResponse)
+	err := c.cc.Invoke(ctx, OrderService_MoveWishlistItemToCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) MoveCartItemToWishlist(ctx context.Context, in *MoveCartItemToWishlistRequest, opts ...grpc.CallOption) (*MoveCartItemToWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveCartItemToWishlistResponse)
+	err := c.cc.Invoke(ctx, OrderService_MoveCartItemToWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) GetCoupons(ctx context.Context, in *GetCouponRequest, opts ...grpc.CallOption) (*GetCouponResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCouponResponse)
@@ -171,6 +307,46 @@ func (c *orderServiceClient) DeleteCoupon(ctx context.Context, in *DeleteCouponR
 	return out, nil
 }
 
+func (c *orderServiceClient) CreateCouponCampaign(ctx context.Context, in *CreateCouponCampaignRequest, opts ...grpc.CallOption) (*CreateCouponCampaignResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCouponCampaignResponse)
+	err := c.cc.Invoke(ctx, OrderService_CreateCouponCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetCouponCampaigns(ctx context.Context, in *GetCouponCampaignsRequest, opts ...grpc.CallOption) (*GetCouponCampaignsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCouponCampaignsResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetCouponCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ExportCouponCampaignCodes(ctx context.Context, in *ExportCouponCampaignCodesRequest, opts ...grpc.CallOption) (*ExportCouponCampaignCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportCouponCampaignCodesResponse)
+	err := c.cc.Invoke(ctx, OrderService_ExportCouponCampaignCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RevokeCouponCampaignCodes(ctx context.Context, in *RevokeCouponCampaignCodesRequest, opts ...grpc.CallOption) (*RevokeCouponCampaignCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeCouponCampaignCodesResponse)
+	err := c.cc.Invoke(ctx, OrderService_RevokeCouponCampaignCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) GetPaymentMethods(ctx context.Context, in *GetPaymentMethodsRequest, opts ...grpc.CallOption) (*GetPaymentMethodsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetPaymentMethodsResponse)
@@ -191,6 +367,16 @@ func (c *orderServiceClient) CreateOrder(ctx context.Context, in *CheckoutReques
 	return out, nil
 }
 
+func (c *orderServiceClient) QuoteCheckout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*QuoteCheckoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteCheckoutResponse)
+	err := c.cc.Invoke(ctx, OrderService_QuoteCheckout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) GetMyOrders(ctx context.Context, in *GetMyOrdersRequest, opts ...grpc.CallOption) (*GetMyOrdersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetMyOrdersResponse)
@@ -201,10 +387,10 @@ func (c *orderServiceClient) GetMyOrders(ctx context.Context, in *GetMyOrdersReq
 	return out, nil
 }
 
-func (c *orderServiceClient) UpdateOrderStatusFromMomo(ctx context.Context, in *UpdateOrderStatusFromMomoRequest, opts ...grpc.CallOption) (*UpdateOrderStatusFromMomoResponse, error) {
+func (c *orderServiceClient) HandlePaymentCallback(ctx context.Context, in *HandlePaymentCallbackRequest, opts ...grpc.CallOption) (*HandlePaymentCallbackResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateOrderStatusFromMomoResponse)
-	err := c.cc.Invoke(ctx, OrderService_UpdateOrderStatusFromMomo_FullMethodName, in, out, cOpts...)
+	out := new(HandlePaymentCallbackResponse)
+	err := c.cc.Invoke(ctx, OrderService_HandlePaymentCallback_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -251,6 +437,156 @@ func (c *orderServiceClient) UpdateOrderItem(ctx context.Context, in *UpdateOrde
 	return out, nil
 }
 
+func (c *orderServiceClient) CancelOrderItem(ctx context.Context, in *CancelOrderItemRequest, opts ...grpc.CallOption) (*CancelOrderItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOrderItemResponse)
+	err := c.cc.Invoke(ctx, OrderService_CancelOrderItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrderItemTimeline(ctx context.Context, in *GetOrderItemTimelineRequest, opts ...grpc.CallOption) (*GetOrderItemTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderItemTimelineResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderItemTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreateRefund(ctx context.Context, in *CreateRefundRequest, opts ...grpc.CallOption) (*CreateRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRefundResponse)
+	err := c.cc.Invoke(ctx, OrderService_CreateRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ProcessRefund(ctx context.Context, in *ProcessRefundRequest, opts ...grpc.CallOption) (*ProcessRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProcessRefundResponse)
+	err := c.cc.Invoke(ctx, OrderService_ProcessRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetRefunds(ctx context.Context, in *GetRefundsRequest, opts ...grpc.CallOption) (*GetRefundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRefundsResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetRefunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetUserPaymentMethods(ctx context.Context, in *GetUserPaymentMethodsRequest, opts ...grpc.CallOption) (*GetUserPaymentMethodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserPaymentMethodsResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetUserPaymentMethods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreateUserPaymentMethod(ctx context.Context, in *CreateUserPaymentMethodRequest, opts ...grpc.CallOption) (*CreateUserPaymentMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUserPaymentMethodResponse)
+	err := c.cc.Invoke(ctx, OrderService_CreateUserPaymentMethod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SetDefaultUserPaymentMethod(ctx context.Context, in *SetDefaultUserPaymentMethodRequest, opts ...grpc.CallOption) (*SetDefaultUserPaymentMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDefaultUserPaymentMethodResponse)
+	err := c.cc.Invoke(ctx, OrderService_SetDefaultUserPaymentMethod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) DeleteUserPaymentMethod(ctx context.Context, in *DeleteUserPaymentMethodRequest, opts ...grpc.CallOption) (*DeleteUserPaymentMethodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserPaymentMethodResponse)
+	err := c.cc.Invoke(ctx, OrderService_DeleteUserPaymentMethod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetShippingRates(ctx context.Context, in *GetShippingRatesRequest, opts ...grpc.CallOption) (*GetShippingRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShippingRatesResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetShippingRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CreateShippingRate(ctx context.Context, in *CreateShippingRateRequest, opts ...grpc.CallOption) (*CreateShippingRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateShippingRateResponse)
+	err := c.cc.Invoke(ctx, OrderService_CreateShippingRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) UpdateShippingRate(ctx context.Context, in *UpdateShippingRateRequest, opts ...grpc.CallOption) (*UpdateShippingRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateShippingRateResponse)
+	err := c.cc.Invoke(ctx, OrderService_UpdateShippingRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) DeleteShippingRate(ctx context.Context, in *DeleteShippingRateRequest, opts ...grpc.CallOption) (*DeleteShippingRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteShippingRateResponse)
+	err := c.cc.Invoke(ctx, OrderService_DeleteShippingRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetExchangeRates(ctx context.Context, in *GetExchangeRatesRequest, opts ...grpc.CallOption) (*GetExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) UpdateExchangeRates(ctx context.Context, in *UpdateExchangeRatesRequest, opts ...grpc.CallOption) (*UpdateExchangeRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, OrderService_UpdateExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -259,20 +595,48 @@ type OrderServiceServer interface {
 	GetCart(context.Context, *GetCartRequest) (*GetCartResponse, error)
 	UpdateCart(context.Context, *UpdateCartItemRequest) (*UpdateCartItemResponse, error)
 	RemoveCartItem(context.Context, *RemoveCartItemRequest) (*RemoveCartItemResponse, error)
+	ReorderToCart(context.Context, *ReorderToCartRequest) (*ReorderToCartResponse, error)
+	MergeGuestCart(context.Context, *MergeGuestCartRequest) (*MergeGuestCartResponse, error)
+	AcknowledgeCartItemChanges(context.Context, *AcknowledgeCartItemChangesRequest) (*AcknowledgeCartItemChangesResponse, error)
+	AddItemToWishlist(context.Context, *AddItemToWishlistRequest) (*AddItemToWishlistResponse, error)
+	GetWishlist(context.Context, *GetWishlistRequest) (*GetWishlistResponse, error)
+	RemoveWishlistItems(context.Context, *RemoveWishlistItemsRequest) (*RemoveWishlistItemsResponse, error)
+	MoveWishlistItemToCart(context.Context, *MoveWishlistItemToCartRequest) (*MoveWishlistItemToCartResponse, error)
+	MoveCartItemToWishlist(context.Context, *MoveCartItemToWishlistRequest) (*MoveCartItemToWishlistResponse, error)
 	GetCoupons(context.Context, *GetCouponRequest) (*GetCouponResponse, error)
 	CreateCoupon(context.Context, *CreateCouponRequest) (*CreateCouponResponse, error)
 	GetCouponsByClient(context.Context, *GetCouponByClientRequest) (*GetCouponResponse, error)
 	GetDetailCoupon(context.Context, *GetDetailCouponRequest) (*GetDetailCouponResponse, error)
 	UpdateCoupon(context.Context, *UpdateCouponRequest) (*UpdateCouponResponse, error)
 	DeleteCoupon(context.Context, *DeleteCouponRequest) (*DeleteCouponResponse, error)
+	CreateCouponCampaign(context.Context, *CreateCouponCampaignRequest) (*CreateCouponCampaignResponse, error)
+	GetCouponCampaigns(context.Context, *GetCouponCampaignsRequest) (*GetCouponCampaignsResponse, error)
+	ExportCouponCampaignCodes(context.Context, *ExportCouponCampaignCodesRequest) (*ExportCouponCampaignCodesResponse, error)
+	RevokeCouponCampaignCodes(context.Context, *RevokeCouponCampaignCodesRequest) (*RevokeCouponCampaignCodesResponse, error)
 	GetPaymentMethods(context.Context, *GetPaymentMethodsRequest) (*GetPaymentMethodsResponse, error)
 	CreateOrder(context.Context, *CheckoutRequest) (*CheckoutResponse, error)
+	QuoteCheckout(context.Context, *CheckoutRequest) (*QuoteCheckoutResponse, error)
 	GetMyOrders(context.Context, *GetMyOrdersRequest) (*GetMyOrdersResponse, error)
-	UpdateOrderStatusFromMomo(context.Context, *UpdateOrderStatusFromMomoRequest) (*UpdateOrderStatusFromMomoResponse, error)
+	HandlePaymentCallback(context.Context, *HandlePaymentCallbackRequest) (*HandlePaymentCallbackResponse, error)
 	RegisterDeliverer(context.Context, *RegisterDelivererRequest) (*RegisterDelivererResponse, error)
 	CreateCartForRegister(context.Context, *CreateCartForRegisterRequest) (*CreateCartForRegisterResponse, error)
 	GetSupplierOrders(context.Context, *GetSupplierOrdersRequest) (*GetSupplierOrdersResponse, error)
 	UpdateOrderItem(context.Context, *UpdateOrderItemRequest) (*UpdateOrderItemResponse, error)
+	CancelOrderItem(context.Context, *CancelOrderItemRequest) (*CancelOrderItemResponse, error)
+	GetOrderItemTimeline(context.Context, *GetOrderItemTimelineRequest) (*GetOrderItemTimelineResponse, error)
+	CreateRefund(context.Context, *CreateRefundRequest) (*CreateRefundResponse, error)
+	ProcessRefund(context.Context, *ProcessRefundRequest) (*ProcessRefundResponse, error)
+	GetRefunds(context.Context, *GetRefundsRequest) (*GetRefundsResponse, error)
+	GetUserPaymentMethods(context.Context, *GetUserPaymentMethodsRequest) (*GetUserPaymentMethodsResponse, error)
+	CreateUserPaymentMethod(context.Context, *CreateUserPaymentMethodRequest) (*CreateUserPaymentMethodResponse, error)
+	SetDefaultUserPaymentMethod(context.Context, *SetDefaultUserPaymentMethodRequest) (*SetDefaultUserPaymentMethodResponse, error)
+	DeleteUserPaymentMethod(context.Context, *DeleteUserPaymentMethodRequest) (*DeleteUserPaymentMethodResponse, error)
+	GetShippingRates(context.Context, *GetShippingRatesRequest) (*GetShippingRatesResponse, error)
+	CreateShippingRate(context.Context, *CreateShippingRateRequest) (*CreateShippingRateResponse, error)
+	UpdateShippingRate(context.Context, *UpdateShippingRateRequest) (*UpdateShippingRateResponse, error)
+	DeleteShippingRate(context.Context, *DeleteShippingRateRequest) (*DeleteShippingRateResponse, error)
+	GetExchangeRates(context.Context, *GetExchangeRatesRequest) (*GetExchangeRatesResponse, error)
+	UpdateExchangeRates(context.Context, *UpdateExchangeRatesRequest) (*UpdateExchangeRatesResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -295,6 +659,30 @@ func (UnimplementedOrderServiceServer) UpdateCart(context.Context, *UpdateCartIt
 func (UnimplementedOrderServiceServer) RemoveCartItem(context.Context, *RemoveCartItemRequest) (*RemoveCartItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCartItem not implemented")
 }
+func (UnimplementedOrderServiceServer) ReorderToCart(context.Context, *ReorderToCartRequest) (*ReorderToCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderToCart not implemented")
+}
+func (UnimplementedOrderServiceServer) MergeGuestCart(context.Context, *MergeGuestCartRequest) (*MergeGuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeGuestCart not implemented")
+}
+func (UnimplementedOrderServiceServer) AcknowledgeCartItemChanges(context.Context, *AcknowledgeCartItemChangesRequest) (*AcknowledgeCartItemChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeCartItemChanges not implemented")
+}
+func (UnimplementedOrderServiceServer) AddItemToWishlist(context.Context, *AddItemToWishlistRequest) (*AddItemToWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddItemToWishlist not implemented")
+}
+func (UnimplementedOrderServiceServer) GetWishlist(context.Context, *GetWishlistRequest) (*GetWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWishlist not implemented")
+}
+func (UnimplementedOrderServiceServer) RemoveWishlistItems(context.Context, *RemoveWishlistItemsRequest) (*RemoveWishlistItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWishlistItems not implemented")
+}
+func (UnimplementedOrderServiceServer) MoveWishlistItemToCart(context.Context, *MoveWishlistItemToCartRequest) (*MoveWishlistItemToCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveWishlistItemToCart not implemented")
+}
+func (UnimplementedOrderServiceServer) MoveCartItemToWishlist(context.Context, *MoveCartItemToWishlistRequest) (*MoveCartItemToWishlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCartItemToWishlist not implemented")
+}
 func (UnimplementedOrderServiceServer) GetCoupons(context.Context, *GetCouponRequest) (*GetCouponResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCoupons not implemented")
 }
@@ -313,17 +701,32 @@ func (UnimplementedOrderServiceServer) UpdateCoupon(context.Context, *UpdateCoup
 func (UnimplementedOrderServiceServer) DeleteCoupon(context.Context, *DeleteCouponRequest) (*DeleteCouponResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCoupon not implemented")
 }
+func (UnimplementedOrderServiceServer) CreateCouponCampaign(context.Context, *CreateCouponCampaignRequest) (*CreateCouponCampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCouponCampaign not implemented")
+}
+func (UnimplementedOrderServiceServer) GetCouponCampaigns(context.Context, *GetCouponCampaignsRequest) (*GetCouponCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCouponCampaigns not implemented")
+}
+func (UnimplementedOrderServiceServer) ExportCouponCampaignCodes(context.Context, *ExportCouponCampaignCodesRequest) (*ExportCouponCampaignCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportCouponCampaignCodes not implemented")
+}
+func (UnimplementedOrderServiceServer) RevokeCouponCampaignCodes(context.Context, *RevokeCouponCampaignCodesRequest) (*RevokeCouponCampaignCodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCouponCampaignCodes not implemented")
+}
 func (UnimplementedOrderServiceServer) GetPaymentMethods(context.Context, *GetPaymentMethodsRequest) (*GetPaymentMethodsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentMethods not implemented")
 }
 func (UnimplementedOrderServiceServer) CreateOrder(context.Context, *CheckoutRequest) (*CheckoutResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) QuoteCheckout(context.Context, *CheckoutRequest) (*QuoteCheckoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteCheckout not implemented")
+}
 func (UnimplementedOrderServiceServer) GetMyOrders(context.Context, *GetMyOrdersRequest) (*GetMyOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMyOrders not implemented")
 }
-func (UnimplementedOrderServiceServer) UpdateOrderStatusFromMomo(context.Context, *UpdateOrderStatusFromMomoRequest) (*UpdateOrderStatusFromMomoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatusFromMomo not implemented")
+func (UnimplementedOrderServiceServer) HandlePaymentCallback(context.Context, *HandlePaymentCallbackRequest) (*HandlePaymentCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandlePaymentCallback not implemented")
 }
 func (UnimplementedOrderServiceServer) RegisterDeliverer(context.Context, *RegisterDelivererRequest) (*RegisterDelivererResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterDeliverer not implemented")
@@ -337,6 +740,51 @@ func (UnimplementedOrderServiceServer) GetSupplierOrders(context.Context, *GetSu
 func (UnimplementedOrderServiceServer) UpdateOrderItem(context.Context, *UpdateOrderItemRequest) (*UpdateOrderItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderItem not implemented")
 }
+func (UnimplementedOrderServiceServer) CancelOrderItem(context.Context, *CancelOrderItemRequest) (*CancelOrderItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrderItem not implemented")
+}
+func (UnimplementedOrderServiceServer) GetOrderItemTimeline(context.Context, *GetOrderItemTimelineRequest) (*GetOrderItemTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderItemTimeline not implemented")
+}
+func (UnimplementedOrderServiceServer) CreateRefund(context.Context, *CreateRefundRequest) (*CreateRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRefund not implemented")
+}
+func (UnimplementedOrderServiceServer) ProcessRefund(context.Context, *ProcessRefundRequest) (*ProcessRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessRefund not implemented")
+}
+func (UnimplementedOrderServiceServer) GetRefunds(context.Context, *GetRefundsRequest) (*GetRefundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefunds not implemented")
+}
+func (UnimplementedOrderServiceServer) GetUserPaymentMethods(context.Context, *GetUserPaymentMethodsRequest) (*GetUserPaymentMethodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserPaymentMethods not implemented")
+}
+func (UnimplementedOrderServiceServer) CreateUserPaymentMethod(context.Context, *CreateUserPaymentMethodRequest) (*CreateUserPaymentMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserPaymentMethod not implemented")
+}
+func (UnimplementedOrderServiceServer) SetDefaultUserPaymentMethod(context.Context, *SetDefaultUserPaymentMethodRequest) (*SetDefaultUserPaymentMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultUserPaymentMethod not implemented")
+}
+func (UnimplementedOrderServiceServer) DeleteUserPaymentMethod(context.Context, *DeleteUserPaymentMethodRequest) (*DeleteUserPaymentMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserPaymentMethod not implemented")
+}
+func (UnimplementedOrderServiceServer) GetShippingRates(context.Context, *GetShippingRatesRequest) (*GetShippingRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShippingRates not implemented")
+}
+func (UnimplementedOrderServiceServer) CreateShippingRate(context.Context, *CreateShippingRateRequest) (*CreateShippingRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShippingRate not implemented")
+}
+func (UnimplementedOrderServiceServer) UpdateShippingRate(context.Context, *UpdateShippingRateRequest) (*UpdateShippingRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateShippingRate not implemented")
+}
+func (UnimplementedOrderServiceServer) DeleteShippingRate(context.Context, *DeleteShippingRateRequest) (*DeleteShippingRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShippingRate not implemented")
+}
+func (UnimplementedOrderServiceServer) GetExchangeRates(context.Context, *GetExchangeRatesRequest) (*GetExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeRates not implemented")
+}
+func (UnimplementedOrderServiceServer) UpdateExchangeRates(context.Context, *UpdateExchangeRatesRequest) (*UpdateExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExchangeRates not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -430,116 +878,332 @@ func _OrderService_RemoveCartItem_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrderService_GetCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCouponRequest)
+func _OrderService_ReorderToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderToCartRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrderServiceServer).GetCoupons(ctx, in)
+		return srv.(OrderServiceServer).ReorderToCart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrderService_GetCoupons_FullMethodName,
+		FullMethod: OrderService_ReorderToCart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).GetCoupons(ctx, req.(*GetCouponRequest))
+		return srv.(OrderServiceServer).ReorderToCart(ctx, req.(*ReorderToCartRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrderService_CreateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCouponRequest)
+func _OrderService_MergeGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeGuestCartRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrderServiceServer).CreateCoupon(ctx, in)
+		return srv.(OrderServiceServer).MergeGuestCart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrderService_CreateCoupon_FullMethodName,
+		FullMethod: OrderService_MergeGuestCart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).CreateCoupon(ctx, req.(*CreateCouponRequest))
+		return srv.(OrderServiceServer).MergeGuestCart(ctx, req.(*MergeGuestCartRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrderService_GetCouponsByClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCouponByClientRequest)
+func _OrderService_AcknowledgeCartItemChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeCartItemChangesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrderServiceServer).GetCouponsByClient(ctx, in)
+		return srv.(OrderServiceServer).AcknowledgeCartItemChanges(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrderService_GetCouponsByClient_FullMethodName,
+		FullMethod: OrderService_AcknowledgeCartItemChanges_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).GetCouponsByClient(ctx, req.(*GetCouponByClientRequest))
+		return srv.(OrderServiceServer).AcknowledgeCartItemChanges(ctx, req.(*AcknowledgeCartItemChangesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrderService_GetDetailCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDetailCouponRequest)
+func _OrderService_AddItemToWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddItemToWishlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrderServiceServer).GetDetailCoupon(ctx, in)
+		return srv.(OrderServiceServer).AddItemToWishlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrderService_GetDetailCoupon_FullMethodName,
+		FullMethod: OrderService_AddItemToWishlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).GetDetailCoupon(ctx, req.(*GetDetailCouponRequest))
+		return srv.(OrderServiceServer).AddItemToWishlist(ctx, req.(*AddItemToWishlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrderService_UpdateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCouponRequest)
+func _OrderService_GetWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWishlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrderServiceServer).UpdateCoupon(ctx, in)
+		return srv.(OrderServiceServer).GetWishlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrderService_UpdateCoupon_FullMethodName,
+		FullMethod: OrderService_GetWishlist_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).UpdateCoupon(ctx, req.(*UpdateCouponRequest))
+		return srv.(OrderServiceServer).GetWishlist(ctx, req.(*GetWishlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrderService_DeleteCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCouponRequest)
+func _OrderService_RemoveWishlistItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWishlistItemsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrderServiceServer).DeleteCoupon(ctx, in)
+		return srv.(OrderServiceServer).RemoveWishlistItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrderService_DeleteCoupon_FullMethodName,
+		FullMethod: OrderService_RemoveWishlistItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).DeleteCoupon(ctx, req.(*DeleteCouponRequest))
+		return srv.(OrderServiceServer).RemoveWishlistItems(ctx, req.(*RemoveWishlistItemsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrderService_GetPaymentMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPaymentMethodsRequest)
+func _OrderService_MoveWishlistItemToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveWishlistItemToCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).MoveWishlistItemToCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_MoveWishlistItemToCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).MoveWishlistItemToCart(ctx, req.(*MoveWishlistItemToCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_MoveCartItemToWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCartItemToWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).MoveCartItemToWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_MoveCartItemToWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).MoveCartItemToWishlist(ctx, req.(*MoveCartItemToWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetCoupons_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetCoupons(ctx, req.(*GetCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateCoupon(ctx, req.(*CreateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetCouponsByClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponByClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetCouponsByClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetCouponsByClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetCouponsByClient(ctx, req.(*GetCouponByClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetDetailCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDetailCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetDetailCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetDetailCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetDetailCoupon(ctx, req.(*GetDetailCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_UpdateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UpdateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UpdateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UpdateCoupon(ctx, req.(*UpdateCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_DeleteCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).DeleteCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_DeleteCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).DeleteCoupon(ctx, req.(*DeleteCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreateCouponCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCouponCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateCouponCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateCouponCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateCouponCampaign(ctx, req.(*CreateCouponCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetCouponCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetCouponCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetCouponCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetCouponCampaigns(ctx, req.(*GetCouponCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ExportCouponCampaignCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportCouponCampaignCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ExportCouponCampaignCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ExportCouponCampaignCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ExportCouponCampaignCodes(ctx, req.(*ExportCouponCampaignCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RevokeCouponCampaignCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCouponCampaignCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RevokeCouponCampaignCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RevokeCouponCampaignCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RevokeCouponCampaignCodes(ctx, req.(*RevokeCouponCampaignCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetPaymentMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentMethodsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -574,6 +1238,24 @@ func _OrderService_CreateOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_QuoteCheckout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).QuoteCheckout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_QuoteCheckout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).QuoteCheckout(ctx, req.(*CheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_GetMyOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMyOrdersRequest)
 	if err := dec(in); err != nil {
@@ -592,20 +1274,20 @@ func _OrderService_GetMyOrders_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrderService_UpdateOrderStatusFromMomo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateOrderStatusFromMomoRequest)
+func _OrderService_HandlePaymentCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandlePaymentCallbackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrderServiceServer).UpdateOrderStatusFromMomo(ctx, in)
+		return srv.(OrderServiceServer).HandlePaymentCallback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: OrderService_UpdateOrderStatusFromMomo_FullMethodName,
+		FullMethod: OrderService_HandlePaymentCallback_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).UpdateOrderStatusFromMomo(ctx, req.(*UpdateOrderStatusFromMomoRequest))
+		return srv.(OrderServiceServer).HandlePaymentCallback(ctx, req.(*HandlePaymentCallbackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -682,6 +1364,276 @@ func _OrderService_UpdateOrderItem_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_CancelOrderItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CancelOrderItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CancelOrderItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CancelOrderItem(ctx, req.(*CancelOrderItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderItemTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderItemTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderItemTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderItemTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderItemTimeline(ctx, req.(*GetOrderItemTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreateRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateRefund(ctx, req.(*CreateRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ProcessRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ProcessRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ProcessRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ProcessRefund(ctx, req.(*ProcessRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetRefunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetRefunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetRefunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetRefunds(ctx, req.(*GetRefundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetUserPaymentMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserPaymentMethodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetUserPaymentMethods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetUserPaymentMethods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetUserPaymentMethods(ctx, req.(*GetUserPaymentMethodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreateUserPaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserPaymentMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateUserPaymentMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateUserPaymentMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateUserPaymentMethod(ctx, req.(*CreateUserPaymentMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_SetDefaultUserPaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultUserPaymentMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SetDefaultUserPaymentMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_SetDefaultUserPaymentMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SetDefaultUserPaymentMethod(ctx, req.(*SetDefaultUserPaymentMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_DeleteUserPaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserPaymentMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).DeleteUserPaymentMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_DeleteUserPaymentMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).DeleteUserPaymentMethod(ctx, req.(*DeleteUserPaymentMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetShippingRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShippingRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetShippingRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetShippingRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetShippingRates(ctx, req.(*GetShippingRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CreateShippingRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShippingRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CreateShippingRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CreateShippingRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CreateShippingRate(ctx, req.(*CreateShippingRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_UpdateShippingRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateShippingRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UpdateShippingRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UpdateShippingRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UpdateShippingRate(ctx, req.(*UpdateShippingRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_DeleteShippingRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShippingRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).DeleteShippingRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_DeleteShippingRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).DeleteShippingRate(ctx, req.(*DeleteShippingRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetExchangeRates(ctx, req.(*GetExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_UpdateExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).UpdateExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_UpdateExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).UpdateExchangeRates(ctx, req.(*UpdateExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -705,6 +1657,38 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCartItem",
 			Handler:    _OrderService_RemoveCartItem_Handler,
 		},
+		{
+			MethodName: "ReorderToCart",
+			Handler:    _OrderService_ReorderToCart_Handler,
+		},
+		{
+			MethodName: "MergeGuestCart",
+			Handler:    _OrderService_MergeGuestCart_Handler,
+		},
+		{
+			MethodName: "AcknowledgeCartItemChanges",
+			Handler:    _OrderService_AcknowledgeCartItemChanges_Handler,
+		},
+		{
+			MethodName: "AddItemToWishlist",
+			Handler:    _OrderService_AddItemToWishlist_Handler,
+		},
+		{
+			MethodName: "GetWishlist",
+			Handler:    _OrderService_GetWishlist_Handler,
+		},
+		{
+			MethodName: "RemoveWishlistItems",
+			Handler:    _OrderService_RemoveWishlistItems_Handler,
+		},
+		{
+			MethodName: "MoveWishlistItemToCart",
+			Handler:    _OrderService_MoveWishlistItemToCart_Handler,
+		},
+		{
+			MethodName: "MoveCartItemToWishlist",
+			Handler:    _OrderService_MoveCartItemToWishlist_Handler,
+		},
 		{
 			MethodName: "GetCoupons",
 			Handler:    _OrderService_GetCoupons_Handler,
@@ -729,6 +1713,22 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCoupon",
 			Handler:    _OrderService_DeleteCoupon_Handler,
 		},
+		{
+			MethodName: "CreateCouponCampaign",
+			Handler:    _OrderService_CreateCouponCampaign_Handler,
+		},
+		{
+			MethodName: "GetCouponCampaigns",
+			Handler:    _OrderService_GetCouponCampaigns_Handler,
+		},
+		{
+			MethodName: "ExportCouponCampaignCodes",
+			Handler:    _OrderService_ExportCouponCampaignCodes_Handler,
+		},
+		{
+			MethodName: "RevokeCouponCampaignCodes",
+			Handler:    _OrderService_RevokeCouponCampaignCodes_Handler,
+		},
 		{
 			MethodName: "GetPaymentMethods",
 			Handler:    _OrderService_GetPaymentMethods_Handler,
@@ -737,13 +1737,17 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateOrder",
 			Handler:    _OrderService_CreateOrder_Handler,
 		},
+		{
+			MethodName: "QuoteCheckout",
+			Handler:    _OrderService_QuoteCheckout_Handler,
+		},
 		{
 			MethodName: "GetMyOrders",
 			Handler:    _OrderService_GetMyOrders_Handler,
 		},
 		{
-			MethodName: "UpdateOrderStatusFromMomo",
-			Handler:    _OrderService_UpdateOrderStatusFromMomo_Handler,
+			MethodName: "HandlePaymentCallback",
+			Handler:    _OrderService_HandlePaymentCallback_Handler,
 		},
 		{
 			MethodName: "RegisterDeliverer",
@@ -761,6 +1765,66 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrderItem",
 			Handler:    _OrderService_UpdateOrderItem_Handler,
 		},
+		{
+			MethodName: "CancelOrderItem",
+			Handler:    _OrderService_CancelOrderItem_Handler,
+		},
+		{
+			MethodName: "GetOrderItemTimeline",
+			Handler:    _OrderService_GetOrderItemTimeline_Handler,
+		},
+		{
+			MethodName: "CreateRefund",
+			Handler:    _OrderService_CreateRefund_Handler,
+		},
+		{
+			MethodName: "ProcessRefund",
+			Handler:    _OrderService_ProcessRefund_Handler,
+		},
+		{
+			MethodName: "GetRefunds",
+			Handler:    _OrderService_GetRefunds_Handler,
+		},
+		{
+			MethodName: "GetUserPaymentMethods",
+			Handler:    _OrderService_GetUserPaymentMethods_Handler,
+		},
+		{
+			MethodName: "CreateUserPaymentMethod",
+			Handler:    _OrderService_CreateUserPaymentMethod_Handler,
+		},
+		{
+			MethodName: "SetDefaultUserPaymentMethod",
+			Handler:    _OrderService_SetDefaultUserPaymentMethod_Handler,
+		},
+		{
+			MethodName: "DeleteUserPaymentMethod",
+			Handler:    _OrderService_DeleteUserPaymentMethod_Handler,
+		},
+		{
+			MethodName: "GetShippingRates",
+			Handler:    _OrderService_GetShippingRates_Handler,
+		},
+		{
+			MethodName: "CreateShippingRate",
+			Handler:    _OrderService_CreateShippingRate_Handler,
+		},
+		{
+			MethodName: "UpdateShippingRate",
+			Handler:    _OrderService_UpdateShippingRate_Handler,
+		},
+		{
+			MethodName: "DeleteShippingRate",
+			Handler:    _OrderService_DeleteShippingRate_Handler,
+		},
+		{
+			MethodName: "GetExchangeRates",
+			Handler:    _OrderService_GetExchangeRates_Handler,
+		},
+		{
+			MethodName: "UpdateExchangeRates",
+			Handler:    _OrderService_UpdateExchangeRates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order_and_payment_main.proto",
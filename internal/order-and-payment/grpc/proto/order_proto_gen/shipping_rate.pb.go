@@ -0,0 +1,661 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: shipping_rate.proto
+
+package order_proto_gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetShippingRatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShippingClass *string                `protobuf:"bytes,1,opt,name=shipping_class,json=shippingClass,proto3,oneof" json:"shipping_class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShippingRatesRequest) Reset() {
+	*x = GetShippingRatesRequest{}
+	mi := &file_shipping_rate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShippingRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShippingRatesRequest) ProtoMessage() {}
+
+func (x *GetShippingRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_rate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShippingRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetShippingRatesRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_rate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetShippingRatesRequest) GetShippingClass() string {
+	if x != nil && x.ShippingClass != nil {
+		return *x.ShippingClass
+	}
+	return ""
+}
+
+type GetShippingRatesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Data          []*ShippingRateResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShippingRatesResponse) Reset() {
+	*x = GetShippingRatesResponse{}
+	mi := &file_shipping_rate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShippingRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShippingRatesResponse) ProtoMessage() {}
+
+func (x *GetShippingRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_rate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShippingRatesResponse.ProtoReflect.Descriptor instead.
+func (*GetShippingRatesResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_rate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetShippingRatesResponse) GetData() []*ShippingRateResponse {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ShippingRateResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShippingClass   string                 `protobuf:"bytes,2,opt,name=shipping_class,json=shippingClass,proto3" json:"shipping_class,omitempty"`
+	Zone            string                 `protobuf:"bytes,3,opt,name=zone,proto3" json:"zone,omitempty"`
+	BaseFee         *Money                 `protobuf:"bytes,4,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	BaseWeightGrams int64                  `protobuf:"varint,5,opt,name=base_weight_grams,json=baseWeightGrams,proto3" json:"base_weight_grams,omitempty"`
+	FeePerExtraKg   *Money                 `protobuf:"bytes,6,opt,name=fee_per_extra_kg,json=feePerExtraKg,proto3" json:"fee_per_extra_kg,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShippingRateResponse) Reset() {
+	*x = ShippingRateResponse{}
+	mi := &file_shipping_rate_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShippingRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingRateResponse) ProtoMessage() {}
+
+func (x *ShippingRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_rate_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingRateResponse.ProtoReflect.Descriptor instead.
+func (*ShippingRateResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_rate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShippingRateResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShippingRateResponse) GetShippingClass() string {
+	if x != nil {
+		return x.ShippingClass
+	}
+	return ""
+}
+
+func (x *ShippingRateResponse) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *ShippingRateResponse) GetBaseFee() *Money {
+	if x != nil {
+		return x.BaseFee
+	}
+	return nil
+}
+
+func (x *ShippingRateResponse) GetBaseWeightGrams() int64 {
+	if x != nil {
+		return x.BaseWeightGrams
+	}
+	return 0
+}
+
+func (x *ShippingRateResponse) GetFeePerExtraKg() *Money {
+	if x != nil {
+		return x.FeePerExtraKg
+	}
+	return nil
+}
+
+func (x *ShippingRateResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ShippingRateResponse) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateShippingRateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ShippingClass   string                 `protobuf:"bytes,1,opt,name=shipping_class,json=shippingClass,proto3" json:"shipping_class,omitempty"`
+	Zone            string                 `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	BaseFee         *Money                 `protobuf:"bytes,3,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	BaseWeightGrams int64                  `protobuf:"varint,4,opt,name=base_weight_grams,json=baseWeightGrams,proto3" json:"base_weight_grams,omitempty"`
+	FeePerExtraKg   *Money                 `protobuf:"bytes,5,opt,name=fee_per_extra_kg,json=feePerExtraKg,proto3" json:"fee_per_extra_kg,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateShippingRateRequest) Reset() {
+	*x = CreateShippingRateRequest{}
+	mi := &file_shipping_rate_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShippingRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShippingRateRequest) ProtoMessage() {}
+
+func (x *CreateShippingRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_rate_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShippingRateRequest.ProtoReflect.Descriptor instead.
+func (*CreateShippingRateRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_rate_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateShippingRateRequest) GetShippingClass() string {
+	if x != nil {
+		return x.ShippingClass
+	}
+	return ""
+}
+
+func (x *CreateShippingRateRequest) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *CreateShippingRateRequest) GetBaseFee() *Money {
+	if x != nil {
+		return x.BaseFee
+	}
+	return nil
+}
+
+func (x *CreateShippingRateRequest) GetBaseWeightGrams() int64 {
+	if x != nil {
+		return x.BaseWeightGrams
+	}
+	return 0
+}
+
+func (x *CreateShippingRateRequest) GetFeePerExtraKg() *Money {
+	if x != nil {
+		return x.FeePerExtraKg
+	}
+	return nil
+}
+
+type CreateShippingRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShippingRateResponse) Reset() {
+	*x = CreateShippingRateResponse{}
+	mi := &file_shipping_rate_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShippingRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShippingRateResponse) ProtoMessage() {}
+
+func (x *CreateShippingRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_rate_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShippingRateResponse.ProtoReflect.Descriptor instead.
+func (*CreateShippingRateResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_rate_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateShippingRateResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateShippingRateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BaseFee         *Money                 `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	BaseWeightGrams int64                  `protobuf:"varint,3,opt,name=base_weight_grams,json=baseWeightGrams,proto3" json:"base_weight_grams,omitempty"`
+	FeePerExtraKg   *Money                 `protobuf:"bytes,4,opt,name=fee_per_extra_kg,json=feePerExtraKg,proto3" json:"fee_per_extra_kg,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateShippingRateRequest) Reset() {
+	*x = UpdateShippingRateRequest{}
+	mi := &file_shipping_rate_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShippingRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShippingRateRequest) ProtoMessage() {}
+
+func (x *UpdateShippingRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_rate_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShippingRateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateShippingRateRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_rate_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateShippingRateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateShippingRateRequest) GetBaseFee() *Money {
+	if x != nil {
+		return x.BaseFee
+	}
+	return nil
+}
+
+func (x *UpdateShippingRateRequest) GetBaseWeightGrams() int64 {
+	if x != nil {
+		return x.BaseWeightGrams
+	}
+	return 0
+}
+
+func (x *UpdateShippingRateRequest) GetFeePerExtraKg() *Money {
+	if x != nil {
+		return x.FeePerExtraKg
+	}
+	return nil
+}
+
+type UpdateShippingRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateShippingRateResponse) Reset() {
+	*x = UpdateShippingRateResponse{}
+	mi := &file_shipping_rate_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateShippingRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateShippingRateResponse) ProtoMessage() {}
+
+func (x *UpdateShippingRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_rate_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateShippingRateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateShippingRateResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_rate_proto_rawDescGZIP(), []int{6}
+}
+
+type DeleteShippingRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShippingRateRequest) Reset() {
+	*x = DeleteShippingRateRequest{}
+	mi := &file_shipping_rate_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShippingRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShippingRateRequest) ProtoMessage() {}
+
+func (x *DeleteShippingRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_rate_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShippingRateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShippingRateRequest) Descriptor() ([]byte, []int) {
+	return file_shipping_rate_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteShippingRateRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteShippingRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShippingRateResponse) Reset() {
+	*x = DeleteShippingRateResponse{}
+	mi := &file_shipping_rate_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShippingRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShippingRateResponse) ProtoMessage() {}
+
+func (x *DeleteShippingRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shipping_rate_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShippingRateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteShippingRateResponse) Descriptor() ([]byte, []int) {
+	return file_shipping_rate_proto_rawDescGZIP(), []int{8}
+}
+
+var File_shipping_rate_proto protoreflect.FileDescriptor
+
+var file_shipping_rate_proto_rawDesc = string([]byte{
+	0x0a, 0x13, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x58, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a,
+	0x0a, 0x0e, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x73,
+	0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x22, 0x45, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xd7, 0x02, 0x0a, 0x14, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a,
+	0x0e, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x67, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x47, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2f, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x6b, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x50, 0x65,
+	0x72, 0x45, 0x78, 0x74, 0x72, 0x61, 0x4b, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xd6,
+	0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x47, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2f, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x6b, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x4b, 0x67, 0x22, 0x2c, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x62,
+	0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x47, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x2f, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x5f, 0x6b, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x4b, 0x67, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1c,
+	0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x13, 0x5a, 0x11,
+	0x2e, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x67, 0x65,
+	0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_shipping_rate_proto_rawDescOnce sync.Once
+	file_shipping_rate_proto_rawDescData []byte
+)
+
+func file_shipping_rate_proto_rawDescGZIP() []byte {
+	file_shipping_rate_proto_rawDescOnce.Do(func() {
+		file_shipping_rate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_shipping_rate_proto_rawDesc), len(file_shipping_rate_proto_rawDesc)))
+	})
+	return file_shipping_rate_proto_rawDescData
+}
+
+var file_shipping_rate_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_shipping_rate_proto_goTypes = []any{
+	(*GetShippingRatesRequest)(nil),    // 0: GetShippingRatesRequest
+	(*GetShippingRatesResponse)(nil),   // 1: GetShippingRatesResponse
+	(*ShippingRateResponse)(nil),       // 2: ShippingRateResponse
+	(*CreateShippingRateRequest)(nil),  // 3: CreateShippingRateRequest
+	(*CreateShippingRateResponse)(nil), // 4: CreateShippingRateResponse
+	(*UpdateShippingRateRequest)(nil),  // 5: UpdateShippingRateRequest
+	(*UpdateShippingRateResponse)(nil), // 6: UpdateShippingRateResponse
+	(*DeleteShippingRateRequest)(nil),  // 7: DeleteShippingRateRequest
+	(*DeleteShippingRateResponse)(nil), // 8: DeleteShippingRateResponse
+	(*Money)(nil),                      // 9: Money
+	(*timestamppb.Timestamp)(nil),      // 10: google.protobuf.Timestamp
+}
+var file_shipping_rate_proto_depIdxs = []int32{
+	2,  // 0: GetShippingRatesResponse.data:type_name -> ShippingRateResponse
+	9,  // 1: ShippingRateResponse.base_fee:type_name -> Money
+	9,  // 2: ShippingRateResponse.fee_per_extra_kg:type_name -> Money
+	10, // 3: ShippingRateResponse.created_at:type_name -> google.protobuf.Timestamp
+	10, // 4: ShippingRateResponse.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 5: CreateShippingRateRequest.base_fee:type_name -> Money
+	9,  // 6: CreateShippingRateRequest.fee_per_extra_kg:type_name -> Money
+	9,  // 7: UpdateShippingRateRequest.base_fee:type_name -> Money
+	9,  // 8: UpdateShippingRateRequest.fee_per_extra_kg:type_name -> Money
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_shipping_rate_proto_init() }
+func file_shipping_rate_proto_init() {
+	if File_shipping_rate_proto != nil {
+		return
+	}
+	file_money_proto_init()
+	file_shipping_rate_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shipping_rate_proto_rawDesc), len(file_shipping_rate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_shipping_rate_proto_goTypes,
+		DependencyIndexes: file_shipping_rate_proto_depIdxs,
+		MessageInfos:      file_shipping_rate_proto_msgTypes,
+	}.Build()
+	File_shipping_rate_proto = out.File
+	file_shipping_rate_proto_goTypes = nil
+	file_shipping_rate_proto_depIdxs = nil
+}
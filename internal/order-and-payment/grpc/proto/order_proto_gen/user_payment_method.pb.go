@@ -0,0 +1,731 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        v5.29.3
+// source: user_payment_method.proto
+
+package order_proto_gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetUserPaymentMethodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserPaymentMethodsRequest) Reset() {
+	*x = GetUserPaymentMethodsRequest{}
+	mi := &file_user_payment_method_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPaymentMethodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPaymentMethodsRequest) ProtoMessage() {}
+
+func (x *GetUserPaymentMethodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_method_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPaymentMethodsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserPaymentMethodsRequest) Descriptor() ([]byte, []int) {
+	return file_user_payment_method_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetUserPaymentMethodsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserPaymentMethodsResponse struct {
+	state              protoimpl.MessageState       `protogen:"open.v1"`
+	UserPaymentMethods []*UserPaymentMethodResponse `protobuf:"bytes,1,rep,name=user_payment_methods,json=userPaymentMethods,proto3" json:"user_payment_methods,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetUserPaymentMethodsResponse) Reset() {
+	*x = GetUserPaymentMethodsResponse{}
+	mi := &file_user_payment_method_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserPaymentMethodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserPaymentMethodsResponse) ProtoMessage() {}
+
+func (x *GetUserPaymentMethodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_method_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserPaymentMethodsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserPaymentMethodsResponse) Descriptor() ([]byte, []int) {
+	return file_user_payment_method_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetUserPaymentMethodsResponse) GetUserPaymentMethods() []*UserPaymentMethodResponse {
+	if x != nil {
+		return x.UserPaymentMethods
+	}
+	return nil
+}
+
+type CreateUserPaymentMethodRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// code of payment method, same as code column of payment_methods
+	PaymentMethodCode string `protobuf:"bytes,2,opt,name=payment_method_code,json=paymentMethodCode,proto3" json:"payment_method_code,omitempty"`
+	CardHolderName    string `protobuf:"bytes,3,opt,name=card_holder_name,json=cardHolderName,proto3" json:"card_holder_name,omitempty"`
+	// either token issued by provider or card number (encrypted before it is saved) is required
+	ProviderToken *string `protobuf:"bytes,4,opt,name=provider_token,json=providerToken,proto3,oneof" json:"provider_token,omitempty"`
+	CardNumber    *string `protobuf:"bytes,5,opt,name=card_number,json=cardNumber,proto3,oneof" json:"card_number,omitempty"`
+	// required with provider_token, read from card_number otherwise
+	CardLast4       *string `protobuf:"bytes,6,opt,name=card_last4,json=cardLast4,proto3,oneof" json:"card_last4,omitempty"`
+	CardBrand       *string `protobuf:"bytes,7,opt,name=card_brand,json=cardBrand,proto3,oneof" json:"card_brand,omitempty"`
+	CardExpiryMonth int64   `protobuf:"varint,8,opt,name=card_expiry_month,json=cardExpiryMonth,proto3" json:"card_expiry_month,omitempty"`
+	CardExpiryYear  int64   `protobuf:"varint,9,opt,name=card_expiry_year,json=cardExpiryYear,proto3" json:"card_expiry_year,omitempty"`
+	IsDefault       bool    `protobuf:"varint,10,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateUserPaymentMethodRequest) Reset() {
+	*x = CreateUserPaymentMethodRequest{}
+	mi := &file_user_payment_method_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserPaymentMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserPaymentMethodRequest) ProtoMessage() {}
+
+func (x *CreateUserPaymentMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_method_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserPaymentMethodRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserPaymentMethodRequest) Descriptor() ([]byte, []int) {
+	return file_user_payment_method_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateUserPaymentMethodRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateUserPaymentMethodRequest) GetPaymentMethodCode() string {
+	if x != nil {
+		return x.PaymentMethodCode
+	}
+	return ""
+}
+
+func (x *CreateUserPaymentMethodRequest) GetCardHolderName() string {
+	if x != nil {
+		return x.CardHolderName
+	}
+	return ""
+}
+
+func (x *CreateUserPaymentMethodRequest) GetProviderToken() string {
+	if x != nil && x.ProviderToken != nil {
+		return *x.ProviderToken
+	}
+	return ""
+}
+
+func (x *CreateUserPaymentMethodRequest) GetCardNumber() string {
+	if x != nil && x.CardNumber != nil {
+		return *x.CardNumber
+	}
+	return ""
+}
+
+func (x *CreateUserPaymentMethodRequest) GetCardLast4() string {
+	if x != nil && x.CardLast4 != nil {
+		return *x.CardLast4
+	}
+	return ""
+}
+
+func (x *CreateUserPaymentMethodRequest) GetCardBrand() string {
+	if x != nil && x.CardBrand != nil {
+		return *x.CardBrand
+	}
+	return ""
+}
+
+func (x *CreateUserPaymentMethodRequest) GetCardExpiryMonth() int64 {
+	if x != nil {
+		return x.CardExpiryMonth
+	}
+	return 0
+}
+
+func (x *CreateUserPaymentMethodRequest) GetCardExpiryYear() int64 {
+	if x != nil {
+		return x.CardExpiryYear
+	}
+	return 0
+}
+
+func (x *CreateUserPaymentMethodRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type CreateUserPaymentMethodResponse struct {
+	state             protoimpl.MessageState     `protogen:"open.v1"`
+	UserPaymentMethod *UserPaymentMethodResponse `protobuf:"bytes,1,opt,name=user_payment_method,json=userPaymentMethod,proto3" json:"user_payment_method,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateUserPaymentMethodResponse) Reset() {
+	*x = CreateUserPaymentMethodResponse{}
+	mi := &file_user_payment_method_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserPaymentMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserPaymentMethodResponse) ProtoMessage() {}
+
+func (x *CreateUserPaymentMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_method_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserPaymentMethodResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserPaymentMethodResponse) Descriptor() ([]byte, []int) {
+	return file_user_payment_method_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateUserPaymentMethodResponse) GetUserPaymentMethod() *UserPaymentMethodResponse {
+	if x != nil {
+		return x.UserPaymentMethod
+	}
+	return nil
+}
+
+type SetDefaultUserPaymentMethodRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserPaymentMethodId int64                  `protobuf:"varint,2,opt,name=user_payment_method_id,json=userPaymentMethodId,proto3" json:"user_payment_method_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SetDefaultUserPaymentMethodRequest) Reset() {
+	*x = SetDefaultUserPaymentMethodRequest{}
+	mi := &file_user_payment_method_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultUserPaymentMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultUserPaymentMethodRequest) ProtoMessage() {}
+
+func (x *SetDefaultUserPaymentMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_method_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultUserPaymentMethodRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultUserPaymentMethodRequest) Descriptor() ([]byte, []int) {
+	return file_user_payment_method_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetDefaultUserPaymentMethodRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetDefaultUserPaymentMethodRequest) GetUserPaymentMethodId() int64 {
+	if x != nil {
+		return x.UserPaymentMethodId
+	}
+	return 0
+}
+
+type SetDefaultUserPaymentMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultUserPaymentMethodResponse) Reset() {
+	*x = SetDefaultUserPaymentMethodResponse{}
+	mi := &file_user_payment_method_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultUserPaymentMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultUserPaymentMethodResponse) ProtoMessage() {}
+
+func (x *SetDefaultUserPaymentMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_method_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultUserPaymentMethodResponse.ProtoReflect.Descriptor instead.
+func (*SetDefaultUserPaymentMethodResponse) Descriptor() ([]byte, []int) {
+	return file_user_payment_method_proto_rawDescGZIP(), []int{5}
+}
+
+type DeleteUserPaymentMethodRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserPaymentMethodId int64                  `protobuf:"varint,2,opt,name=user_payment_method_id,json=userPaymentMethodId,proto3" json:"user_payment_method_id,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DeleteUserPaymentMethodRequest) Reset() {
+	*x = DeleteUserPaymentMethodRequest{}
+	mi := &file_user_payment_method_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserPaymentMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserPaymentMethodRequest) ProtoMessage() {}
+
+func (x *DeleteUserPaymentMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_method_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserPaymentMethodRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserPaymentMethodRequest) Descriptor() ([]byte, []int) {
+	return file_user_payment_method_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteUserPaymentMethodRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteUserPaymentMethodRequest) GetUserPaymentMethodId() int64 {
+	if x != nil {
+		return x.UserPaymentMethodId
+	}
+	return 0
+}
+
+type DeleteUserPaymentMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserPaymentMethodResponse) Reset() {
+	*x = DeleteUserPaymentMethodResponse{}
+	mi := &file_user_payment_method_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserPaymentMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserPaymentMethodResponse) ProtoMessage() {}
+
+func (x *DeleteUserPaymentMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_method_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserPaymentMethodResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserPaymentMethodResponse) Descriptor() ([]byte, []int) {
+	return file_user_payment_method_proto_rawDescGZIP(), []int{7}
+}
+
+type UserPaymentMethodResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PaymentMethodCode string                 `protobuf:"bytes,2,opt,name=payment_method_code,json=paymentMethodCode,proto3" json:"payment_method_code,omitempty"`
+	PaymentMethodName string                 `protobuf:"bytes,3,opt,name=payment_method_name,json=paymentMethodName,proto3" json:"payment_method_name,omitempty"`
+	CardHolderName    string                 `protobuf:"bytes,4,opt,name=card_holder_name,json=cardHolderName,proto3" json:"card_holder_name,omitempty"`
+	CardLast4         string                 `protobuf:"bytes,5,opt,name=card_last4,json=cardLast4,proto3" json:"card_last4,omitempty"`
+	CardBrand         string                 `protobuf:"bytes,6,opt,name=card_brand,json=cardBrand,proto3" json:"card_brand,omitempty"`
+	CardExpiryMonth   int64                  `protobuf:"varint,7,opt,name=card_expiry_month,json=cardExpiryMonth,proto3" json:"card_expiry_month,omitempty"`
+	CardExpiryYear    int64                  `protobuf:"varint,8,opt,name=card_expiry_year,json=cardExpiryYear,proto3" json:"card_expiry_year,omitempty"`
+	IsDefault         bool                   `protobuf:"varint,9,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UserPaymentMethodResponse) Reset() {
+	*x = UserPaymentMethodResponse{}
+	mi := &file_user_payment_method_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPaymentMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPaymentMethodResponse) ProtoMessage() {}
+
+func (x *UserPaymentMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_payment_method_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPaymentMethodResponse.ProtoReflect.Descriptor instead.
+func (*UserPaymentMethodResponse) Descriptor() ([]byte, []int) {
+	return file_user_payment_method_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UserPaymentMethodResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserPaymentMethodResponse) GetPaymentMethodCode() string {
+	if x != nil {
+		return x.PaymentMethodCode
+	}
+	return ""
+}
+
+func (x *UserPaymentMethodResponse) GetPaymentMethodName() string {
+	if x != nil {
+		return x.PaymentMethodName
+	}
+	return ""
+}
+
+func (x *UserPaymentMethodResponse) GetCardHolderName() string {
+	if x != nil {
+		return x.CardHolderName
+	}
+	return ""
+}
+
+func (x *UserPaymentMethodResponse) GetCardLast4() string {
+	if x != nil {
+		return x.CardLast4
+	}
+	return ""
+}
+
+func (x *UserPaymentMethodResponse) GetCardBrand() string {
+	if x != nil {
+		return x.CardBrand
+	}
+	return ""
+}
+
+func (x *UserPaymentMethodResponse) GetCardExpiryMonth() int64 {
+	if x != nil {
+		return x.CardExpiryMonth
+	}
+	return 0
+}
+
+func (x *UserPaymentMethodResponse) GetCardExpiryYear() int64 {
+	if x != nil {
+		return x.CardExpiryYear
+	}
+	return 0
+}
+
+func (x *UserPaymentMethodResponse) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *UserPaymentMethodResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_user_payment_method_proto protoreflect.FileDescriptor
+
+var file_user_payment_method_proto_rawDesc = string([]byte{
+	0x0a, 0x19, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x14, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x12, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x73, 0x22, 0xe3, 0x03, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x2e, 0x0a, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x72, 0x64,
+	0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0e, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0a, 0x63,
+	0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
+	0x63, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x34, 0x88, 0x01, 0x01,
+	0x12, 0x22, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x42, 0x72, 0x61, 0x6e,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x63, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x4d, 0x6f, 0x6e, 0x74, 0x68,
+	0x12, 0x28, 0x0a, 0x10, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f,
+	0x79, 0x65, 0x61, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x61, 0x72, 0x64,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73,
+	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x34, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x63, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x22, 0x6d, 0x0a, 0x1f, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
+	0x13, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x11, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x72, 0x0a, 0x22, 0x53, 0x65, 0x74,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x49, 0x64, 0x22, 0x25, 0x0a,
+	0x23, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6e, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x33, 0x0a, 0x16, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x13, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x49, 0x64, 0x22, 0x21, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa3, 0x03, 0x0a, 0x19, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x68, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x61, 0x72, 0x64, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x34, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x34, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x2a, 0x0a,
+	0x11, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x6d, 0x6f, 0x6e,
+	0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x61, 0x72, 0x64, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x61, 0x72,
+	0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x59,
+	0x65, 0x61, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x13, 0x5a,
+	0x11, 0x2e, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x67,
+	0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_user_payment_method_proto_rawDescOnce sync.Once
+	file_user_payment_method_proto_rawDescData []byte
+)
+
+func file_user_payment_method_proto_rawDescGZIP() []byte {
+	file_user_payment_method_proto_rawDescOnce.Do(func() {
+		file_user_payment_method_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_user_payment_method_proto_rawDesc), len(file_user_payment_method_proto_rawDesc)))
+	})
+	return file_user_payment_method_proto_rawDescData
+}
+
+var file_user_payment_method_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_user_payment_method_proto_goTypes = []any{
+	(*GetUserPaymentMethodsRequest)(nil),        // 0: GetUserPaymentMethodsRequest
+	(*GetUserPaymentMethodsResponse)(nil),       // 1: GetUserPaymentMethodsResponse
+	(*CreateUserPaymentMethodRequest)(nil),      // 2: CreateUserPaymentMethodRequest
+	(*CreateUserPaymentMethodResponse)(nil),     // 3: CreateUserPaymentMethodResponse
+	(*SetDefaultUserPaymentMethodRequest)(nil),  // 4: SetDefaultUserPaymentMethodRequest
+	(*SetDefaultUserPaymentMethodResponse)(nil), // 5: SetDefaultUserPaymentMethodResponse
+	(*DeleteUserPaymentMethodRequest)(nil),      // 6: DeleteUserPaymentMethodRequest
+	(*DeleteUserPaymentMethodResponse)(nil),     // 7: DeleteUserPaymentMethodResponse
+	(*UserPaymentMethodResponse)(nil),           // 8: UserPaymentMethodResponse
+	(*timestamppb.Timestamp)(nil),               // 9: google.protobuf.Timestamp
+}
+var file_user_payment_method_proto_depIdxs = []int32{
+	8, // 0: GetUserPaymentMethodsResponse.user_payment_methods:type_name -> UserPaymentMethodResponse
+	8, // 1: CreateUserPaymentMethodResponse.user_payment_method:type_name -> UserPaymentMethodResponse
+	9, // 2: UserPaymentMethodResponse.created_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_user_payment_method_proto_init() }
+func file_user_payment_method_proto_init() {
+	if File_user_payment_method_proto != nil {
+		return
+	}
+	file_user_payment_method_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_payment_method_proto_rawDesc), len(file_user_payment_method_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_user_payment_method_proto_goTypes,
+		DependencyIndexes: file_user_payment_method_proto_depIdxs,
+		MessageInfos:      file_user_payment_method_proto_msgTypes,
+	}.Build()
+	File_user_payment_method_proto = out.File
+	file_user_payment_method_proto_goTypes = nil
+	file_user_payment_method_proto_depIdxs = nil
+}
@@ -57,3 +57,42 @@ func (h *OrderHandler) RemoveCartItem(ctx context.Context, data *order_proto_gen
 
 	return res, nil
 }
+
+func (h *OrderHandler) ReorderToCart(ctx context.Context, data *order_proto_gen.ReorderToCartRequest) (*order_proto_gen.ReorderToCartResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "ReorderToCart"))
+	defer span.End()
+
+	res, err := h.cartService.ReorderToCart(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) MergeGuestCart(ctx context.Context, data *order_proto_gen.MergeGuestCartRequest) (*order_proto_gen.MergeGuestCartResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "MergeGuestCart"))
+	defer span.End()
+
+	res, err := h.cartService.MergeGuestCart(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) AcknowledgeCartItemChanges(ctx context.Context, data *order_proto_gen.AcknowledgeCartItemChangesRequest) (*order_proto_gen.AcknowledgeCartItemChangesResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "AcknowledgeCartItemChanges"))
+	defer span.End()
+
+	res, err := h.cartService.AcknowledgeCartItemChanges(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+)
+
+func (h *OrderHandler) CreateCouponCampaign(ctx context.Context, data *order_proto_gen.CreateCouponCampaignRequest) (*order_proto_gen.CreateCouponCampaignResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "CreateCouponCampaign"))
+	defer span.End()
+
+	res, err := h.couponCampaignService.CreateCouponCampaign(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) GetCouponCampaigns(ctx context.Context, data *order_proto_gen.GetCouponCampaignsRequest) (*order_proto_gen.GetCouponCampaignsResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "GetCouponCampaigns"))
+	defer span.End()
+
+	res, err := h.couponCampaignService.GetCouponCampaigns(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) ExportCouponCampaignCodes(ctx context.Context, data *order_proto_gen.ExportCouponCampaignCodesRequest) (*order_proto_gen.ExportCouponCampaignCodesResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "ExportCouponCampaignCodes"))
+	defer span.End()
+
+	res, err := h.couponCampaignService.ExportCouponCampaignCodes(ctx, data.CampaignId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) RevokeCouponCampaignCodes(ctx context.Context, data *order_proto_gen.RevokeCouponCampaignCodesRequest) (*order_proto_gen.RevokeCouponCampaignCodesResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "RevokeCouponCampaignCodes"))
+	defer span.End()
+
+	res, err := h.couponCampaignService.RevokeCouponCampaignCodes(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
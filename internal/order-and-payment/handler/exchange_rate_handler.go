@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+)
+
+func (h *OrderHandler) GetExchangeRates(ctx context.Context, data *order_proto_gen.GetExchangeRatesRequest) (*order_proto_gen.GetExchangeRatesResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "GetExchangeRates"))
+	defer span.End()
+
+	res, err := h.exchangeRateService.GetExchangeRates(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) UpdateExchangeRates(ctx context.Context, data *order_proto_gen.UpdateExchangeRatesRequest) (*order_proto_gen.UpdateExchangeRatesResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "UpdateExchangeRates"))
+	defer span.End()
+
+	if err := h.exchangeRateService.UpdateExchangeRates(ctx, data); err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.UpdateExchangeRatesResponse{}, nil
+}
@@ -10,26 +10,44 @@ import (
 
 type OrderHandler struct {
 	order_proto_gen.UnimplementedOrderServiceServer
-	tracer           pkg.Tracer
-	cartService      service.ICartService
-	couponService    service.ICouponService
-	paymentService   service.IPaymentService
-	orderService     service.IOrderService
-	delivererService service.IDelivererService
+	tracer                   pkg.Tracer
+	cartService              service.ICartService
+	couponService            service.ICouponService
+	paymentService           service.IPaymentService
+	orderService             service.IOrderService
+	delivererService         service.IDelivererService
+	refundService            service.IRefundService
+	userPaymentMethodService service.IUserPaymentMethodService
+	couponCampaignService    service.ICouponCampaignService
+	shippingRateService      service.IShippingRateService
+	exchangeRateService      service.IExchangeRateService
+	wishlistService          service.IWishlistService
 }
 
 func NewOrderHandler(tracer pkg.Tracer, cartService service.ICartService,
 	couponService service.ICouponService,
 	paymentService service.IPaymentService,
 	orderService service.IOrderService,
-	delivererService service.IDelivererService) *OrderHandler {
+	delivererService service.IDelivererService,
+	refundService service.IRefundService,
+	userPaymentMethodService service.IUserPaymentMethodService,
+	couponCampaignService service.ICouponCampaignService,
+	shippingRateService service.IShippingRateService,
+	exchangeRateService service.IExchangeRateService,
+	wishlistService service.IWishlistService) *OrderHandler {
 	return &OrderHandler{
-		tracer:           tracer,
-		cartService:      cartService,
-		couponService:    couponService,
-		paymentService:   paymentService,
-		orderService:     orderService,
-		delivererService: delivererService,
+		tracer:                   tracer,
+		cartService:              cartService,
+		couponService:            couponService,
+		paymentService:           paymentService,
+		orderService:             orderService,
+		delivererService:         delivererService,
+		refundService:            refundService,
+		userPaymentMethodService: userPaymentMethodService,
+		couponCampaignService:    couponCampaignService,
+		shippingRateService:      shippingRateService,
+		exchangeRateService:      exchangeRateService,
+		wishlistService:          wishlistService,
 	}
 }
 
@@ -42,3 +42,27 @@ func (h *OrderHandler) UpdateOrderItem(ctx context.Context, data *order_proto_ge
 
 	return &order_proto_gen.UpdateOrderItemResponse{}, nil
 }
+
+func (h *OrderHandler) CancelOrderItem(ctx context.Context, data *order_proto_gen.CancelOrderItemRequest) (*order_proto_gen.CancelOrderItemResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "CancelOrderItem"))
+	defer span.End()
+
+	if err := h.orderService.CancelOrderItem(ctx, data); err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.CancelOrderItemResponse{}, nil
+}
+
+func (h *OrderHandler) GetOrderItemTimeline(ctx context.Context, data *order_proto_gen.GetOrderItemTimelineRequest) (*order_proto_gen.GetOrderItemTimelineResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "GetOrderItemTimeline"))
+	defer span.End()
+
+	res, err := h.orderService.GetOrderItemTimeline(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (h *OrderHandler) GetPaymentMethods(ctx context.Context, _ *order_proto_gen.GetPaymentMethodsRequest) (*order_proto_gen.GetPaymentMethodsResponse, error) {
@@ -23,6 +25,10 @@ func (h *OrderHandler) CreateOrder(ctx context.Context, data *order_proto_gen.Ch
 	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "CreateOrder"))
 	defer span.End()
 
+	if err := validateCheckoutItems(data.Items); err != nil {
+		return nil, err
+	}
+
 	res, err := h.paymentService.CreateOrder(ctx, data)
 
 	if err != nil {
@@ -32,13 +38,52 @@ func (h *OrderHandler) CreateOrder(ctx context.Context, data *order_proto_gen.Ch
 	return res, nil
 }
 
-func (h *OrderHandler) UpdateOrderStatusFromMomo(ctx context.Context, data *order_proto_gen.UpdateOrderStatusFromMomoRequest) (*order_proto_gen.UpdateOrderStatusFromMomoResponse, error) {
-	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "UpdateOrderStatusFromMomo"))
+func (h *OrderHandler) QuoteCheckout(ctx context.Context, data *order_proto_gen.CheckoutRequest) (*order_proto_gen.QuoteCheckoutResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "QuoteCheckout"))
 	defer span.End()
 
-	if err := h.paymentService.UpdateOrderStatusFromMomo(ctx, data); err != nil {
+	if err := validateCheckoutItems(data.Items); err != nil {
+		return nil, err
+	}
+
+	res, err := h.paymentService.QuoteCheckout(ctx, data)
+
+	if err != nil {
 		return nil, err
 	}
 
-	return &order_proto_gen.UpdateOrderStatusFromMomoResponse{}, nil
+	return res, nil
+}
+
+func (h *OrderHandler) HandlePaymentCallback(ctx context.Context, data *order_proto_gen.HandlePaymentCallbackRequest) (*order_proto_gen.HandlePaymentCallbackResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "HandlePaymentCallback"))
+	defer span.End()
+
+	if len(data.RawData) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Callback data is empty")
+	}
+
+	res, err := h.paymentService.HandlePaymentCallback(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// validateCheckoutItems rejects product which is on more than one line, checkout keeps information and reservation
+// of each product by product variant id
+func validateCheckoutItems(items []*order_proto_gen.CheckoutItemRequest) error {
+	productVariantIDs := make(map[string]struct{}, len(items))
+
+	for _, item := range items {
+		if _, ok := productVariantIDs[item.ProductVariantId]; ok {
+			return status.Errorf(codes.InvalidArgument, "Product %s is duplicated in checkout items", item.ProductVariantId)
+		}
+
+		productVariantIDs[item.ProductVariantId] = struct{}{}
+	}
+
+	return nil
 }
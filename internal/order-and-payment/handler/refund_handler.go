@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+)
+
+func (h *OrderHandler) CreateRefund(ctx context.Context, data *order_proto_gen.CreateRefundRequest) (*order_proto_gen.CreateRefundResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "CreateRefund"))
+	defer span.End()
+
+	res, err := h.refundService.CreateRefund(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) ProcessRefund(ctx context.Context, data *order_proto_gen.ProcessRefundRequest) (*order_proto_gen.ProcessRefundResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "ProcessRefund"))
+	defer span.End()
+
+	res, err := h.refundService.ProcessRefund(ctx, data.RefundId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) GetRefunds(ctx context.Context, data *order_proto_gen.GetRefundsRequest) (*order_proto_gen.GetRefundsResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "GetRefunds"))
+	defer span.End()
+
+	res, err := h.refundService.GetRefunds(ctx, data.PaymentHistoryId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
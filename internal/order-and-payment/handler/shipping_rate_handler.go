@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+)
+
+func (h *OrderHandler) GetShippingRates(ctx context.Context, data *order_proto_gen.GetShippingRatesRequest) (*order_proto_gen.GetShippingRatesResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "GetShippingRates"))
+	defer span.End()
+
+	res, err := h.shippingRateService.GetShippingRates(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) CreateShippingRate(ctx context.Context, data *order_proto_gen.CreateShippingRateRequest) (*order_proto_gen.CreateShippingRateResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "CreateShippingRate"))
+	defer span.End()
+
+	res, err := h.shippingRateService.CreateShippingRate(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) UpdateShippingRate(ctx context.Context, data *order_proto_gen.UpdateShippingRateRequest) (*order_proto_gen.UpdateShippingRateResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "UpdateShippingRate"))
+	defer span.End()
+
+	if err := h.shippingRateService.UpdateShippingRate(ctx, data); err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.UpdateShippingRateResponse{}, nil
+}
+
+func (h *OrderHandler) DeleteShippingRate(ctx context.Context, data *order_proto_gen.DeleteShippingRateRequest) (*order_proto_gen.DeleteShippingRateResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "DeleteShippingRate"))
+	defer span.End()
+
+	if err := h.shippingRateService.DeleteShippingRate(ctx, data.Id); err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.DeleteShippingRateResponse{}, nil
+}
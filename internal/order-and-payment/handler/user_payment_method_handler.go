@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+)
+
+func (h *OrderHandler) GetUserPaymentMethods(ctx context.Context, data *order_proto_gen.GetUserPaymentMethodsRequest) (*order_proto_gen.GetUserPaymentMethodsResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "GetUserPaymentMethods"))
+	defer span.End()
+
+	res, err := h.userPaymentMethodService.GetUserPaymentMethods(ctx, data.UserId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) CreateUserPaymentMethod(ctx context.Context, data *order_proto_gen.CreateUserPaymentMethodRequest) (*order_proto_gen.CreateUserPaymentMethodResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "CreateUserPaymentMethod"))
+	defer span.End()
+
+	res, err := h.userPaymentMethodService.CreateUserPaymentMethod(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) SetDefaultUserPaymentMethod(ctx context.Context, data *order_proto_gen.SetDefaultUserPaymentMethodRequest) (*order_proto_gen.SetDefaultUserPaymentMethodResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "SetDefaultUserPaymentMethod"))
+	defer span.End()
+
+	if err := h.userPaymentMethodService.SetDefaultUserPaymentMethod(ctx, data.UserId, data.UserPaymentMethodId); err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.SetDefaultUserPaymentMethodResponse{}, nil
+}
+
+func (h *OrderHandler) DeleteUserPaymentMethod(ctx context.Context, data *order_proto_gen.DeleteUserPaymentMethodRequest) (*order_proto_gen.DeleteUserPaymentMethodResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "DeleteUserPaymentMethod"))
+	defer span.End()
+
+	if err := h.userPaymentMethodService.DeleteUserPaymentMethod(ctx, data.UserId, data.UserPaymentMethodId); err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.DeleteUserPaymentMethodResponse{}, nil
+}
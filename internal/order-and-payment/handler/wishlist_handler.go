@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+)
+
+func (h *OrderHandler) AddItemToWishlist(ctx context.Context, data *order_proto_gen.AddItemToWishlistRequest) (*order_proto_gen.AddItemToWishlistResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "AddItemToWishlist"))
+	defer span.End()
+
+	res, err := h.wishlistService.AddItemToWishlist(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) GetWishlist(ctx context.Context, data *order_proto_gen.GetWishlistRequest) (*order_proto_gen.GetWishlistResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "GetWishlist"))
+	defer span.End()
+
+	res, err := h.wishlistService.GetWishlist(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) RemoveWishlistItems(ctx context.Context, data *order_proto_gen.RemoveWishlistItemsRequest) (*order_proto_gen.RemoveWishlistItemsResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "RemoveWishlistItems"))
+	defer span.End()
+
+	res, err := h.wishlistService.RemoveWishlistItems(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (h *OrderHandler) MoveWishlistItemToCart(ctx context.Context, data *order_proto_gen.MoveWishlistItemToCartRequest) (*order_proto_gen.MoveWishlistItemToCartResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "MoveWishlistItemToCart"))
+	defer span.End()
+
+	if err := h.wishlistService.MoveWishlistItemToCart(ctx, data); err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.MoveWishlistItemToCartResponse{}, nil
+}
+
+func (h *OrderHandler) MoveCartItemToWishlist(ctx context.Context, data *order_proto_gen.MoveCartItemToWishlistRequest) (*order_proto_gen.MoveCartItemToWishlistResponse, error) {
+	ctx, span := h.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "MoveCartItemToWishlist"))
+	defer span.End()
+
+	res, err := h.wishlistService.MoveCartItemToWishlist(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,5 @@
+alter table order_items
+drop constraint fk_coupon_id_order_items;
+
+alter table order_items
+drop column coupon_id;
@@ -0,0 +1,6 @@
+alter table order_items
+add column coupon_id uuid;
+
+alter table order_items
+add constraint fk_coupon_id_order_items
+foreign key (coupon_id) references coupons(id) on delete set null;
@@ -0,0 +1,2 @@
+alter table order_items_history
+alter column created_by set not null;
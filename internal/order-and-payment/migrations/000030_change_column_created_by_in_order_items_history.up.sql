@@ -0,0 +1,3 @@
+-- created_by is null when status is changed by system (payment gateway, jobs)
+alter table order_items_history
+alter column created_by drop not null;
@@ -0,0 +1,5 @@
+alter table order_items_history
+drop constraint check_actor_order_items_history;
+
+alter table order_items_history
+drop column actor;
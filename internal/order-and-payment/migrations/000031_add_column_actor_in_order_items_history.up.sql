@@ -0,0 +1,6 @@
+alter table order_items_history
+add column actor varchar(30) not null default 'system';
+
+alter table order_items_history
+add constraint check_actor_order_items_history
+check (actor in ('customer', 'supplier', 'deliverer', 'system'));
@@ -0,0 +1,2 @@
+alter table payment_history
+alter column user_payment_method_id set not null;
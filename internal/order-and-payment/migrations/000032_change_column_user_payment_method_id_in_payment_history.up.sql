@@ -0,0 +1,3 @@
+-- payment via gateway (momo, ...) is not bound to saved payment method of user
+alter table payment_history
+alter column user_payment_method_id drop not null;
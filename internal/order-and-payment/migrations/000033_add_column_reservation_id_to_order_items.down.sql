@@ -0,0 +1,2 @@
+alter table order_items
+drop column reservation_id;
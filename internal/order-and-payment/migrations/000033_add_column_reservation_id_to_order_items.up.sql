@@ -0,0 +1,3 @@
+-- id of inventory reservation in partners db, empty for order items created before reservation
+alter table order_items
+add column reservation_id uuid;
@@ -0,0 +1,26 @@
+alter table orders
+drop constraint fk_user_payment_method_id_orders;
+
+alter table orders
+drop column user_payment_method_id;
+
+drop index if exists idx_user_id_default_user_payment_methods;
+
+alter table user_payment_methods
+drop constraint check_card_expiry_month_user_payment_methods;
+
+alter table user_payment_methods
+add column card_number varchar(255);
+
+update user_payment_methods
+set card_number = coalesce(card_last4, '');
+
+alter table user_payment_methods
+alter column card_number set not null;
+
+alter table user_payment_methods
+drop column provider_token,
+drop column encrypted_card_number,
+drop column card_last4,
+drop column card_brand,
+drop column deleted_at;
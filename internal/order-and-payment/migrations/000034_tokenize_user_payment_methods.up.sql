@@ -0,0 +1,40 @@
+-- card data is kept only as token of provider (or encrypted card number) with last 4 digits, brand and expiry
+alter table user_payment_methods
+add column provider_token text,
+add column encrypted_card_number text,
+add column card_last4 varchar(4),
+add column card_brand varchar(50),
+add column deleted_at timestamptz;
+
+-- plain card numbers are not kept, only last 4 digits
+update user_payment_methods
+set card_last4 = right(regexp_replace(card_number, '\D', '', 'g'), 4);
+
+alter table user_payment_methods
+drop column card_number;
+
+alter table user_payment_methods
+add constraint check_card_expiry_month_user_payment_methods
+check (card_expiry_month is null or card_expiry_month between 1 and 12);
+
+-- only one default payment method for each user
+update user_payment_methods
+set is_default = false
+where is_default = true and id not in (
+    select distinct on (user_id) id from user_payment_methods
+    where is_default = true
+    order by user_id, updated_at desc
+);
+
+create unique index idx_user_id_default_user_payment_methods
+on user_payment_methods(user_id)
+where is_default = true and deleted_at is null;
+
+-- saved payment method which buyer picked at checkout
+alter table orders
+add column user_payment_method_id bigint;
+
+alter table orders
+add constraint fk_user_payment_method_id_orders
+foreign key (user_payment_method_id) references user_payment_methods(id)
+on delete no action;
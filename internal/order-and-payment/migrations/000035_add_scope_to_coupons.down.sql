@@ -0,0 +1,15 @@
+alter table orders
+drop constraint if exists fk_coupon_id_orders;
+
+alter table orders
+drop column coupon_id;
+
+alter table coupons
+drop constraint if exists check_category_id_coupons;
+
+alter table coupons
+drop constraint if exists check_scope_coupons;
+
+alter table coupons
+drop column category_id,
+drop column scope;
@@ -0,0 +1,21 @@
+-- product: coupon of order item, order: coupon of whole order, category: coupon of order item in category
+alter table coupons
+add column scope varchar(20) not null default 'product',
+add column category_id bigint;
+
+alter table coupons
+add constraint check_scope_coupons
+check (scope in ('order', 'product', 'category'));
+
+-- categories live in partner service, so only id of category is kept
+alter table coupons
+add constraint check_category_id_coupons
+check ((scope = 'category') = (category_id is not null));
+
+-- coupon of whole order, its discount is spread into discount_amount of order items
+alter table orders
+add column coupon_id uuid;
+
+alter table orders
+add constraint fk_coupon_id_orders
+foreign key (coupon_id) references coupons(id) on delete set null;
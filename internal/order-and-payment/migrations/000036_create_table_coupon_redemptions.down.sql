@@ -0,0 +1,7 @@
+drop table if exists coupon_redemptions;
+
+alter table coupons
+drop constraint if exists check_usage_limit_per_user_coupons;
+
+alter table coupons
+drop column usage_limit_per_user;
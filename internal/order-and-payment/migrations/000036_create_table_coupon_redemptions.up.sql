@@ -0,0 +1,57 @@
+-- null means buyer can use coupon until usage_limit is reached
+alter table coupons
+add column usage_limit_per_user int;
+
+alter table coupons
+add constraint check_usage_limit_per_user_coupons
+check (usage_limit_per_user is null or usage_limit_per_user > 0);
+
+-- one row for each use of coupon: order item for coupon of product/category, order for coupon of whole order
+create table if not exists coupon_redemptions (
+    id uuid primary key default gen_random_uuid(),
+    coupon_id uuid not null,
+    user_id bigint not null,
+    order_id uuid not null,
+    order_item_id uuid,
+    status varchar(20) not null default 'redeemed',
+    created_at timestamptz default current_timestamp,
+    reversed_at timestamptz
+);
+
+alter table coupon_redemptions
+add constraint fk_coupon_id_coupon_redemptions
+foreign key (coupon_id) references coupons(id) on delete cascade;
+
+alter table coupon_redemptions
+add constraint fk_order_id_coupon_redemptions
+foreign key (order_id) references orders(id) on delete cascade;
+
+alter table coupon_redemptions
+add constraint fk_order_item_id_coupon_redemptions
+foreign key (order_item_id) references order_items(id) on delete cascade;
+
+alter table coupon_redemptions
+add constraint check_status_coupon_redemptions
+check (status in ('redeemed', 'reversed'));
+
+create index idx_coupon_id_user_id_coupon_redemptions
+on coupon_redemptions(coupon_id, user_id)
+where status = 'redeemed';
+
+create index idx_order_id_coupon_redemptions
+on coupon_redemptions(order_id);
+
+-- coupons used by orders which are still alive
+insert into coupon_redemptions (coupon_id, user_id, order_id, order_item_id, created_at)
+select oi.coupon_id, o.user_id, o.id, oi.id, oi.created_at
+from order_items oi
+inner join orders o on oi.order_id = o.id
+where oi.coupon_id is not null and oi.status not in ('cancelled', 'payment_failed');
+
+insert into coupon_redemptions (coupon_id, user_id, order_id, created_at)
+select o.coupon_id, o.user_id, o.id, o.created_at
+from orders o
+where o.coupon_id is not null and exists (
+    select 1 from order_items oi
+    where oi.order_id = o.id and oi.status not in ('cancelled', 'payment_failed')
+);
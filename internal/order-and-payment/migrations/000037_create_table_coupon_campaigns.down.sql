@@ -0,0 +1,12 @@
+drop index if exists idx_user_id_coupons;
+
+drop index if exists idx_campaign_id_coupons;
+
+alter table coupons
+drop constraint if exists fk_campaign_id_coupons;
+
+alter table coupons
+drop column user_id,
+drop column campaign_id;
+
+drop table if exists coupon_campaigns;
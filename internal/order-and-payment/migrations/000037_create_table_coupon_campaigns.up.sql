@@ -0,0 +1,73 @@
+-- campaign keeps discount rules once, its single-use codes are generated into coupons in background
+create table if not exists coupon_campaigns (
+    id uuid primary key default gen_random_uuid(),
+    name varchar(5000) not null,
+    description text,
+    discount_type varchar(20) not null,
+    discount_value numeric(14, 2) not null default 0,
+    maximum_discount_amount numeric(14, 2) not null default 0,
+    minimum_order_amount numeric(14, 2) not null default 0,
+    currency varchar(20) not null default 'VND',
+    start_date timestamptz not null,
+    end_date timestamptz not null,
+    scope varchar(20) not null default 'product',
+    category_id bigint,
+    code_prefix varchar(20) not null default '',
+    total_codes int not null,
+    generated_codes int not null default 0,
+    -- code i is assigned to user_ids[i], codes are not assigned when it is null
+    user_ids bigint[],
+    status varchar(20) not null default 'pending',
+    created_by bigint not null,
+    created_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp
+);
+
+CREATE TRIGGER set_timestamp_coupon_campaigns
+    BEFORE UPDATE ON coupon_campaigns
+    FOR EACH ROW
+    EXECUTE FUNCTION update_modified_column();
+
+alter table coupon_campaigns
+add constraint check_discount_type_coupon_campaigns
+check (discount_type in ('percentage', 'fixed_amount'));
+
+alter table coupon_campaigns
+add constraint check_scope_coupon_campaigns
+check (scope in ('order', 'product', 'category'));
+
+alter table coupon_campaigns
+add constraint check_category_id_coupon_campaigns
+check ((scope = 'category') = (category_id is not null));
+
+alter table coupon_campaigns
+add constraint check_total_codes_coupon_campaigns
+check (total_codes > 0 and generated_codes between 0 and total_codes);
+
+alter table coupon_campaigns
+add constraint check_user_ids_coupon_campaigns
+check (user_ids is null or cardinality(user_ids) = total_codes);
+
+alter table coupon_campaigns
+add constraint check_status_coupon_campaigns
+check (status in ('pending', 'completed'));
+
+create index idx_status_coupon_campaigns
+on coupon_campaigns(status)
+where status = 'pending';
+
+-- codes of campaign, user_id is buyer who is the only one allowed to use assigned code
+alter table coupons
+add column campaign_id uuid,
+add column user_id bigint;
+
+alter table coupons
+add constraint fk_campaign_id_coupons
+foreign key (campaign_id) references coupon_campaigns(id) on delete cascade;
+
+create index idx_campaign_id_coupons
+on coupons(campaign_id);
+
+create index idx_user_id_coupons
+on coupons(user_id)
+where user_id is not null;
@@ -0,0 +1,2 @@
+alter table orders
+drop column prices_include_tax;
@@ -0,0 +1,4 @@
+-- true when tax_amount is already contained in total_price of order items (tax-inclusive pricing),
+-- so amount which buyer pays for item is total_price - discount_amount, not total_price + tax_amount - discount_amount
+alter table orders
+add column prices_include_tax boolean not null default false;
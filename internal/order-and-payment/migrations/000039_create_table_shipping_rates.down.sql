@@ -0,0 +1,7 @@
+alter table orders
+drop column shipping_fee,
+drop column shipping_ward,
+drop column shipping_district,
+drop column shipping_province;
+
+drop table if exists shipping_rates;
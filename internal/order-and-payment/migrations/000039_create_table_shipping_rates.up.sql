@@ -0,0 +1,42 @@
+-- fee of one package of supplier = base_fee + fee_per_extra_kg * ceil((weight - base_weight_grams) / 1000)
+-- rates of shipping class 'default' are used for shipping classes which have no rates
+create table if not exists shipping_rates (
+    id bigserial primary key,
+    shipping_class varchar(255) not null,
+    zone varchar(20) not null,
+    base_fee numeric(14, 2) not null,
+    base_weight_grams int not null default 0,
+    fee_per_extra_kg numeric(14, 2) not null default 0,
+    created_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp
+);
+
+CREATE TRIGGER set_timestamp_shipping_rates
+    BEFORE UPDATE ON shipping_rates
+    FOR EACH ROW
+    EXECUTE FUNCTION update_modified_column();
+
+alter table shipping_rates
+add constraint unique_shipping_class_zone_shipping_rates
+unique (shipping_class, zone);
+
+-- intra_district: same district of same province, intra_province: same province, inter_province: other provinces
+alter table shipping_rates
+add constraint check_zone_shipping_rates
+check (zone in ('intra_district', 'intra_province', 'inter_province'));
+
+alter table shipping_rates
+add constraint check_fee_shipping_rates
+check (base_fee >= 0 and fee_per_extra_kg >= 0 and base_weight_grams >= 0);
+
+insert into shipping_rates (shipping_class, zone, base_fee, base_weight_grams, fee_per_extra_kg)
+values ('default', 'intra_district', 15000, 1000, 2500),
+       ('default', 'intra_province', 22000, 1000, 2500),
+       ('default', 'inter_province', 32000, 1000, 5000);
+
+-- destination of order, shipping_fee is sum of fees of packages of all suppliers
+alter table orders
+add column shipping_province varchar(255),
+add column shipping_district varchar(255),
+add column shipping_ward varchar(255),
+add column shipping_fee numeric(14, 2) not null default 0;
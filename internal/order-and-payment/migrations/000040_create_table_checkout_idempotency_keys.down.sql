@@ -0,0 +1 @@
+drop table if exists checkout_idempotency_keys;
@@ -0,0 +1,18 @@
+-- idempotency key sent by client with checkout, retry with same key replays response of first checkout.
+-- order_id, status and payment_url are null while first checkout is in progress
+create table if not exists checkout_idempotency_keys (
+    user_id bigint not null,
+    idempotency_key varchar(255) not null,
+    request_hash varchar(64) not null,
+    order_id uuid references orders(id) on delete cascade,
+    status varchar(50),
+    payment_url text,
+    created_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp,
+    primary key (user_id, idempotency_key)
+);
+
+CREATE TRIGGER set_timestamp_checkout_idempotency_keys
+    BEFORE UPDATE ON checkout_idempotency_keys
+    FOR EACH ROW
+    EXECUTE FUNCTION update_modified_column();
@@ -0,0 +1,19 @@
+-- domain events of orders, they are written in same transaction as change of order
+-- and published to kafka by relay in order of id, aggregate_id (order id) is key of kafka message
+create table if not exists outbox_events (
+    id bigserial primary key,
+    event_id uuid not null unique,
+    aggregate_id varchar(255) not null,
+    event_type varchar(100) not null,
+    event_version int not null,
+    payload bytea not null,
+    attempts int not null default 0,
+    last_error text,
+    next_attempt_at timestamptz not null default current_timestamp,
+    published_at timestamptz,
+    created_at timestamptz default current_timestamp
+);
+
+create index if not exists idx_outbox_events_unpublished on outbox_events (id) where published_at is null;
+create index if not exists idx_outbox_events_aggregate_unpublished on outbox_events (aggregate_id) where published_at is null;
+create index if not exists idx_outbox_events_published_at on outbox_events (published_at) where published_at is not null;
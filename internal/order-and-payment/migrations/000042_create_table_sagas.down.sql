@@ -0,0 +1,4 @@
+alter table orders
+drop column saga_id;
+
+drop table if exists sagas;
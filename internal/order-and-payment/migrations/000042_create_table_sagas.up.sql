@@ -0,0 +1,29 @@
+-- state of sagas, it is saved after each step, so saga can be resumed after crash.
+-- current_step is step which is being executed (running) or compensated (compensating)
+create table if not exists sagas (
+    id uuid primary key default gen_random_uuid(),
+    saga_type varchar(50) not null,
+    status varchar(20) not null,
+    current_step int not null default 0,
+    state jsonb not null,
+    last_error text,
+    attempts int not null default 0,
+    next_attempt_at timestamptz not null default current_timestamp,
+    created_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp
+);
+
+CREATE TRIGGER set_timestamp_sagas
+    BEFORE UPDATE ON sagas
+    FOR EACH ROW
+    EXECUTE FUNCTION update_modified_column();
+
+alter table sagas
+add constraint check_status_sagas
+check (status in ('running', 'compensating', 'completed', 'compensated'));
+
+create index if not exists idx_sagas_unfinished on sagas (updated_at) where status in ('running', 'compensating');
+
+-- checkout saga which created order, order is found by it when saga is compensated
+alter table orders
+add column saga_id uuid unique;
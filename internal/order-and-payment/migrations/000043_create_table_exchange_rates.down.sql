@@ -0,0 +1,8 @@
+alter table order_items
+drop column currency,
+drop column exchange_rate;
+
+alter table orders
+drop column currency;
+
+drop table if exists exchange_rates;
@@ -0,0 +1,25 @@
+-- rate is amount of base currency (VND) for one unit of currency, base currency itself has no row
+create table if not exists exchange_rates (
+    currency varchar(20) primary key,
+    rate numeric(18, 8) not null,
+    created_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp
+);
+
+CREATE TRIGGER set_timestamp_exchange_rates
+    BEFORE UPDATE ON exchange_rates
+    FOR EACH ROW
+    EXECUTE FUNCTION update_modified_column();
+
+alter table exchange_rates
+add constraint check_rate_exchange_rates
+check (rate > 0);
+
+-- order is settled in currency of payment gateway, prices of products in other currencies are converted into it
+alter table orders
+add column currency varchar(20) not null default 'VND';
+
+-- currency of product price and rate which converted it into currency of order
+alter table order_items
+add column currency varchar(20) not null default 'VND',
+add column exchange_rate numeric(18, 8) not null default 1;
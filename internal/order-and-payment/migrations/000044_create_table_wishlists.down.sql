@@ -0,0 +1,3 @@
+drop table if exists wishlist_items;
+
+drop table if exists wishlists;
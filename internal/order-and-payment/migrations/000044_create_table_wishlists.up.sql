@@ -0,0 +1,33 @@
+-- wishlist of user is created when first item is saved
+create table if not exists wishlists (
+    id bigserial primary key,
+    user_id bigint not null
+);
+
+create unique index idx_user_id_wishlists
+on wishlists (user_id);
+
+-- saved price is price of product when item is saved, it is compared with current price to show price drop
+create table if not exists wishlist_items (
+    id uuid primary key default gen_random_uuid(),
+    wishlist_id bigint not null,
+    product_id uuid not null,
+    product_variant_id uuid not null,
+    saved_price numeric(14, 2) not null,
+    currency varchar(20) not null default 'VND',
+    added_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp
+);
+
+CREATE TRIGGER set_timestamp_wishlist_items
+    BEFORE UPDATE ON wishlist_items
+    FOR EACH ROW
+    EXECUTE FUNCTION update_modified_column();
+
+alter table wishlist_items
+add constraint fk_wishlist_id_wishlist_items
+foreign key (wishlist_id) references wishlists(id)
+on delete cascade;
+
+create unique index idx_wishlist_id_product_variant_id_wishlist_items
+on wishlist_items (wishlist_id, product_variant_id);
@@ -0,0 +1,3 @@
+alter table cart_items
+drop column last_seen_price,
+drop column last_seen_currency;
@@ -0,0 +1,4 @@
+-- last price seen by buyer in currency of product, it is saved when item is added or its quantity changes
+alter table cart_items
+add column last_seen_price numeric(14, 2),
+add column last_seen_currency varchar(20);
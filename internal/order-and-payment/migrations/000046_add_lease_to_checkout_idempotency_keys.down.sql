@@ -0,0 +1,2 @@
+alter table checkout_idempotency_keys
+drop column lease_expires_at;
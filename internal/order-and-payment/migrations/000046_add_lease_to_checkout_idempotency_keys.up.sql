@@ -0,0 +1,4 @@
+-- checkout which is in progress holds key until lease expires, so retry can take over key of checkout which died.
+-- It is null when response of checkout is saved
+alter table checkout_idempotency_keys
+add column lease_expires_at timestamptz;
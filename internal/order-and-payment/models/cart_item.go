@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"time"
+)
 
 type CartItem struct {
 	ID               string
@@ -8,6 +11,10 @@ type CartItem struct {
 	ProductID        string
 	Quantity         int64
 	ProductVariantID string
+	// LastSeenPrice is price which buyer saw last time, it is saved when item is added or its quantity changes
+	// and when buyer acknowledges price change. It is nil for items which were added before prices were saved
+	LastSeenPrice    *money.Money
+	LastSeenCurrency *string
 	AddedAt          time.Time
 	UpdatedAt        time.Time
 }
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+type CheckoutIdempotencyKey struct {
+	UserID         int64
+	IdempotencyKey string
+	RequestHash    string
+	OrderID        *string
+	Status         *string
+	PaymentURL     *string
+	// LeaseExpiresAt is nil when response is saved
+	LeaseExpiresAt *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -1,22 +1,32 @@
 package models
 
-import "time"
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"time"
+)
 
 type Coupon struct {
-	ID                    string
-	Code                  string
-	Name                  string
-	Description           string
-	DiscountType          string
-	DiscountValue         float64
-	MaximumDiscountAmount float64
-	MinimumOrderAmount    float64
+	ID           string
+	Code         string
+	Name         string
+	Description  string
+	DiscountType string
+	// DiscountValue is percent when discount type is percentage, otherwise amount
+	DiscountValue         money.Money
+	MaximumDiscountAmount money.Money
+	MinimumOrderAmount    money.Money
 	Currency              string
 	StartDate             time.Time
 	EndDate               time.Time
 	UsageLimit            int64
 	UsageCount            int64
+	UsageLimitPerUser     *int64 // nil means no limit for each buyer
 	IsActive              bool
+	Scope                 common.CouponScope
+	CategoryID            *int64 // only set when scope is category
+	CampaignID            *string
+	UserID                *int64 // buyer who is the only one allowed to use code of campaign
 	CreatedAt             time.Time
 	UpdatedAt             time.Time
 }
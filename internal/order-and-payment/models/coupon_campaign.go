@@ -0,0 +1,35 @@
+package models
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"time"
+)
+
+type CouponCampaign struct {
+	ID           string
+	Name         string
+	Description  string
+	DiscountType string
+	// DiscountValue is percent when discount type is percentage, otherwise amount
+	DiscountValue         money.Money
+	MaximumDiscountAmount money.Money
+	MinimumOrderAmount    money.Money
+	Currency              string
+	StartDate             time.Time
+	EndDate               time.Time
+	Scope                 common.CouponScope
+	CategoryID            *int64
+	CodePrefix            string
+	TotalCodes            int64
+	GeneratedCodes        int64
+	UserIDs               []int64 // code i is assigned to UserIDs[i], nil when codes are not assigned
+	Status                common.CouponCampaignStatus
+	CreatedBy             int64
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+
+	// additional info
+	UsedCodes    int64
+	RevokedCodes int64
+}
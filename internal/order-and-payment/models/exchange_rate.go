@@ -0,0 +1,14 @@
+package models
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"time"
+)
+
+// ExchangeRate is amount of base currency for one unit of currency
+type ExchangeRate struct {
+	Currency  string
+	Rate      money.Rate
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
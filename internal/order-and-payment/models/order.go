@@ -0,0 +1,26 @@
+package models
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"time"
+)
+
+type Order struct {
+	ID              string
+	UserID          int64
+	TrackingNumber  string
+	ShippingAddress string
+	ShippingMethod  common.MethodType
+	SubTotal        money.Money
+	DiscountAmount  money.Money
+	TaxAmount       money.Money
+	TotalAmount     money.Money
+	RecipientName   string
+	RecipientPhone  string
+	CouponID        *string
+	// Currency is currency of payment gateway which order is settled in
+	Currency  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+type OrderDeliverer struct {
+	ID              string
+	OrderItemID     string
+	DelivererID     int64
+	Status          string
+	PickupTime      *time.Time
+	DeliveryTime    *time.Time
+	DeliveryNotes   *string
+	FailureReason   *string
+	ProofOfDelivery *string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
 	"time"
 )
 
@@ -12,21 +13,26 @@ type OrderItem struct {
 	ProductVariantImageURL string
 	ProductVariantName     string
 	Quantity               int64
-	UnitPrice              float64
-	TotalPrice             float64
+	UnitPrice              money.Money
+	TotalPrice             money.Money
 	EstimatedDeliveryDate  time.Time
 	ActualDeliveryDate     *time.Time
 	CancelledReason        *string
 	Notes                  *string
 	Status                 common.StatusOrder
-	DiscountAmount         float64
-	TaxAmount              float64
-	ShippingFee            float64
+	DiscountAmount         money.Money
+	TaxAmount              money.Money
+	ShippingFee            money.Money
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
 	ProductVariantID       string
 	SupplierID             int64
 	ProductID              string
+	CouponID               *string
+	ReservationID          *string
+	// Currency is currency of product price, ExchangeRate converted it into currency of order
+	Currency     string
+	ExchangeRate money.Rate
 
 	// additional info
 	TrackingNumber  string
@@ -34,4 +40,5 @@ type OrderItem struct {
 	ShippingMethod  common.MethodType
 	RecipientName   string
 	RecipientPhone  string
+	OrderCurrency   string
 }
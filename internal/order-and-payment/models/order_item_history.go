@@ -0,0 +1,16 @@
+package models
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"time"
+)
+
+type OrderItemHistory struct {
+	ID          string
+	OrderItemID string
+	Status      common.StatusOrder
+	Actor       common.OrderActor
+	Notes       *string
+	CreatedAt   time.Time
+	CreatedBy   *int64
+}
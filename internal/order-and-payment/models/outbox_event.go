@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+type OutboxEvent struct {
+	ID            int64
+	EventID       string
+	AggregateID   string
+	EventType     string
+	EventVersion  int32
+	Payload       []byte
+	Attempts      int
+	LastError     *string
+	NextAttemptAt time.Time
+	PublishedAt   *time.Time
+	CreatedAt     time.Time
+}
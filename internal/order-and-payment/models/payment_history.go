@@ -0,0 +1,22 @@
+package models
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"time"
+)
+
+type PaymentHistory struct {
+	ID                     string
+	OrderItemID            string
+	UserPaymentMethodID    *int64
+	Amount                 money.Money
+	Currency               string
+	Status                 common.PaymentStatus
+	TransactionID          *string
+	PaymentGateway         *string
+	PaymentGatewayResponse []byte
+	ErrorMessage           *string
+	PaidAt                 *time.Time
+	CreatedAt              time.Time
+}
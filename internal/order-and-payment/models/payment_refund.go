@@ -0,0 +1,19 @@
+package models
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"time"
+)
+
+type PaymentRefund struct {
+	ID                     string
+	PaymentHistoryID       string
+	Amount                 money.Money
+	Currency               string // currency of payment which is refunded
+	Status                 common.RefundStatus
+	TransactionID          *string
+	PaymentGatewayResponse []byte
+	ProcessedBy            int64
+	RefundedAt             *time.Time
+}
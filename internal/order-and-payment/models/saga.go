@@ -0,0 +1,19 @@
+package models
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"time"
+)
+
+type Saga struct {
+	ID            string
+	SagaType      common.SagaType
+	Status        common.SagaStatus
+	CurrentStep   int
+	State         []byte
+	LastError     *string
+	Attempts      int
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
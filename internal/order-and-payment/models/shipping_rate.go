@@ -0,0 +1,18 @@
+package models
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"time"
+)
+
+type ShippingRate struct {
+	ID              int64
+	ShippingClass   string
+	Zone            common.ShippingZone
+	BaseFee         money.Money
+	BaseWeightGrams int64
+	FeePerExtraKg   money.Money
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
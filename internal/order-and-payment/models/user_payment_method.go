@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+type UserPaymentMethod struct {
+	ID                  int64
+	UserID              int64
+	PaymentMethodID     int64
+	PaymentMethodCode   string
+	PaymentMethodName   string
+	IsDefault           bool
+	CardHolderName      string
+	ProviderToken       *string
+	EncryptedCardNumber *string
+	CardLast4           string
+	CardBrand           *string
+	CardExpiryMonth     *int64
+	CardExpiryYear      *int64
+	DeletedAt           *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
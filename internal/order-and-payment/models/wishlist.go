@@ -0,0 +1,23 @@
+package models
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"time"
+)
+
+type Wishlist struct {
+	ID     int64
+	UserID int64
+}
+
+// WishlistItem keeps price of product when it is saved, saved price is in currency of product
+type WishlistItem struct {
+	ID               string
+	WishlistID       int64
+	ProductID        string
+	ProductVariantID string
+	SavedPrice       money.Money
+	Currency         string
+	AddedAt          time.Time
+	UpdatedAt        time.Time
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/Masterminds/squirrel"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
 	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/pkg"
 	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
@@ -36,125 +37,158 @@ func NewCartRepository(tracer pkg.Tracer, db pkg.Database,
 	}
 }
 
-func (c *cartRepository) AddItemToCart(ctx context.Context, data *order_proto_gen.AddItemToCartRequest) error {
+func (c *cartRepository) AddItemToCart(ctx context.Context, data *order_proto_gen.AddItemToCartRequest, price dto.UnitPrice) error {
 	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "AddItemToCart"))
 	defer span.End()
 
 	return c.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.Serializable}, func(tx pkg.Tx) error {
-		quantityStr, err := c.redis.Get(ctx, fmt.Sprintf("product_variant:%v", data.ProductVariantId))
+		if err := c.addItemToCart(ctx, tx, data, price); err != nil {
+			span.RecordError(err)
+			return err
+		}
 
-		var quantityInventory int64
-		// check available prod to add item to cart
-		if err != nil {
-			if !errors.Is(err, redis.Nil) {
-				return status.Error(codes.Internal, err.Error())
-			}
+		return nil
+	})
+}
 
-			// check available prod if not exists in redis
-			availableRes, errAvailable := c.partnerClient.CheckAvailableProduct(ctx, &partner_proto_gen.CheckAvailableProductRequest{
-				ProductVariantId: data.ProductVariantId,
-				Quantity:         data.Quantity,
-			})
+func (c *cartRepository) MoveWishlistItemToCart(ctx context.Context, data *order_proto_gen.AddItemToCartRequest, price dto.UnitPrice, wishlistItemID string) error {
+	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "MoveWishlistItemToCart"))
+	defer span.End()
 
-			if errAvailable != nil {
-				return errAvailable
-			}
+	return c.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.Serializable}, func(tx pkg.Tx) error {
+		if err := c.addItemToCart(ctx, tx, data, price); err != nil {
+			span.RecordError(err)
+			return err
+		}
 
-			quantityInventory = availableRes.Quantity
+		var deletedID string
+		sqlDelete := `delete from wishlist_items
+			where id = $1 and wishlist_id in (select id from wishlists where user_id = $2)
+			returning id`
+
+		if err := tx.QueryRow(ctx, sqlDelete, wishlistItemID, data.UserId).Scan(&deletedID); err != nil {
+			span.RecordError(err)
 
-			if errAvailable = c.redis.Set(ctx, fmt.Sprintf("product_variant:%v", data.ProductVariantId), availableRes.Quantity, time.Minute*10); errAvailable != nil {
-				span.RecordError(errAvailable)
-				return status.Error(codes.Internal, errAvailable.Error())
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.NotFound, "Wishlist item not found")
 			}
-		} else {
-			quantityInt, _ := strconv.Atoi(quantityStr)
-			quantityInventory = int64(quantityInt)
+
+			return status.Error(codes.Internal, err.Error())
 		}
 
-		// get cart id
-		selectCartID, args, err := squirrel.Select("id").From("carts").
-			Where(squirrel.Eq{"user_id": data.UserId}).
-			PlaceholderFormat(squirrel.Dollar).
-			ToSql()
+		return nil
+	})
+}
 
-		if err != nil {
-			span.RecordError(err)
+// addItemToCart adds quantity of product variant into cart of user in transaction tx,
+// inventory is checked with cached quantity of product variant and price is saved as last price seen by buyer
+func (c *cartRepository) addItemToCart(ctx context.Context, tx pkg.Tx, data *order_proto_gen.AddItemToCartRequest, price dto.UnitPrice) error {
+	quantityStr, err := c.redis.Get(ctx, fmt.Sprintf("product_variant:%v", data.ProductVariantId))
+
+	var quantityInventory int64
+	// check available prod to add item to cart
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
 			return status.Error(codes.Internal, err.Error())
 		}
 
-		var cartID int64
+		// check available prod if not exists in redis
+		availableRes, errAvailable := c.partnerClient.CheckAvailableProduct(ctx, &partner_proto_gen.CheckAvailableProductRequest{
+			ProductVariantId: data.ProductVariantId,
+			Quantity:         data.Quantity,
+		})
 
-		if err = c.db.QueryRow(ctx, selectCartID, args...).Scan(&cartID); err != nil {
-			span.RecordError(err)
+		if errAvailable != nil {
+			return errAvailable
+		}
 
-			if errors.Is(err, pgx.ErrNoRows) {
-				return status.Error(codes.NotFound, err.Error())
-			}
+		quantityInventory = availableRes.Quantity
 
-			return status.Error(codes.Internal, err.Error())
+		if errAvailable = c.redis.Set(ctx, fmt.Sprintf("product_variant:%v", data.ProductVariantId), availableRes.Quantity, time.Minute*10); errAvailable != nil {
+			return status.Error(codes.Internal, errAvailable.Error())
 		}
+	} else {
+		quantityInt, _ := strconv.Atoi(quantityStr)
+		quantityInventory = int64(quantityInt)
+	}
 
-		// check exists of prod variant in cart_items
-		sqlGet, args, err := squirrel.Select("quantity").From("cart_items").
-			Where(squirrel.Eq{"cart_id": cartID}).
-			Where(squirrel.Eq{"product_variant_id": data.ProductVariantId}).
-			PlaceholderFormat(squirrel.Dollar).
-			ToSql()
+	// get cart id
+	selectCartID, args, err := squirrel.Select("id").From("carts").
+		Where(squirrel.Eq{"user_id": data.UserId}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
 
-		var oldQuantity int64
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
 
-		if err = c.db.QueryRow(ctx, sqlGet, args...).Scan(&oldQuantity); err != nil {
-			if errors.Is(err, pgx.ErrNoRows) {
-				if quantityInventory < data.Quantity {
-					return status.Error(codes.Canceled, "Quantity of product is not enough")
-				}
+	var cartID int64
 
-				// insert new record
-				sqlInsert, args, err := squirrel.Insert("cart_items").Columns("cart_id", "product_id",
-					"quantity", "product_variant_id").
-					Values(cartID, data.ProductId, data.Quantity, data.ProductVariantId).
-					PlaceholderFormat(squirrel.Dollar).
-					ToSql()
+	if err = tx.QueryRow(ctx, selectCartID, args...).Scan(&cartID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.NotFound, err.Error())
+		}
 
-				if err != nil {
-					span.RecordError(err)
-					return status.Error(codes.Internal, err.Error())
-				}
+		return status.Error(codes.Internal, err.Error())
+	}
 
-				if err = c.db.Exec(ctx, sqlInsert, args...); err != nil {
-					span.RecordError(err)
-					return status.Error(codes.Internal, err.Error())
-				}
+	// check exists of prod variant in cart_items
+	sqlGet, args, err := squirrel.Select("quantity").From("cart_items").
+		Where(squirrel.Eq{"cart_id": cartID}).
+		Where(squirrel.Eq{"product_variant_id": data.ProductVariantId}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
 
-				return nil
+	var oldQuantity int64
+
+	if err = tx.QueryRow(ctx, sqlGet, args...).Scan(&oldQuantity); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			if quantityInventory < data.Quantity {
+				return status.Error(codes.Canceled, "Quantity of product is not enough")
 			}
 
-			span.RecordError(err)
-			return status.Error(codes.Internal, err.Error())
-		}
+			// insert new record
+			sqlInsert, args, err := squirrel.Insert("cart_items").Columns("cart_id", "product_id",
+				"quantity", "product_variant_id", "last_seen_price", "last_seen_currency").
+				Values(cartID, data.ProductId, data.Quantity, data.ProductVariantId, price.Price, price.Currency).
+				PlaceholderFormat(squirrel.Dollar).
+				ToSql()
 
-		if quantityInventory < data.Quantity+oldQuantity {
-			return status.Error(codes.Canceled, "Quantity of product is not enough")
-		}
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
 
-		// update previous cart if exists record in cart items
-		sqlUpdate, args, err := squirrel.Update("cart_items").Set("quantity", oldQuantity+data.Quantity).
-			Where(squirrel.Eq{"cart_id": cartID}).
-			Where(squirrel.Eq{"product_variant_id": data.ProductVariantId}).
-			PlaceholderFormat(squirrel.Dollar).ToSql()
+			if err = tx.Exec(ctx, sqlInsert, args...); err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
 
-		if err != nil {
-			span.RecordError(err)
-			return status.Error(codes.Internal, err.Error())
+			return nil
 		}
 
-		if err = c.db.Exec(ctx, sqlUpdate, args...); err != nil {
-			span.RecordError(err)
-			return status.Error(codes.Internal, err.Error())
-		}
+		return status.Error(codes.Internal, err.Error())
+	}
 
-		return nil
-	})
+	if quantityInventory < data.Quantity+oldQuantity {
+		return status.Error(codes.Canceled, "Quantity of product is not enough")
+	}
+
+	// update previous cart if exists record in cart items
+	sqlUpdate, args, err := squirrel.Update("cart_items").Set("quantity", oldQuantity+data.Quantity).
+		Set("last_seen_price", price.Price).
+		Set("last_seen_currency", price.Currency).
+		Where(squirrel.Eq{"cart_id": cartID}).
+		Where(squirrel.Eq{"product_variant_id": data.ProductVariantId}).
+		PlaceholderFormat(squirrel.Dollar).ToSql()
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if err = tx.Exec(ctx, sqlUpdate, args...); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
 }
 
 func (c *cartRepository) GetCart(ctx context.Context, userID int64) ([]*models.CartItem, error) {
@@ -185,7 +219,8 @@ func (c *cartRepository) GetCart(ctx context.Context, userID int64) ([]*models.C
 	}
 
 	// get all cart items
-	selectCartItem, args, err := squirrel.Select("id", "product_id", "quantity", "product_variant_id").
+	selectCartItem, args, err := squirrel.Select("id", "product_id", "quantity", "product_variant_id",
+		"last_seen_price", "last_seen_currency").
 		From("cart_items").
 		Where(squirrel.Eq{"cart_id": cartID}).
 		PlaceholderFormat(squirrel.Dollar).
@@ -210,7 +245,8 @@ func (c *cartRepository) GetCart(ctx context.Context, userID int64) ([]*models.C
 	for rows.Next() {
 		var cartItem models.CartItem
 
-		if err = rows.Scan(&cartItem.ID, &cartItem.ProductID, &cartItem.Quantity, &cartItem.ProductVariantID); err != nil {
+		if err = rows.Scan(&cartItem.ID, &cartItem.ProductID, &cartItem.Quantity, &cartItem.ProductVariantID,
+			&cartItem.LastSeenPrice, &cartItem.LastSeenCurrency); err != nil {
 			span.RecordError(err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -221,7 +257,7 @@ func (c *cartRepository) GetCart(ctx context.Context, userID int64) ([]*models.C
 	return res, nil
 }
 
-func (c *cartRepository) UpdateCartItem(ctx context.Context, data *order_proto_gen.UpdateCartItemRequest) (*models.CartItem, error) {
+func (c *cartRepository) UpdateCartItem(ctx context.Context, data *order_proto_gen.UpdateCartItemRequest, price dto.UnitPrice) (*models.CartItem, error) {
 	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "UpdateCartItem"))
 	defer span.End()
 
@@ -297,9 +333,9 @@ func (c *cartRepository) UpdateCartItem(ctx context.Context, data *order_proto_g
 	}
 
 	// update quantity in cart if prod is enough
-	sqlUpdate := `update cart_items set quantity = $1 where id = $2`
+	sqlUpdate := `update cart_items set quantity = $1, last_seen_price = $2, last_seen_currency = $3 where id = $4`
 
-	if err = c.db.Exec(ctx, sqlUpdate, data.Quantity, data.CartItemId); err != nil {
+	if err = c.db.Exec(ctx, sqlUpdate, data.Quantity, price.Price, price.Currency, data.CartItemId); err != nil {
 		span.RecordError(err)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -361,3 +397,108 @@ func (c *cartRepository) CreateCart(ctx context.Context, userID int64) error {
 
 	return nil
 }
+
+func (c *cartRepository) MergeCartItems(ctx context.Context, userID int64, items []models.CartItem, availableQuantities map[string]int64) ([]dto.MergedCartItem, error) {
+	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "MergeCartItems"))
+	defer span.End()
+
+	result := make([]dto.MergedCartItem, 0, len(items))
+
+	err := c.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		// cart row is locked, so concurrent merges and adds of same user are applied one by one
+		var cartID int64
+
+		if err := tx.QueryRow(ctx, `select id from carts where user_id = $1 for update`, userID).Scan(&cartID); err != nil {
+			span.RecordError(err)
+
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.NotFound, "Cart not found")
+			}
+
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		rows, err := tx.Query(ctx, `select product_variant_id, quantity from cart_items where cart_id = $1`, cartID)
+
+		if err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		cartQuantities := make(map[string]int64)
+
+		for rows.Next() {
+			var productVariantID string
+			var quantity int64
+
+			if err = rows.Scan(&productVariantID, &quantity); err != nil {
+				rows.Close()
+				span.RecordError(err)
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			cartQuantities[productVariantID] = quantity
+		}
+
+		rows.Close()
+
+		for _, item := range items {
+			oldQuantity, inCart := cartQuantities[item.ProductVariantID]
+			// quantity already in cart is kept even when stock is lower now, only guest quantity is capped
+			added := min(item.Quantity, max(availableQuantities[item.ProductVariantID]-oldQuantity, 0))
+			merged := dto.MergedCartItem{
+				ProductVariantID: item.ProductVariantID,
+				Quantity:         oldQuantity + added,
+				Capped:           added < item.Quantity,
+			}
+
+			switch {
+			case added == 0:
+			case inCart:
+				if err = tx.Exec(ctx, `update cart_items set quantity = $1, last_seen_price = $2, last_seen_currency = $3
+					where cart_id = $4 and product_variant_id = $5`,
+					merged.Quantity, item.LastSeenPrice, item.LastSeenCurrency, cartID, item.ProductVariantID); err != nil {
+					span.RecordError(err)
+					return status.Error(codes.Internal, err.Error())
+				}
+			default:
+				if err = tx.Exec(ctx, `insert into cart_items (cart_id, product_id, quantity, product_variant_id, last_seen_price, last_seen_currency)
+					values ($1, $2, $3, $4, $5, $6)`,
+					cartID, item.ProductID, merged.Quantity, item.ProductVariantID, item.LastSeenPrice, item.LastSeenCurrency); err != nil {
+					span.RecordError(err)
+					return status.Error(codes.Internal, err.Error())
+				}
+			}
+
+			cartQuantities[item.ProductVariantID] = merged.Quantity
+			result = append(result, merged)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *cartRepository) UpdateLastSeenPrices(ctx context.Context, userID int64, prices []dto.CartItemPrice) error {
+	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "UpdateLastSeenPrices"))
+	defer span.End()
+
+	return c.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		sqlUpdate := `update cart_items set last_seen_price = $1, last_seen_currency = $2
+			where id = $3 and cart_id in (select id from carts where user_id = $4)`
+
+		for _, price := range prices {
+			if err := tx.Exec(ctx, sqlUpdate, price.Price, price.Currency, price.CartItemID, userID); err != nil {
+				span.RecordError(err)
+				return status.Error(codes.Internal, err.Error())
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+type checkoutIdempotencyRepository struct {
+	tracer pkg.Tracer
+	db     pkg.Database
+}
+
+func NewCheckoutIdempotencyRepository(tracer pkg.Tracer, db pkg.Database) ICheckoutIdempotencyRepository {
+	return &checkoutIdempotencyRepository{
+		tracer: tracer,
+		db:     db,
+	}
+}
+
+func (r *checkoutIdempotencyRepository) AcquireCheckoutIdempotencyKey(ctx context.Context, userID int64, idempotencyKey,
+	requestHash string, expiredBefore time.Time, lease time.Duration) (*models.CheckoutIdempotencyKey, bool, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "AcquireCheckoutIdempotencyKey"))
+	defer span.End()
+
+	// expired key and key of checkout whose lease expired without response are taken over by new checkout,
+	// live key is kept as it is and nothing is returned
+	queryAcquire := `insert into checkout_idempotency_keys (user_id, idempotency_key, request_hash, lease_expires_at)
+		values ($1, $2, $3, current_timestamp + make_interval(secs => $5))
+		on conflict (user_id, idempotency_key) do update
+		set request_hash = excluded.request_hash, order_id = null, status = null, payment_url = null,
+			lease_expires_at = excluded.lease_expires_at, created_at = current_timestamp
+		where checkout_idempotency_keys.created_at < $4
+			or (checkout_idempotency_keys.order_id is null and checkout_idempotency_keys.lease_expires_at < current_timestamp)
+		returning user_id, idempotency_key, request_hash, lease_expires_at, created_at, updated_at`
+
+	var key models.CheckoutIdempotencyKey
+
+	err := r.db.QueryRow(ctx, queryAcquire, userID, idempotencyKey, requestHash, expiredBefore, lease.Seconds()).
+		Scan(&key.UserID, &key.IdempotencyKey, &key.RequestHash, &key.LeaseExpiresAt, &key.CreatedAt, &key.UpdatedAt)
+
+	if err == nil {
+		return &key, true, nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		span.RecordError(err)
+		return nil, false, status.Error(codes.Internal, err.Error())
+	}
+
+	query, args, err := squirrel.Select("user_id", "idempotency_key", "request_hash", "order_id", "status",
+		"payment_url", "lease_expires_at", "created_at", "updated_at").
+		From("checkout_idempotency_keys").
+		Where(squirrel.Eq{"user_id": userID, "idempotency_key": idempotencyKey}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, false, status.Error(codes.Internal, err.Error())
+	}
+
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&key.UserID, &key.IdempotencyKey, &key.RequestHash,
+		&key.OrderID, &key.Status, &key.PaymentURL, &key.LeaseExpiresAt, &key.CreatedAt, &key.UpdatedAt); err != nil {
+		span.RecordError(err)
+
+		// key was released by first checkout between two queries, so client can retry
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, false, status.Error(codes.Aborted, "Checkout with this idempotency key was just released, please try again")
+		}
+
+		return nil, false, status.Error(codes.Internal, err.Error())
+	}
+
+	return &key, false, nil
+}
+
+func (r *checkoutIdempotencyRepository) SaveCheckoutIdempotencyResponse(ctx context.Context, userID int64, idempotencyKey string,
+	leaseExpiresAt time.Time, orderID, orderStatus string, paymentURL *string) error {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "SaveCheckoutIdempotencyResponse"))
+	defer span.End()
+
+	query, args, err := squirrel.Update("checkout_idempotency_keys").
+		Set("order_id", orderID).
+		Set("status", orderStatus).
+		Set("payment_url", paymentURL).
+		Set("lease_expires_at", nil).
+		Where(squirrel.Eq{"user_id": userID, "idempotency_key": idempotencyKey, "lease_expires_at": leaseExpiresAt}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if err = r.db.Exec(ctx, query, args...); err != nil {
+		span.RecordError(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func (r *checkoutIdempotencyRepository) ReleaseCheckoutIdempotencyKey(ctx context.Context, userID int64, idempotencyKey string,
+	leaseExpiresAt time.Time) error {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "ReleaseCheckoutIdempotencyKey"))
+	defer span.End()
+
+	// key of finished checkout is kept, only key of failed checkout is released
+	query, args, err := squirrel.Delete("checkout_idempotency_keys").
+		Where(squirrel.Eq{"user_id": userID, "idempotency_key": idempotencyKey, "order_id": nil,
+			"lease_expires_at": leaseExpiresAt}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if err = r.db.Exec(ctx, query, args...); err != nil {
+		span.RecordError(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
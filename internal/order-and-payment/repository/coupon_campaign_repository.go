@@ -0,0 +1,381 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
+	"github.com/TienMinh25/ecommerce-platform/internal/utils"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// couponCampaignCodeLength is number of random characters of each code, prefix is not counted
+	couponCampaignCodeLength = 8
+	// maxGenerateCodeAttempts is how many times codes which collide with existing codes are generated again
+	maxGenerateCodeAttempts = 5
+)
+
+type couponCampaignRepository struct {
+	tracer pkg.Tracer
+	db     pkg.Database
+}
+
+func NewCouponCampaignRepository(tracer pkg.Tracer, db pkg.Database) ICouponCampaignRepository {
+	return &couponCampaignRepository{
+		tracer: tracer,
+		db:     db,
+	}
+}
+
+func (r *couponCampaignRepository) CreateCouponCampaign(ctx context.Context, data *order_proto_gen.CreateCouponCampaignRequest) (string, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "CreateCouponCampaign"))
+	defer span.End()
+
+	var userIDs []int64
+
+	if len(data.UserIds) > 0 {
+		userIDs = data.UserIds
+	}
+
+	query, args, err := squirrel.Insert("coupon_campaigns").
+		Columns("name", "description", "discount_type", "discount_value", "maximum_discount_amount",
+			"minimum_order_amount", "currency", "start_date", "end_date", "scope", "category_id",
+			"code_prefix", "total_codes", "user_ids", "status", "created_by").
+		Values(data.Name, data.Description, data.DiscountType, data.DiscountValue, dto.FromMoneyProto(data.MaximumDiscountAmount),
+			dto.FromMoneyProto(data.MinimumOrderAmount), data.Currency, data.StartDate.AsTime(), data.EndDate.AsTime(), data.Scope, data.CategoryId,
+			data.CodePrefix, data.TotalCodes, userIDs, common.CouponCampaignStatusPending, data.CreatedBy).
+		Suffix("returning id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	var campaignID string
+
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&campaignID); err != nil {
+		span.RecordError(err)
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	return campaignID, nil
+}
+
+func (r *couponCampaignRepository) GetCouponCampaigns(ctx context.Context, data *order_proto_gen.GetCouponCampaignsRequest) ([]models.CouponCampaign, int64, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetCouponCampaigns"))
+	defer span.End()
+
+	queryBuilder := squirrel.Select("cc.id", "cc.name", "cc.discount_type", "cc.discount_value",
+		"cc.maximum_discount_amount", "cc.minimum_order_amount", "cc.currency", "cc.start_date", "cc.end_date",
+		"cc.scope", "cc.category_id", "cc.code_prefix", "cc.total_codes", "cc.generated_codes", "cc.status", "cc.created_at",
+		"(select count(*) from coupons c where c.campaign_id = cc.id and c.usage_count > 0) as used_codes",
+		"(select count(*) from coupons c where c.campaign_id = cc.id and c.usage_count = 0 and c.is_active = false) as revoked_codes").
+		From("coupon_campaigns cc")
+
+	if data.Status != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"cc.status": *data.Status})
+	}
+
+	countQuerySub, countArgs, err := queryBuilder.PlaceholderFormat(squirrel.Dollar).ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	countQuery := fmt.Sprintf("select count(*) from (%s) as filtered_campaigns", countQuerySub)
+
+	var totalItems int64
+
+	if err = r.db.QueryRow(ctx, countQuery, countArgs...).Scan(&totalItems); err != nil {
+		span.RecordError(err)
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	offset := data.Limit * (data.Page - 1)
+
+	query, args, err := queryBuilder.
+		OrderBy("cc.created_at desc").
+		Limit(uint64(data.Limit)).
+		Offset(uint64(offset)).
+		PlaceholderFormat(squirrel.Dollar).ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	defer rows.Close()
+
+	campaigns := make([]models.CouponCampaign, 0)
+
+	for rows.Next() {
+		var campaign models.CouponCampaign
+
+		if err = rows.Scan(&campaign.ID, &campaign.Name, &campaign.DiscountType, &campaign.DiscountValue,
+			&campaign.MaximumDiscountAmount, &campaign.MinimumOrderAmount, &campaign.Currency, &campaign.StartDate, &campaign.EndDate,
+			&campaign.Scope, &campaign.CategoryID, &campaign.CodePrefix, &campaign.TotalCodes, &campaign.GeneratedCodes,
+			&campaign.Status, &campaign.CreatedAt, &campaign.UsedCodes, &campaign.RevokedCodes); err != nil {
+			span.RecordError(err)
+			return nil, 0, status.Error(codes.Internal, err.Error())
+		}
+
+		campaigns = append(campaigns, campaign)
+	}
+
+	return campaigns, totalItems, nil
+}
+
+func (r *couponCampaignRepository) GetCouponCampaignByID(ctx context.Context, campaignID string) (*models.CouponCampaign, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetCouponCampaignByID"))
+	defer span.End()
+
+	selectSql := `select id, name, code_prefix, total_codes, generated_codes, status, created_at
+			from coupon_campaigns where id = $1`
+
+	var campaign models.CouponCampaign
+
+	if err := r.db.QueryRow(ctx, selectSql, campaignID).Scan(&campaign.ID, &campaign.Name, &campaign.CodePrefix,
+		&campaign.TotalCodes, &campaign.GeneratedCodes, &campaign.Status, &campaign.CreatedAt); err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "Coupon campaign not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &campaign, nil
+}
+
+func (r *couponCampaignRepository) GenerateCouponCampaignCodes(ctx context.Context, batchSize int64) (bool, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GenerateCouponCampaignCodes"))
+	defer span.End()
+
+	var isGenerated bool
+
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		// other instances skip campaign which is being generated
+		selectSql := `select id, code_prefix, total_codes, generated_codes, user_ids
+				from coupon_campaigns
+				where status = $1
+				order by created_at asc
+				limit 1
+				for update skip locked`
+
+		var campaign models.CouponCampaign
+
+		if err := tx.QueryRow(ctx, selectSql, common.CouponCampaignStatusPending).Scan(&campaign.ID, &campaign.CodePrefix,
+			&campaign.TotalCodes, &campaign.GeneratedCodes, &campaign.UserIDs); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		batchCodes := min(batchSize, campaign.TotalCodes-campaign.GeneratedCodes)
+
+		// code i of batch goes to buyer at position generated_codes + i of campaign
+		userIDs := make([]*int64, batchCodes)
+
+		if campaign.UserIDs != nil {
+			for idx := range userIDs {
+				userIDs[idx] = &campaign.UserIDs[campaign.GeneratedCodes+int64(idx)]
+			}
+		}
+
+		if err := insertCouponCampaignCodes(ctx, tx, campaign, userIDs); err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		updateSql := `update coupon_campaigns
+				set generated_codes = generated_codes + $2,
+					status = case when generated_codes + $2 >= total_codes then $3 else status end
+				where id = $1`
+
+		if err := tx.Exec(ctx, updateSql, campaign.ID, batchCodes, common.CouponCampaignStatusCompleted); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		isGenerated = true
+
+		return nil
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	return isGenerated, nil
+}
+
+// insertCouponCampaignCodes saves one single-use coupon for each item of userIDs (nil item is code which is not assigned).
+// Codes which collide with existing codes are generated again, so every buyer gets exactly one code
+func insertCouponCampaignCodes(ctx context.Context, tx pkg.Tx, campaign models.CouponCampaign, userIDs []*int64) error {
+	insertSql := `insert into coupons (code, name, description, discount_type, discount_value, maximum_discount_amount,
+				minimum_order_amount, currency, start_date, end_date, usage_limit, usage_limit_per_user, scope, category_id,
+				campaign_id, user_id)
+			select codes.code, cc.name, cc.description, cc.discount_type, cc.discount_value, cc.maximum_discount_amount,
+				cc.minimum_order_amount, cc.currency, cc.start_date, cc.end_date, 1, 1, cc.scope, cc.category_id,
+				cc.id, codes.user_id
+			from unnest($2::text[], $3::bigint[]) with ordinality as codes(code, user_id, position)
+			cross join coupon_campaigns cc
+			where cc.id = $1
+			order by codes.position
+			on conflict (code) do nothing
+			returning code`
+
+	for attempt := 0; attempt < maxGenerateCodeAttempts && len(userIDs) > 0; attempt++ {
+		generatedCodes := make([]string, len(userIDs))
+
+		for idx := range generatedCodes {
+			code, err := utils.GenerateRandomCouponCode(campaign.CodePrefix, couponCampaignCodeLength)
+
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			generatedCodes[idx] = code
+		}
+
+		rows, err := tx.Query(ctx, insertSql, campaign.ID, generatedCodes, userIDs)
+
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		insertedCodes := make(map[string]bool, len(generatedCodes))
+
+		for rows.Next() {
+			var code string
+
+			if err = rows.Scan(&code); err != nil {
+				rows.Close()
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			insertedCodes[code] = true
+		}
+
+		rows.Close()
+
+		// keep buyers whose code collided, they get new code in next attempt
+		collidedUserIDs := make([]*int64, 0)
+
+		for idx, code := range generatedCodes {
+			if !insertedCodes[code] {
+				collidedUserIDs = append(collidedUserIDs, userIDs[idx])
+			}
+		}
+
+		userIDs = collidedUserIDs
+	}
+
+	if len(userIDs) > 0 {
+		return status.Errorf(codes.Internal, "Can not generate %d unique codes for campaign %s", len(userIDs), campaign.ID)
+	}
+
+	return nil
+}
+
+func (r *couponCampaignRepository) GetCouponCampaignCodes(ctx context.Context, campaignID string) ([]models.Coupon, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetCouponCampaignCodes"))
+	defer span.End()
+
+	selectSql := `select id, code, user_id, usage_count, is_active, created_at
+			from coupons
+			where campaign_id = $1
+			order by created_at asc, code asc`
+
+	rows, err := r.db.Query(ctx, selectSql, campaignID)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	defer rows.Close()
+
+	coupons := make([]models.Coupon, 0)
+
+	for rows.Next() {
+		var coupon models.Coupon
+
+		if err = rows.Scan(&coupon.ID, &coupon.Code, &coupon.UserID, &coupon.UsageCount, &coupon.IsActive,
+			&coupon.CreatedAt); err != nil {
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		coupons = append(coupons, coupon)
+	}
+
+	return coupons, nil
+}
+
+func (r *couponCampaignRepository) RevokeCouponCampaignCodes(ctx context.Context, campaignID string, couponIDs []string) (int64, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "RevokeCouponCampaignCodes"))
+	defer span.End()
+
+	var exists bool
+
+	if err := r.db.QueryRow(ctx, `select exists(select 1 from coupon_campaigns where id = $1)`, campaignID).Scan(&exists); err != nil {
+		span.RecordError(err)
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+
+	if !exists {
+		return 0, status.Error(codes.NotFound, "Coupon campaign not found")
+	}
+
+	// used codes are kept, so orders still show which code they used
+	updateBuilder := squirrel.Update("coupons").
+		Set("is_active", false).
+		Where(squirrel.Eq{"campaign_id": campaignID}).
+		Where(squirrel.Eq{"usage_count": 0}).
+		Where(squirrel.Eq{"is_active": true})
+
+	if len(couponIDs) > 0 {
+		updateBuilder = updateBuilder.Where(squirrel.Eq{"id": couponIDs})
+	}
+
+	updateSub, args, err := updateBuilder.Suffix("returning id").PlaceholderFormat(squirrel.Dollar).ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+
+	var revokedCodes int64
+
+	if err = r.db.QueryRow(ctx, fmt.Sprintf("with revoked as (%s) select count(*) from revoked", updateSub), args...).
+		Scan(&revokedCodes); err != nil {
+		span.RecordError(err)
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return revokedCodes, nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"github.com/Masterminds/squirrel"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
 	"github.com/TienMinh25/ecommerce-platform/internal/utils"
 	"github.com/TienMinh25/ecommerce-platform/pkg"
 	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
@@ -13,6 +15,8 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"maps"
+	"slices"
 )
 
 type couponRepository struct {
@@ -33,7 +37,7 @@ func (repo *couponRepository) GetCoupons(ctx context.Context, data *order_proto_
 
 	queryBuilder := squirrel.Select("id", "code", "name", "discount_type", "discount_value",
 		"start_date", "end_date", "minimum_order_amount", "maximum_discount_amount",
-		"usage_count", "usage_limit", "currency", "is_active").From("coupons")
+		"usage_count", "usage_limit", "currency", "is_active", "scope", "category_id", "usage_limit_per_user", "user_id").From("coupons")
 
 	if data.Code != nil {
 		queryBuilder = queryBuilder.Where(squirrel.Eq{"code": *data.Code})
@@ -47,6 +51,17 @@ func (repo *couponRepository) GetCoupons(ctx context.Context, data *order_proto_
 		queryBuilder = queryBuilder.Where(squirrel.Eq{"discount_type": *data.DiscountType})
 	}
 
+	if data.Scope != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"scope": *data.Scope})
+	}
+
+	// codes of campaigns are listed by campaign only, so they do not flood list of coupons
+	if data.CampaignId != nil {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"campaign_id": *data.CampaignId})
+	} else {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"campaign_id": nil})
+	}
+
 	if data.StartDate != nil && data.EndDate != nil {
 		queryBuilder = queryBuilder.Where(squirrel.And{
 			squirrel.LtOrEq{"start_date": data.StartDate.AsTime()},
@@ -101,7 +116,8 @@ func (repo *couponRepository) GetCoupons(ctx context.Context, data *order_proto_
 
 		if err = rows.Scan(&coupon.ID, &coupon.Code, &coupon.Name, &coupon.DiscountType, &coupon.DiscountValue,
 			&coupon.StartDate, &coupon.EndDate, &coupon.MinimumOrderAmount, &coupon.MaximumDiscountAmount,
-			&coupon.UsageLimit, &coupon.UsageCount, &coupon.Currency, &coupon.IsActive); err != nil {
+			&coupon.UsageLimit, &coupon.UsageCount, &coupon.Currency, &coupon.IsActive, &coupon.Scope, &coupon.CategoryID,
+			&coupon.UsageLimitPerUser, &coupon.UserID); err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
 		}
 
@@ -118,7 +134,7 @@ func (repo *couponRepository) GetDetailCouponByID(ctx context.Context, id string
 	query, args, err := squirrel.Select("id", "code", "name", "description", "discount_type",
 		"discount_value", "maximum_discount_amount", "minimum_order_amount", "currency",
 		"usage_limit", "usage_count", "is_active", "start_date", "end_date",
-		"created_at", "updated_at").From("coupons").
+		"created_at", "updated_at", "scope", "category_id", "usage_limit_per_user").From("coupons").
 		Where(squirrel.Eq{"id": id}).
 		PlaceholderFormat(squirrel.Dollar).ToSql()
 
@@ -131,7 +147,7 @@ func (repo *couponRepository) GetDetailCouponByID(ctx context.Context, id string
 	if err = repo.db.QueryRow(ctx, query, args...).Scan(&coupon.ID, &coupon.Code, &coupon.Name, &coupon.Description,
 		&coupon.DiscountType, &coupon.DiscountValue, &coupon.MaximumDiscountAmount, &coupon.MinimumOrderAmount, &coupon.Currency,
 		&coupon.UsageLimit, &coupon.UsageCount, &coupon.IsActive, &coupon.StartDate, &coupon.EndDate,
-		&coupon.CreatedAt, &coupon.UpdatedAt); err != nil {
+		&coupon.CreatedAt, &coupon.UpdatedAt, &coupon.Scope, &coupon.CategoryID, &coupon.UsageLimitPerUser); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
@@ -151,13 +167,17 @@ func (repo *couponRepository) UpdateCoupon(ctx context.Context, data *order_prot
 	changedField["name"] = data.Name
 	changedField["description"] = data.Description
 	changedField["discount_type"] = data.DiscountType
+	// discount value is decimal text which is checked by service, postgres reads it into numeric exactly
 	changedField["discount_value"] = data.DiscountValue
-	changedField["maximum_discount_amount"] = data.MaximumDiscountAmount
-	changedField["minimum_order_amount"] = data.MinimumOrderAmount
+	changedField["maximum_discount_amount"] = dto.FromMoneyProto(data.MaximumDiscountAmount)
+	changedField["minimum_order_amount"] = dto.FromMoneyProto(data.MinimumOrderAmount)
 	changedField["start_date"] = data.StartDate.AsTime()
 	changedField["end_date"] = data.EndDate.AsTime()
 	changedField["is_active"] = data.IsActive
 	changedField["usage_limit"] = data.UsageLimit
+	changedField["scope"] = data.Scope
+	changedField["category_id"] = data.CategoryId
+	changedField["usage_limit_per_user"] = data.UsageLimitPerUser
 
 	queryUpdate, args, err := squirrel.Update("coupons").
 		SetMap(changedField).
@@ -199,12 +219,20 @@ func (repo *couponRepository) GetCouponsByClient(ctx context.Context, data *orde
 
 	queryBuilder := squirrel.Select("id", "code", "name", "discount_type", "discount_value",
 		"start_date", "end_date", "minimum_order_amount", "maximum_discount_amount",
-		"usage_count", "usage_limit", "currency", "is_active").From("coupons").
+		"usage_count", "usage_limit", "currency", "is_active", "scope", "category_id", "usage_limit_per_user", "user_id").From("coupons").
 		Where(squirrel.And{
 			squirrel.LtOrEq{"start_date": data.CurrentDate.AsTime()},
 			squirrel.GtOrEq{"end_date": data.CurrentDate.AsTime()},
 		}).
-		Where(squirrel.Eq{"is_active": true})
+		Where(squirrel.Eq{"is_active": true}).
+		// public coupons and unused codes of campaigns which are assigned to buyer
+		Where(squirrel.Or{
+			squirrel.Eq{"campaign_id": nil},
+			squirrel.And{
+				squirrel.Eq{"user_id": data.UserId},
+				squirrel.Expr("usage_count < usage_limit"),
+			},
+		})
 
 	countQueryBuilder := queryBuilder
 
@@ -249,7 +277,8 @@ func (repo *couponRepository) GetCouponsByClient(ctx context.Context, data *orde
 
 		if err = rows.Scan(&coupon.ID, &coupon.Code, &coupon.Name, &coupon.DiscountType, &coupon.DiscountValue,
 			&coupon.StartDate, &coupon.EndDate, &coupon.MinimumOrderAmount, &coupon.MaximumDiscountAmount,
-			&coupon.UsageLimit, &coupon.UsageCount, &coupon.Currency, &coupon.IsActive); err != nil {
+			&coupon.UsageLimit, &coupon.UsageCount, &coupon.Currency, &coupon.IsActive, &coupon.Scope, &coupon.CategoryID,
+			&coupon.UsageLimitPerUser, &coupon.UserID); err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
 		}
 
@@ -268,7 +297,7 @@ func (repo *couponRepository) CreateCoupon(ctx context.Context, data *order_prot
 		Columns(
 			"code", "name", "description", "discount_type", "discount_value",
 			"maximum_discount_amount", "minimum_order_amount", "currency",
-			"start_date", "end_date", "usage_limit",
+			"start_date", "end_date", "usage_limit", "scope", "category_id", "usage_limit_per_user",
 		).
 		Values(
 			utils.GenerateCouponCodeWithMillis(),
@@ -276,12 +305,15 @@ func (repo *couponRepository) CreateCoupon(ctx context.Context, data *order_prot
 			data.Description,
 			data.DiscountType,
 			data.DiscountValue,
-			data.MaximumDiscountAmount,
-			data.MinimumOrderAmount,
+			dto.FromMoneyProto(data.MaximumDiscountAmount),
+			dto.FromMoneyProto(data.MinimumOrderAmount),
 			data.Currency,
 			data.StartDate.AsTime(),
 			data.EndDate.AsTime(),
 			data.UsageLimit,
+			data.Scope,
+			data.CategoryId,
+			data.UsageLimitPerUser,
 		).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
@@ -296,3 +328,86 @@ func (repo *couponRepository) CreateCoupon(ctx context.Context, data *order_prot
 
 	return nil
 }
+
+// redeemCoupons takes usage of coupons used by order and saves each use into coupon_redemptions.
+// usage_count is increased by conditional update, so concurrent checkouts can not go over usage_limit.
+// Row of coupon stays locked until transaction ends, so uses of buyer are counted one checkout at a time
+func redeemCoupons(ctx context.Context, tx pkg.Tx, orderID string, userID int64, couponUsageCount map[string]int64) error {
+	// lock coupons in the same order, so two checkouts with the same coupons do not deadlock
+	couponIDs := slices.Sorted(maps.Keys(couponUsageCount))
+
+	for _, couponID := range couponIDs {
+		usageCount := couponUsageCount[couponID]
+
+		// code may be revoked after it was validated, so is_active is checked again
+		redeemSql := `update coupons set usage_count = usage_count + $2
+				where id = $1 and is_active = true and (usage_limit is null or usage_count + $2 <= usage_limit)
+				returning usage_limit_per_user`
+
+		var usageLimitPerUser *int64
+
+		if err := tx.QueryRow(ctx, redeemSql, couponID, usageCount).Scan(&usageLimitPerUser); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Errorf(codes.FailedPrecondition, "Coupon %s usage limit exceeded", couponID)
+			}
+
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if usageLimitPerUser == nil {
+			continue
+		}
+
+		countSql := `select count(*) from coupon_redemptions where coupon_id = $1 and user_id = $2 and status = $3`
+
+		var userUsageCount int64
+
+		if err := tx.QueryRow(ctx, countSql, couponID, userID, common.CouponRedemptionRedeemed).Scan(&userUsageCount); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if userUsageCount+usageCount > *usageLimitPerUser {
+			return status.Errorf(codes.FailedPrecondition,
+				"Coupon %s usage limit per user exceeded. Used: %d, This order: %d, Limit: %d",
+				couponID, userUsageCount, usageCount, *usageLimitPerUser)
+		}
+	}
+
+	insertSql := `insert into coupon_redemptions (coupon_id, user_id, order_id, order_item_id)
+			select coupon_id, $2, order_id, id from order_items where order_id = $1 and coupon_id is not null
+			union all
+			select coupon_id, $2, id, null from orders where id = $1 and coupon_id is not null`
+
+	if err := tx.Exec(ctx, insertSql, orderID, userID); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+// reverseCouponRedemptions gives back coupon usage of order items which are cancelled or failed payment.
+// Use of coupon of whole order is given back only when no order item of order is left alive,
+// so status of order items must be changed before calling it
+func reverseCouponRedemptions(ctx context.Context, tx pkg.Tx, orderID string, orderItemIDs []string) error {
+	reverseSql := `with reversed as (
+				update coupon_redemptions cr
+				set status = $3, reversed_at = current_timestamp
+				where cr.order_id = $1 and cr.status = $4
+				and (cr.order_item_id = any($2) or (cr.order_item_id is null and not exists (
+					select 1 from order_items oi
+					where oi.order_id = $1 and oi.status not in ($5, $6)
+				)))
+				returning cr.coupon_id
+			)
+			update coupons c
+			set usage_count = greatest(c.usage_count - used.total, 0)
+			from (select coupon_id, count(*) as total from reversed group by coupon_id) as used
+			where c.id = used.coupon_id`
+
+	if err := tx.Exec(ctx, reverseSql, orderID, orderItemIDs, common.CouponRedemptionReversed,
+		common.CouponRedemptionRedeemed, common.Cancelled, common.PaymentFailed); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
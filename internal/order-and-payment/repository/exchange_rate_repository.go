@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+)
+
+type exchangeRateRepository struct {
+	tracer pkg.Tracer
+	db     pkg.Database
+}
+
+func NewExchangeRateRepository(tracer pkg.Tracer, db pkg.Database) IExchangeRateRepository {
+	return &exchangeRateRepository{
+		tracer: tracer,
+		db:     db,
+	}
+}
+
+func (r *exchangeRateRepository) GetExchangeRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetExchangeRates"))
+	defer span.End()
+
+	query, args, err := squirrel.Select("currency", "rate", "created_at", "updated_at").
+		From("exchange_rates").
+		OrderBy("currency asc").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	defer rows.Close()
+
+	exchangeRates := make([]models.ExchangeRate, 0)
+
+	for rows.Next() {
+		var exchangeRate models.ExchangeRate
+
+		if err = rows.Scan(&exchangeRate.Currency, &exchangeRate.Rate, &exchangeRate.CreatedAt,
+			&exchangeRate.UpdatedAt); err != nil {
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		exchangeRates = append(exchangeRates, exchangeRate)
+	}
+
+	return exchangeRates, nil
+}
+
+func (r *exchangeRateRepository) UpsertExchangeRates(ctx context.Context, exchangeRates []models.ExchangeRate) error {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "UpsertExchangeRates"))
+	defer span.End()
+
+	// rows are locked in order of currency, so concurrent updates do not deadlock
+	sort.Slice(exchangeRates, func(i, j int) bool {
+		return exchangeRates[i].Currency < exchangeRates[j].Currency
+	})
+
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		upsertSql := `insert into exchange_rates (currency, rate) values ($1, $2)
+			on conflict (currency) do update set rate = excluded.rate`
+
+		for _, exchangeRate := range exchangeRates {
+			if err := tx.Exec(ctx, upsertSql, exchangeRate.Currency, exchangeRate.Rate); err != nil {
+				span.RecordError(err)
+				return status.Error(codes.Internal, err.Error())
+			}
+		}
+
+		return nil
+	})
+}
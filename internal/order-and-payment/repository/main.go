@@ -3,17 +3,38 @@ package repository
 import (
 	"context"
 	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
+	"time"
 )
 
 type ICartRepository interface {
-	AddItemToCart(ctx context.Context, data *order_proto_gen.AddItemToCartRequest) error
+	// AddItemToCart adds item into cart, price is saved as last price seen by buyer
+	AddItemToCart(ctx context.Context, data *order_proto_gen.AddItemToCartRequest, price dto.UnitPrice) error
+	// MoveWishlistItemToCart adds item into cart and deletes wishlist item in one transaction
+	MoveWishlistItemToCart(ctx context.Context, data *order_proto_gen.AddItemToCartRequest, price dto.UnitPrice, wishlistItemID string) error
 	GetCart(ctx context.Context, userID int64) ([]*models.CartItem, error)
-	UpdateCartItem(ctx context.Context, data *order_proto_gen.UpdateCartItemRequest) (*models.CartItem, error)
+	// UpdateCartItem changes quantity of cart item, price is saved as last price seen by buyer
+	UpdateCartItem(ctx context.Context, data *order_proto_gen.UpdateCartItemRequest, price dto.UnitPrice) (*models.CartItem, error)
 	DeleteCartItem(ctx context.Context, cartItemIds []string, userID int64) error
 	CreateCart(ctx context.Context, userID int64) error
+	// MergeCartItems adds items into cart of user, quantities are summed and added quantity is capped at available quantity.
+	// Last seen price of item is saved for every row which is inserted or whose quantity changes
+	MergeCartItems(ctx context.Context, userID int64, items []models.CartItem, availableQuantities map[string]int64) ([]dto.MergedCartItem, error)
+	// UpdateLastSeenPrices saves prices as last prices seen by buyer, items of other users are not updated
+	UpdateLastSeenPrices(ctx context.Context, userID int64, prices []dto.CartItemPrice) error
+}
+
+type IWishlistRepository interface {
+	// AddWishlistItem returns id of item, item which is already in wishlist is kept with its saved price
+	AddWishlistItem(ctx context.Context, userID int64, item models.WishlistItem) (string, error)
+	GetWishlistItems(ctx context.Context, userID int64) ([]models.WishlistItem, error)
+	GetWishlistItem(ctx context.Context, userID int64, wishlistItemID string) (*models.WishlistItem, error)
+	DeleteWishlistItems(ctx context.Context, userID int64, wishlistItemIDs []string) error
+	// MoveCartItemToWishlist saves item into wishlist and deletes cart item in one transaction
+	MoveCartItemToWishlist(ctx context.Context, userID int64, cartItemID string, item models.WishlistItem) (string, error)
 }
 
 type ICouponRepository interface {
@@ -25,16 +46,107 @@ type ICouponRepository interface {
 	CreateCoupon(ctx context.Context, data *order_proto_gen.CreateCouponRequest) error
 }
 
+type ICouponCampaignRepository interface {
+	CreateCouponCampaign(ctx context.Context, data *order_proto_gen.CreateCouponCampaignRequest) (string, error)
+	GetCouponCampaigns(ctx context.Context, data *order_proto_gen.GetCouponCampaignsRequest) ([]models.CouponCampaign, int64, error)
+	GetCouponCampaignByID(ctx context.Context, campaignID string) (*models.CouponCampaign, error)
+	// GenerateCouponCampaignCodes generates next batch of codes of one pending campaign, it returns false when no campaign is pending
+	GenerateCouponCampaignCodes(ctx context.Context, batchSize int64) (bool, error)
+	GetCouponCampaignCodes(ctx context.Context, campaignID string) ([]models.Coupon, error)
+	RevokeCouponCampaignCodes(ctx context.Context, campaignID string, couponIDs []string) (int64, error)
+}
+
+type IShippingRateRepository interface {
+	// GetShippingRates returns rates of shipping classes, all rates are returned when shippingClasses is empty
+	GetShippingRates(ctx context.Context, shippingClasses []string) ([]models.ShippingRate, error)
+	CreateShippingRate(ctx context.Context, data *order_proto_gen.CreateShippingRateRequest) (int64, error)
+	UpdateShippingRate(ctx context.Context, data *order_proto_gen.UpdateShippingRateRequest) error
+	DeleteShippingRate(ctx context.Context, id int64) error
+}
+
+type IExchangeRateRepository interface {
+	GetExchangeRates(ctx context.Context) ([]models.ExchangeRate, error)
+	// UpsertExchangeRates sets rates of currencies in one transaction, rates of other currencies are kept
+	UpsertExchangeRates(ctx context.Context, exchangeRates []models.ExchangeRate) error
+}
+
+type IOutboxRepository interface {
+	// RelayOutboxEvents publishes events which are not published yet in order of id, events of order whose
+	// earlier event failed to be published are kept for next time. It returns number of published events
+	RelayOutboxEvents(ctx context.Context, limit int64, publish func(ctx context.Context, event models.OutboxEvent) error) (int, error)
+	DeletePublishedOutboxEvents(ctx context.Context, publishedBefore time.Time) error
+}
+
+type ISagaRepository interface {
+	CreateSaga(ctx context.Context, sagaType common.SagaType, state []byte) (*models.Saga, error)
+	GetSaga(ctx context.Context, sagaID string) (*models.Saga, error)
+	// UpdateSaga saves status, current step, state, error and next attempt of saga
+	UpdateSaga(ctx context.Context, saga *models.Saga) error
+	// ClaimStaleSagas returns unfinished sagas which are not updated since staleBefore and due for next attempt,
+	// updated_at of claimed sagas is touched so other instances do not claim them at the same time
+	ClaimStaleSagas(ctx context.Context, staleBefore time.Time, limit int64) ([]models.Saga, error)
+}
+
+type ICheckoutIdempotencyRepository interface {
+	// AcquireCheckoutIdempotencyKey saves key for checkout with lease and returns it with true. When key is used by
+	// other checkout which is not expired and whose lease is not expired, that checkout is returned with false
+	AcquireCheckoutIdempotencyKey(ctx context.Context, userID int64, idempotencyKey, requestHash string,
+		expiredBefore time.Time, lease time.Duration) (*models.CheckoutIdempotencyKey, bool, error)
+	// SaveCheckoutIdempotencyResponse and ReleaseCheckoutIdempotencyKey only change key which still has lease
+	// leaseExpiresAt, so checkout whose key was taken over does not change key of checkout which took it over
+	SaveCheckoutIdempotencyResponse(ctx context.Context, userID int64, idempotencyKey string, leaseExpiresAt time.Time,
+		orderID, orderStatus string, paymentURL *string) error
+	ReleaseCheckoutIdempotencyKey(ctx context.Context, userID int64, idempotencyKey string, leaseExpiresAt time.Time) error
+}
+
 type IPaymentRepository interface {
 	GetPaymentMethods(ctx context.Context) ([]*models.PaymentMethod, error)
-	CreateOrder(ctx context.Context, order dto.CheckoutRequest) (string, common.StatusOrder, float64, error)
-	UpdateOrderStatusFromMomo(ctx context.Context, data *order_proto_gen.UpdateOrderStatusFromMomoRequest) error
+	CreateOrder(ctx context.Context, order dto.CheckoutRequest) (string, common.StatusOrder, money.Money, error)
+	QuoteOrder(ctx context.Context, data dto.CheckoutRequest) (dto.OrderQuote, error)
+	SavePaymentAttempt(ctx context.Context, orderID string, gateway common.MethodType, paymentStatus common.PaymentStatus,
+		gatewayResponse []byte, errorMessage *string) error
+	// UpdateOrderPaymentResult saves result of payment of order, error is NotFound when order does not exist,
+	// InvalidArgument when amount is not match and AlreadyExists when payment of order was confirmed before.
+	// It returns ids of order item stock sagas which keep or release reservations of order items
+	UpdateOrderPaymentResult(ctx context.Context, methodCode common.MethodType, data dto.PaymentResult,
+		nextStatus common.StatusOrder, paymentStatus common.PaymentStatus) ([]string, error)
+	GetExpiredUnpaidOrders(ctx context.Context, createdBefore time.Time, limit int64) ([]models.Order, error)
+	// ExpireUnpaidOrder returns number of expired order items and ids of order item stock sagas which release them
+	ExpireUnpaidOrder(ctx context.Context, orderID string, nextStatus common.StatusOrder, reason string) (int, []string, error)
+	// CancelCheckoutOrder cancels items of order created by checkout saga which are not paid or confirmed yet,
+	// nothing is done when saga did not create order
+	CancelCheckoutOrder(ctx context.Context, sagaID string, reason string) error
 }
 
 type IOrderRepository interface {
 	GetMyOrders(ctx context.Context, data *order_proto_gen.GetMyOrdersRequest) ([]models.OrderItem, int64, error)
 	GetSupplierOrders(ctx context.Context, data *order_proto_gen.GetSupplierOrdersRequest, supplierID int64) ([]models.OrderItem, int64, error)
-	UpdateOrderItem(ctx context.Context, data *order_proto_gen.UpdateOrderItemRequest) error
+	// GetOrderItemsOfOrder returns items of order of user, it returns NotFound when user has no such order
+	GetOrderItemsOfOrder(ctx context.Context, orderID string, userID int64) ([]models.OrderItem, error)
+	// GetSupplierOrderItem returns order item of supplier, it returns NotFound when supplier has no such order item
+	GetSupplierOrderItem(ctx context.Context, supplierID int64, orderItemID string) (*models.OrderItem, error)
+	// UpdateOrderItem and CancelOrderItem return id of order item stock saga which changes stock after status
+	// change is committed, it is empty when stock is not changed. Stock of confirmed order item is taken
+	// by caller before status is changed
+	UpdateOrderItem(ctx context.Context, supplierID int64, data *order_proto_gen.UpdateOrderItemRequest) (string, error)
+	CancelOrderItem(ctx context.Context, data *order_proto_gen.CancelOrderItemRequest) (string, error)
+	GetOrderItemTimeline(ctx context.Context, data *order_proto_gen.GetOrderItemTimelineRequest) ([]models.OrderItemHistory, *models.OrderDeliverer, error)
+}
+
+type IRefundRepository interface {
+	CreateRefund(ctx context.Context, paymentHistoryID string, amount money.Money, processedBy int64) (*models.PaymentRefund, error)
+	StartProcessingRefund(ctx context.Context, refundID string) (*models.PaymentRefund, *models.PaymentHistory, *models.Order, error)
+	CompleteRefund(ctx context.Context, refundID, transactionID string, gatewayResponse []byte) (*models.PaymentRefund, error)
+	FailRefund(ctx context.Context, refundID string, gatewayResponse []byte) (*models.PaymentRefund, error)
+	GetRefunds(ctx context.Context, paymentHistoryID string) ([]models.PaymentRefund, error)
+}
+
+type IUserPaymentMethodRepository interface {
+	GetUserPaymentMethods(ctx context.Context, userID int64) ([]models.UserPaymentMethod, error)
+	GetUserPaymentMethodByID(ctx context.Context, userID, userPaymentMethodID int64) (*models.UserPaymentMethod, error)
+	CreateUserPaymentMethod(ctx context.Context, data models.UserPaymentMethod) (*models.UserPaymentMethod, error)
+	SetDefaultUserPaymentMethod(ctx context.Context, userID, userPaymentMethodID int64) error
+	DeleteUserPaymentMethod(ctx context.Context, userID, userPaymentMethodID int64) error
 }
 
 type IDelivererRepository interface {
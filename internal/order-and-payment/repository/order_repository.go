@@ -7,10 +7,12 @@ import (
 	"github.com/TienMinh25/ecommerce-platform/internal/common"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
 	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/pkg"
 	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
 	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"sync"
@@ -44,7 +46,7 @@ func (r *orderRepository) GetMyOrders(ctx context.Context, data *order_proto_gen
 		"oi.product_variant_name", "oi.quantity", "oi.unit_price", "oi.total_price", "coalesce(oi.discount_amount, 0)",
 		"coalesce(oi.tax_amount, 0)", "oi.shipping_fee", "oi.status", "o.tracking_number", "o.shipping_address", "o.shipping_method",
 		"o.recipient_name", "o.recipient_phone", "oi.estimated_delivery_date", "oi.actual_delivery_date", "oi.notes", "oi.cancelled_reason",
-		"oi.product_variant_image_url", "oi.supplier_id").
+		"oi.product_variant_image_url", "oi.supplier_id", "o.currency").
 		From("order_items oi").
 		InnerJoin("orders o on oi.order_id = o.id").
 		Where(squirrel.Eq{"o.user_id": data.UserId})
@@ -118,7 +120,7 @@ func (r *orderRepository) GetMyOrders(ctx context.Context, data *order_proto_gen
 				&orderItem.ProductVariantName, &orderItem.Quantity, &orderItem.UnitPrice, &orderItem.TotalPrice, &orderItem.DiscountAmount,
 				&orderItem.TaxAmount, &orderItem.ShippingFee, &orderItem.Status, &orderItem.TrackingNumber, &orderItem.ShippingAddress, &orderItem.ShippingMethod,
 				&orderItem.RecipientName, &orderItem.RecipientPhone, &orderItem.EstimatedDeliveryDate, &orderItem.ActualDeliveryDate, &orderItem.Notes, &orderItem.CancelledReason,
-				&orderItem.ProductVariantImageURL, &orderItem.SupplierID); err != nil {
+				&orderItem.ProductVariantImageURL, &orderItem.SupplierID, &orderItem.OrderCurrency); err != nil {
 				span.RecordError(err)
 				err = status.Error(codes.Internal, err.Error())
 				return
@@ -162,7 +164,7 @@ func (r *orderRepository) GetSupplierOrders(ctx context.Context, data *order_pro
 		"oi.product_variant_name", "oi.quantity", "oi.unit_price", "oi.total_price", "coalesce(oi.discount_amount, 0)",
 		"coalesce(oi.tax_amount, 0)", "oi.shipping_fee", "oi.status", "o.tracking_number", "o.shipping_address", "o.shipping_method",
 		"o.recipient_name", "o.recipient_phone", "oi.estimated_delivery_date", "oi.actual_delivery_date", "oi.notes", "oi.cancelled_reason",
-		"oi.product_variant_image_url", "oi.supplier_id").
+		"oi.product_variant_image_url", "oi.supplier_id", "o.currency").
 		From("order_items oi").
 		InnerJoin("orders o on oi.order_id = o.id").
 		Where(squirrel.Eq{"oi.supplier_id": supplierID}).
@@ -232,7 +234,7 @@ func (r *orderRepository) GetSupplierOrders(ctx context.Context, data *order_pro
 				&orderItem.ProductVariantName, &orderItem.Quantity, &orderItem.UnitPrice, &orderItem.TotalPrice, &orderItem.DiscountAmount,
 				&orderItem.TaxAmount, &orderItem.ShippingFee, &orderItem.Status, &orderItem.TrackingNumber, &orderItem.ShippingAddress, &orderItem.ShippingMethod,
 				&orderItem.RecipientName, &orderItem.RecipientPhone, &orderItem.EstimatedDeliveryDate, &orderItem.ActualDeliveryDate, &orderItem.Notes, &orderItem.CancelledReason,
-				&orderItem.ProductVariantImageURL, &orderItem.SupplierID); err != nil {
+				&orderItem.ProductVariantImageURL, &orderItem.SupplierID, &orderItem.OrderCurrency); err != nil {
 				span.RecordError(err)
 				err = status.Error(codes.Internal, err.Error())
 				return
@@ -251,45 +253,377 @@ func (r *orderRepository) GetSupplierOrders(ctx context.Context, data *order_pro
 	return orderItems, totalItems, nil
 }
 
-func (r *orderRepository) UpdateOrderItem(ctx context.Context, data *order_proto_gen.UpdateOrderItemRequest) error {
+func (r *orderRepository) GetOrderItemsOfOrder(ctx context.Context, orderID string, userID int64) ([]models.OrderItem, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetOrderItemsOfOrder"))
+	defer span.End()
+
+	selectQuery, args, err := squirrel.Select("oi.id", "oi.product_id", "oi.product_variant_id", "oi.product_name",
+		"oi.product_variant_name", "oi.quantity", "oi.unit_price", "oi.status", "o.currency").
+		From("order_items oi").
+		InnerJoin("orders o on oi.order_id = o.id").
+		Where(squirrel.Eq{"o.id": orderID}).
+		Where(squirrel.Eq{"o.user_id": userID}).
+		OrderBy("oi.created_at asc").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rows, err := r.db.Query(ctx, selectQuery, args...)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	defer rows.Close()
+
+	orderItems := make([]models.OrderItem, 0)
+
+	for rows.Next() {
+		orderItem := models.OrderItem{}
+
+		if err = rows.Scan(&orderItem.ID, &orderItem.ProductID, &orderItem.ProductVariantID, &orderItem.ProductName,
+			&orderItem.ProductVariantName, &orderItem.Quantity, &orderItem.UnitPrice, &orderItem.Status,
+			&orderItem.OrderCurrency); err != nil {
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		orderItems = append(orderItems, orderItem)
+	}
+
+	// order of other user is reported as not found, so its existence is not leaked
+	if len(orderItems) == 0 {
+		return nil, status.Error(codes.NotFound, "Order not found")
+	}
+
+	return orderItems, nil
+}
+
+func (r *orderRepository) GetSupplierOrderItem(ctx context.Context, supplierID int64, orderItemID string) (*models.OrderItem, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetSupplierOrderItem"))
+	defer span.End()
+
+	selectSql := `select id, order_id, status, quantity, product_variant_id, reservation_id
+			from order_items
+			where supplier_id = $1 and id = $2`
+
+	var orderItem models.OrderItem
+
+	if err := r.db.QueryRow(ctx, selectSql, supplierID, orderItemID).Scan(&orderItem.ID, &orderItem.OrderID,
+		&orderItem.Status, &orderItem.Quantity, &orderItem.ProductVariantID, &orderItem.ReservationID); err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "Order item not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &orderItem, nil
+}
+
+func (r *orderRepository) UpdateOrderItem(ctx context.Context, supplierID int64, data *order_proto_gen.UpdateOrderItemRequest) (string, error) {
 	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "UpdateOrderItem"))
 	defer span.End()
 
-	return r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
-		resPartner, err := r.partnerClient.GetSupplierID(ctx, &partner_proto_gen.GetSupplierIDRequest{
-			UserId: data.UserId,
-		})
+	var sagaID string
 
-		if err != nil {
-			return err
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		// lock order item of supplier
+		selectSql := `select oi.order_id, oi.status, oi.quantity, oi.product_variant_id, oi.coupon_id, oi.reservation_id, o.shipping_method
+				from order_items oi
+				inner join orders o on oi.order_id = o.id
+				where oi.supplier_id = $1 and oi.id = $2
+				for update of oi`
+
+		var orderItem models.OrderItem
+
+		var err error
+
+		if err = tx.QueryRow(ctx, selectSql, supplierID, data.OrderItemId).Scan(&orderItem.OrderID, &orderItem.Status,
+			&orderItem.Quantity, &orderItem.ProductVariantID, &orderItem.CouponID, &orderItem.ReservationID,
+			&orderItem.ShippingMethod); err != nil {
+			span.RecordError(err)
+
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.NotFound, "Order item not found")
+			}
+
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		nextStatus := common.StatusOrder(data.Status)
+
+		if !orderItem.Status.CanTransitionTo(nextStatus, common.ActorSupplier) {
+			return status.Errorf(codes.FailedPrecondition, "Can not change status of order item from %v to %v",
+				orderItem.Status, nextStatus)
 		}
 
 		// update order items
-		updateSql := `update order_items set status = $1 where supplier_id = $2 and id = $3
-				returning quantity, product_variant_id`
+		updateSql := `update order_items set status = $1 where id = $2`
+		args := []interface{}{nextStatus, data.OrderItemId}
 
-		var quantity int64
-		var productVariantID string
+		if nextStatus == common.Cancelled {
+			updateSql = `update order_items set status = $1, cancelled_reason = $3 where id = $2`
+			args = append(args, data.Notes)
+		}
 
-		if err = tx.QueryRow(ctx, updateSql, data.Status, resPartner.SupplierId, data.OrderItemId).
-			Scan(&quantity, &productVariantID); err != nil {
+		if err = tx.Exec(ctx, updateSql, args...); err != nil {
 			span.RecordError(err)
 			return status.Error(codes.Internal, err.Error())
 		}
 
-		if data.Status == string(common.Pending) {
-			// call to partner to update item
-			_, err = r.partnerClient.UpdateQuantityProductVariantWhenConfirmed(ctx, &partner_proto_gen.UpdateQuantityProductVariantWhenConfirmedRequest{
-				Quantity:         quantity,
-				ProductVariantId: productVariantID,
-			})
+		if err = insertOrderItemHistory(ctx, tx, orderItem.OrderID, data.OrderItemId, orderItem.Status, nextStatus,
+			common.ActorSupplier, data.Notes, &data.UserId); err != nil {
+			span.RecordError(err)
+			return err
+		}
 
-			if err != nil {
-				span.RecordError(err)
-				return status.Error(codes.Internal, err.Error())
+		if nextStatus == common.Cancelled {
+			if sagaID, err = r.releaseCancelledOrderItem(ctx, tx, data.OrderItemId, orderItem, data.UserId); err != nil {
+				return err
 			}
 		}
 
 		return nil
 	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return sagaID, nil
+}
+
+func (r *orderRepository) CancelOrderItem(ctx context.Context, data *order_proto_gen.CancelOrderItemRequest) (string, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "CancelOrderItem"))
+	defer span.End()
+
+	var sagaID string
+
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		// lock order item of current user
+		selectSql := `select oi.order_id, oi.status, oi.quantity, oi.product_variant_id, oi.coupon_id, oi.reservation_id, o.shipping_method
+				from order_items oi
+				inner join orders o on oi.order_id = o.id
+				where oi.id = $1 and o.user_id = $2
+				for update of oi`
+
+		var orderItem models.OrderItem
+
+		if err := tx.QueryRow(ctx, selectSql, data.OrderItemId, data.UserId).Scan(&orderItem.OrderID, &orderItem.Status, &orderItem.Quantity,
+			&orderItem.ProductVariantID, &orderItem.CouponID, &orderItem.ReservationID, &orderItem.ShippingMethod); err != nil {
+			span.RecordError(err)
+
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.NotFound, "Order item not found")
+			}
+
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		// only allow cancel before supplier start processing the order item
+		if !orderItem.Status.CanTransitionTo(common.Cancelled, common.ActorCustomer) {
+			return status.Errorf(codes.FailedPrecondition, "Order item with status %v can not be cancelled", orderItem.Status)
+		}
+
+		updateSql := `update order_items set status = $1, cancelled_reason = $2 where id = $3`
+
+		if err := tx.Exec(ctx, updateSql, common.Cancelled, data.CancelledReason, data.OrderItemId); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if err := insertOrderItemHistory(ctx, tx, orderItem.OrderID, data.OrderItemId, orderItem.Status, common.Cancelled,
+			common.ActorCustomer, &data.CancelledReason, &data.UserId); err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		var err error
+		sagaID, err = r.releaseCancelledOrderItem(ctx, tx, data.OrderItemId, orderItem, data.UserId)
+
+		return err
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return sagaID, nil
+}
+
+func (r *orderRepository) GetOrderItemTimeline(ctx context.Context, data *order_proto_gen.GetOrderItemTimelineRequest) ([]models.OrderItemHistory, *models.OrderDeliverer, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetOrderItemTimeline"))
+	defer span.End()
+
+	// check caller owns the order item
+	checkBuilder := squirrel.Select("1").
+		From("order_items oi").
+		InnerJoin("orders o on oi.order_id = o.id").
+		Where(squirrel.Eq{"oi.id": data.OrderItemId})
+
+	switch common.OrderActor(data.Role) {
+	case common.ActorCustomer:
+		checkBuilder = checkBuilder.Where(squirrel.Eq{"o.user_id": data.UserId})
+	case common.ActorSupplier:
+		resPartner, err := r.partnerClient.GetSupplierID(ctx, &partner_proto_gen.GetSupplierIDRequest{
+			UserId: data.UserId,
+		})
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		checkBuilder = checkBuilder.Where(squirrel.Eq{"oi.supplier_id": resPartner.SupplierId})
+	default:
+		return nil, nil, status.Errorf(codes.InvalidArgument, "Role %v can not view timeline of order item", data.Role)
+	}
+
+	checkQuery, args, err := checkBuilder.PlaceholderFormat(squirrel.Dollar).ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var exists int
+
+	if err = r.db.QueryRow(ctx, checkQuery, args...).Scan(&exists); err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, status.Error(codes.NotFound, "Order item not found")
+		}
+
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	historySql := `select id, order_item_id, status, actor, notes, created_at, created_by
+			from order_items_history
+			where order_item_id = $1
+			order by created_at asc`
+
+	rows, err := r.db.Query(ctx, historySql, data.OrderItemId)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	defer rows.Close()
+
+	histories := make([]models.OrderItemHistory, 0)
+
+	for rows.Next() {
+		var history models.OrderItemHistory
+
+		if err = rows.Scan(&history.ID, &history.OrderItemID, &history.Status, &history.Actor, &history.Notes,
+			&history.CreatedAt, &history.CreatedBy); err != nil {
+			span.RecordError(err)
+			return nil, nil, status.Error(codes.Internal, err.Error())
+		}
+
+		histories = append(histories, history)
+	}
+
+	delivererSql := `select id, order_item_id, deliverer_id, status, pickup_time, delivery_time, delivery_notes
+			from order_deliverers
+			where order_item_id = $1`
+
+	var orderDeliverer models.OrderDeliverer
+
+	if err = r.db.QueryRow(ctx, delivererSql, data.OrderItemId).Scan(&orderDeliverer.ID, &orderDeliverer.OrderItemID,
+		&orderDeliverer.DelivererID, &orderDeliverer.Status, &orderDeliverer.PickupTime, &orderDeliverer.DeliveryTime,
+		&orderDeliverer.DeliveryNotes); err != nil {
+		// order item is not assigned to any deliverer yet
+		if errors.Is(err, pgx.ErrNoRows) {
+			return histories, nil, nil
+		}
+
+		span.RecordError(err)
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return histories, &orderDeliverer, nil
+}
+
+// releaseCancelledOrderItem gives back coupon usage and queues refund of cancelled order item, restock of
+// what was held by it is saved as order item stock saga whose id is returned. Saga id is empty when nothing is held
+func (r *orderRepository) releaseCancelledOrderItem(ctx context.Context, tx pkg.Tx, orderItemID string,
+	orderItem models.OrderItem, processedBy int64) (string, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "releaseCancelledOrderItem"))
+	defer span.End()
+
+	// give back coupon usage
+	if err := reverseCouponRedemptions(ctx, tx, orderItem.OrderID, []string{orderItemID}); err != nil {
+		span.RecordError(err)
+		return "", err
+	}
+
+	// order item was paid via payment gateway -> queue refund
+	if orderItem.ShippingMethod != common.Cod && orderItem.Status != common.PendingPayment {
+		insertRefundSql := `insert into payment_refunds (payment_history_id, amount, status, processed_by)
+			select id, amount, $1, $2 from payment_history where order_item_id = $3 and status = $4`
+
+		if err := tx.Exec(ctx, insertRefundSql, common.RefundStatusPending, processedBy,
+			orderItemID, common.PaymentStatusCompleted); err != nil {
+			span.RecordError(err)
+			return "", status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	// partner gives back held quantity or restocks when reservation was committed,
+	// order items created before reservation only decreased inventory when supplier confirmed them
+	if orderItem.ReservationID == nil && orderItem.Status != common.Confirmed && orderItem.Status != common.Processing {
+		return "", nil
+	}
+
+	sagaID, err := insertSaga(ctx, tx, common.SagaTypeOrderItemStock, dto.OrderItemStockSagaState{
+		OrderItemID:      orderItemID,
+		Action:           common.OrderItemStockRelease,
+		ReservationID:    orderItem.ReservationID,
+		ProductVariantID: orderItem.ProductVariantID,
+		Quantity:         orderItem.Quantity,
+		PerformedBy:      &processedBy,
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return "", err
+	}
+
+	return sagaID, nil
+}
+
+// insertOrderItemHistory saves status change of order item into history and outbox,
+// createdBy is nil when status is changed by system
+func insertOrderItemHistory(ctx context.Context, tx pkg.Tx, orderID, orderItemID string, previousStatus, statusOrder common.StatusOrder,
+	actor common.OrderActor, notes *string, createdBy *int64) error {
+	insertSql := `insert into order_items_history (order_item_id, status, actor, notes, created_by) values ($1, $2, $3, $4, $5)`
+
+	if err := tx.Exec(ctx, insertSql, orderItemID, statusOrder, actor, notes, createdBy); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return insertOutboxEvent(ctx, tx, &order_proto_gen.OrderEvent{
+		EventType: string(common.OrderEventOrderItemStatusChanged),
+		OrderId:   orderID,
+		Payload: &order_proto_gen.OrderEvent_OrderItemStatusChanged{
+			OrderItemStatusChanged: &order_proto_gen.OrderItemStatusChangedEvent{
+				OrderItemId: orderItemID,
+				FromStatus:  string(previousStatus),
+				ToStatus:    string(statusOrder),
+				Actor:       string(actor),
+				Notes:       notes,
+				ChangedBy:   createdBy,
+			},
+		},
+	})
 }
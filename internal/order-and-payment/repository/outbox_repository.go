@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// outboxRelayLockID is key of advisory lock of relay, so only one instance of service publishes events at a time
+const outboxRelayLockID = 7340001
+
+// maxOutboxBackoffSeconds limits wait time before event which failed to be published is retried
+const maxOutboxBackoffSeconds = 300
+
+// orderEventVersions is version of payload of each event type, it is increased when payload is changed
+// in not backward compatible way
+var orderEventVersions = map[common.OrderEventType]int32{
+	// amounts are money instead of double since version 2
+	common.OrderEventOrderCreated:           2,
+	common.OrderEventOrderItemStatusChanged: 1,
+	common.OrderEventPaymentSucceeded:       2,
+	common.OrderEventPaymentFailed:          1,
+	common.OrderEventRefundCompleted:        2,
+}
+
+type outboxRepository struct {
+	tracer pkg.Tracer
+	db     pkg.Database
+}
+
+func NewOutboxRepository(tracer pkg.Tracer, db pkg.Database) IOutboxRepository {
+	return &outboxRepository{
+		tracer: tracer,
+		db:     db,
+	}
+}
+
+func (r *outboxRepository) RelayOutboxEvents(ctx context.Context, limit int64,
+	publish func(ctx context.Context, event models.OutboxEvent) error) (int, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "RelayOutboxEvents"))
+	defer span.End()
+
+	var publishedEvents int
+
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		var isLocked bool
+
+		if err := tx.QueryRow(ctx, `select pg_try_advisory_xact_lock($1)`, outboxRelayLockID).Scan(&isLocked); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		// other instance is relaying events
+		if !isLocked {
+			return nil
+		}
+
+		// orders which have event waiting for retry are skipped, so their later events are not published before it
+		selectSql := `select id, event_id, aggregate_id, event_type, event_version, payload, attempts, next_attempt_at, created_at
+				from outbox_events
+				where published_at is null
+				and aggregate_id not in (
+					select aggregate_id from outbox_events
+					where published_at is null and next_attempt_at > current_timestamp
+				)
+				order by id asc
+				limit $1`
+
+		rows, err := tx.Query(ctx, selectSql, limit)
+
+		if err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		events := make([]models.OutboxEvent, 0)
+
+		for rows.Next() {
+			var event models.OutboxEvent
+
+			if err = rows.Scan(&event.ID, &event.EventID, &event.AggregateID, &event.EventType, &event.EventVersion,
+				&event.Payload, &event.Attempts, &event.NextAttemptAt, &event.CreatedAt); err != nil {
+				rows.Close()
+				span.RecordError(err)
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			events = append(events, event)
+		}
+
+		rows.Close()
+
+		publishedIDs := make([]int64, 0, len(events))
+		failedAggregates := make(map[string]bool)
+
+		for _, event := range events {
+			// earlier event of same order was not published, so this one waits for it
+			if failedAggregates[event.AggregateID] {
+				continue
+			}
+
+			if errPublish := publish(ctx, event); errPublish != nil {
+				span.RecordError(errPublish)
+				failedAggregates[event.AggregateID] = true
+
+				updateFailedSql := `update outbox_events
+						set attempts = attempts + 1, last_error = $1,
+							next_attempt_at = current_timestamp + least(power(2, attempts), $2) * interval '1 second'
+						where id = $3`
+
+				if err = tx.Exec(ctx, updateFailedSql, errPublish.Error(), maxOutboxBackoffSeconds, event.ID); err != nil {
+					span.RecordError(err)
+					return status.Error(codes.Internal, err.Error())
+				}
+
+				continue
+			}
+
+			publishedIDs = append(publishedIDs, event.ID)
+		}
+
+		if len(publishedIDs) == 0 {
+			return nil
+		}
+
+		if err = tx.Exec(ctx, `update outbox_events set published_at = current_timestamp where id = any($1)`,
+			publishedIDs); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		publishedEvents = len(publishedIDs)
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return publishedEvents, nil
+}
+
+func (r *outboxRepository) DeletePublishedOutboxEvents(ctx context.Context, publishedBefore time.Time) error {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "DeletePublishedOutboxEvents"))
+	defer span.End()
+
+	if err := r.db.Exec(ctx, `delete from outbox_events where published_at < $1`, publishedBefore); err != nil {
+		span.RecordError(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+// insertOutboxEvent saves event of order into outbox in transaction of change, so event is only published
+// when change is committed. event_type, order_id and payload of event must be set
+func insertOutboxEvent(ctx context.Context, tx pkg.Tx, event *order_proto_gen.OrderEvent) error {
+	event.EventId = uuid.New().String()
+	event.Version = orderEventVersions[common.OrderEventType(event.EventType)]
+	event.OccurredAt = timestamppb.Now()
+
+	payload, err := proto.Marshal(event)
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	insertSql := `insert into outbox_events (event_id, aggregate_id, event_type, event_version, payload)
+			values ($1, $2, $3, $4, $5)`
+
+	if err = tx.Exec(ctx, insertSql, event.EventId, event.OrderId, event.EventType, event.Version, payload); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"github.com/Masterminds/squirrel"
 	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/tax"
 	"github.com/TienMinh25/ecommerce-platform/internal/utils"
 	"github.com/TienMinh25/ecommerce-platform/pkg"
 	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
@@ -15,19 +17,20 @@ import (
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"strings"
 	"time"
 )
 
 type paymentRepository struct {
-	tracer pkg.Tracer
-	db     pkg.Database
+	tracer        pkg.Tracer
+	db            pkg.Database
+	taxCalculator tax.ITaxCalculator
 }
 
-func NewPaymentRepository(tracer pkg.Tracer, db pkg.Database) IPaymentRepository {
+func NewPaymentRepository(tracer pkg.Tracer, db pkg.Database, taxCalculator tax.ITaxCalculator) IPaymentRepository {
 	return &paymentRepository{
-		tracer: tracer,
-		db:     db,
+		tracer:        tracer,
+		db:            db,
+		taxCalculator: taxCalculator,
 	}
 }
 
@@ -72,13 +75,13 @@ func (r *paymentRepository) GetPaymentMethods(ctx context.Context) ([]*models.Pa
 	return paymentMethods, nil
 }
 
-func (r *paymentRepository) CreateOrder(ctx context.Context, data dto.CheckoutRequest) (string, common.StatusOrder, float64, error) {
+func (r *paymentRepository) CreateOrder(ctx context.Context, data dto.CheckoutRequest) (string, common.StatusOrder, money.Money, error) {
 	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "CreateOrder"))
 	defer span.End()
 
 	var orderID string
 	var statusOrder common.StatusOrder
-	var totalAmount float64
+	var totalAmount money.Money
 
 	methodType := data.MethodType
 
@@ -95,6 +98,10 @@ func (r *paymentRepository) CreateOrder(ctx context.Context, data dto.CheckoutRe
 		}
 	}
 
+	if data.OrderCouponID != nil {
+		couponUsageCount[*data.OrderCouponID]++
+	}
+
 	couponUsed := make([]string, 0, len(couponUsageCount))
 	for couponID := range couponUsageCount {
 		couponUsed = append(couponUsed, couponID)
@@ -104,110 +111,41 @@ func (r *paymentRepository) CreateOrder(ctx context.Context, data dto.CheckoutRe
 		// coupon_usages, orders, order_items, cart_items
 
 		// get info from coupons and calculate
-		couponMap, err := r.validateCoupons(ctx, tx, couponUsed, couponUsageCount)
+		couponMap, err := r.validateCoupons(ctx, tx, couponUsed, couponUsageCount, data.UserID)
 
 		if err != nil {
 			return err
 		}
 
-		// subtotals: tong tien chua tinh thue va giam gia
-		// discount_amount: tong tien giam
-		// total_amount: tong tien phai tra
-		var taxAmount float64 = 0
-		var totalDiscountAmount float64 = 0
-		var subTotal float64 = 0
-		orderItems := make([]models.OrderItem, 0)
-
-		// step 1: calculate money
-		for _, item := range data.Items {
-			unitPrice := item.OriginalUnitPrice
-			var discountAmount float64 = 0
-
-			if item.DiscountUnitPrice > 0 && item.DiscountUnitPrice < item.OriginalUnitPrice {
-				unitPrice = item.DiscountUnitPrice
-			}
-
-			// calculate subtotal
-			itemSubtotal := unitPrice * float64(item.Quantity)
-			subTotal += itemSubtotal
-
-			// calculate discount amount
-			if item.CouponID != nil {
-				coupon := couponMap[*item.CouponID]
-
-				if itemSubtotal < coupon.MinimumOrderAmount {
-					return status.Errorf(codes.FailedPrecondition,
-						"Total amount %.2f for coupon %s is below minimum order amount %.2f",
-						itemSubtotal, *item.CouponID, coupon.MinimumOrderAmount)
-				}
-
-				switch coupon.DiscountType {
-				case "percentage":
-					discountAmount = itemSubtotal * (coupon.DiscountValue / 100)
-				case "fixed_amount":
-					discountAmount = coupon.DiscountValue
-				}
+		// step 1, 2: calculate money and tax
+		quote := r.calculateOrder(data, couponMap)
 
-				if discountAmount > coupon.MaximumDiscountAmount {
-					discountAmount = coupon.MaximumDiscountAmount
-				}
+		if len(quote.Problems) > 0 {
+			return status.Error(codes.FailedPrecondition, quote.Problems[0].Message)
+		}
 
-				totalDiscountAmount += discountAmount
-			}
+		orderItems := quote.Items
+		statusOrder = common.PendingPayment
 
-			switch methodType {
-			case common.Cod:
-				statusOrder = common.Pending
-
-				orderItems = append(orderItems, models.OrderItem{
-					ProductName:            item.ProductName,
-					ProductVariantImageURL: item.ProductVariantImageURL,
-					ProductVariantName:     item.ProductVariantName,
-					Quantity:               item.Quantity,
-					UnitPrice:              unitPrice,
-					TotalPrice:             itemSubtotal,
-					EstimatedDeliveryDate:  item.EstimatedDeliveryDate,
-					Status:                 common.Pending,
-					ShippingFee:            item.ShippingFee,
-					ProductVariantID:       item.ProductVariantID,
-					DiscountAmount:         discountAmount,
-					TaxAmount:              0,
-					SupplierID:             item.SupplierID,
-					ProductID:              item.ProductID,
-				})
-			case common.Momo:
-				statusOrder = common.PendingPayment
-
-				orderItems = append(orderItems, models.OrderItem{
-					ProductName:            item.ProductName,
-					ProductVariantImageURL: item.ProductVariantImageURL,
-					ProductVariantName:     item.ProductVariantName,
-					Quantity:               item.Quantity,
-					UnitPrice:              unitPrice,
-					TotalPrice:             itemSubtotal,
-					EstimatedDeliveryDate:  item.EstimatedDeliveryDate,
-					Status:                 common.PendingPayment,
-					ShippingFee:            item.ShippingFee,
-					ProductVariantID:       item.ProductVariantID,
-					DiscountAmount:         discountAmount,
-					TaxAmount:              0,
-					SupplierID:             item.SupplierID,
-					ProductID:              item.ProductID,
-				})
-			}
+		if methodType == common.Cod {
+			statusOrder = common.Pending
 		}
 
+		totalAmount = quote.TotalAmount
 		trackingNumber := utils.GenerateTrackingNumber()
-		totalAmount = subTotal + taxAmount - totalDiscountAmount
 
-		// step 2: insert into orders (auto gen tracking number)
+		// step 3: insert into orders (auto gen tracking number)
 		insertOrders, args, err := squirrel.Insert("orders").
 			Columns("user_id", "tracking_number", "shipping_address", "shipping_method",
 				"sub_total", "discount_amount", "tax_amount",
-				"total_amount", "recipient_name", "recipient_phone").
+				"total_amount", "recipient_name", "recipient_phone", "created_at", "user_payment_method_id", "coupon_id",
+				"prices_include_tax", "shipping_province", "shipping_district", "shipping_ward", "shipping_fee", "saga_id",
+				"currency").
 			Values(data.UserID, trackingNumber, data.ShippingAddress, methodType,
-				subTotal, totalDiscountAmount, taxAmount, totalAmount,
-				data.RecipientName, data.RecipientPhone).
+				quote.SubTotal, quote.DiscountAmount, quote.TaxAmount, totalAmount,
+				data.RecipientName, data.RecipientPhone, data.CreatedAt, data.UserPaymentMethodID, data.OrderCouponID,
+				quote.PricesIncludeTax, data.ShippingProvince, data.ShippingDistrict, data.ShippingWard, quote.ShippingFee,
+				data.SagaID, data.Currency).
 			Suffix("returning id").
 			PlaceholderFormat(squirrel.Dollar).
 			ToSql()
@@ -227,34 +165,412 @@ func (r *paymentRepository) CreateOrder(ctx context.Context, data dto.CheckoutRe
 			orderItems[idx].OrderID = orderID
 		}
 
-		// step 3: insert into order_items, coupon_usages, update cart_items, coupons
-		if err = r.processOrder(ctx, tx, orderItems, couponUsageCount,
-			couponMap, data.UserID); err != nil {
+		// step 4: insert into order_items, coupon_redemptions, update cart_items, coupons
+		if err = r.processOrder(ctx, tx, orderID, orderItems, couponUsageCount, data.UserID); err != nil {
 			span.RecordError(err)
-			return status.Error(codes.Internal, err.Error())
+			return err
+		}
+
+		// step 5: save order created event into outbox
+		eventItems := make([]*order_proto_gen.OrderCreatedItemEvent, 0, len(orderItems))
+
+		for _, orderItem := range orderItems {
+			eventItems = append(eventItems, &order_proto_gen.OrderCreatedItemEvent{
+				ProductVariantId: orderItem.ProductVariantID,
+				SupplierId:       orderItem.SupplierID,
+				Quantity:         orderItem.Quantity,
+				UnitPrice:        dto.ToMoneyProto(orderItem.UnitPrice, data.Currency),
+				DiscountAmount:   dto.ToMoneyProto(orderItem.DiscountAmount, data.Currency),
+				TaxAmount:        dto.ToMoneyProto(orderItem.TaxAmount, data.Currency),
+				ShippingFee:      dto.ToMoneyProto(orderItem.ShippingFee, data.Currency),
+				Status:           string(orderItem.Status),
+			})
+		}
+
+		if err = insertOutboxEvent(ctx, tx, &order_proto_gen.OrderEvent{
+			EventType: string(common.OrderEventOrderCreated),
+			OrderId:   orderID,
+			Payload: &order_proto_gen.OrderEvent_OrderCreated{
+				OrderCreated: &order_proto_gen.OrderCreatedEvent{
+					UserId:         data.UserID,
+					TrackingNumber: trackingNumber,
+					MethodType:     string(methodType),
+					SubTotal:       dto.ToMoneyProto(quote.SubTotal, data.Currency),
+					DiscountAmount: dto.ToMoneyProto(quote.DiscountAmount, data.Currency),
+					TaxAmount:      dto.ToMoneyProto(quote.TaxAmount, data.Currency),
+					ShippingFee:    dto.ToMoneyProto(quote.ShippingFee, data.Currency),
+					TotalAmount:    dto.ToMoneyProto(totalAmount, data.Currency),
+					Items:          eventItems,
+				},
+			},
+		}); err != nil {
+			span.RecordError(err)
+			return err
 		}
 
 		return nil
 	})
 
 	if err != nil {
-		return "", "", 0, err
+		return "", "", money.Zero, err
 	}
 
 	return orderID, statusOrder, totalAmount, nil
 }
 
-func (r *paymentRepository) validateCoupons(ctx context.Context, tx pkg.Tx, couponUsed []string, couponUsedCount map[string]int64) (map[string]models.Coupon, error) {
+func (r *paymentRepository) QuoteOrder(ctx context.Context, data dto.CheckoutRequest) (dto.OrderQuote, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "QuoteOrder"))
+	defer span.End()
+
+	couponUsageCount := make(map[string]int64)
+
+	for _, item := range data.Items {
+		if item.CouponID != nil {
+			couponUsageCount[*item.CouponID]++
+		}
+	}
+
+	if data.OrderCouponID != nil {
+		couponUsageCount[*data.OrderCouponID]++
+	}
+
+	couponUsed := make([]string, 0, len(couponUsageCount))
+	for couponID := range couponUsageCount {
+		couponUsed = append(couponUsed, couponID)
+	}
+
+	// quote does not lock or write anything, so coupons are read outside of transaction
+	coupons, err := r.getCoupons(ctx, r.db, couponUsed)
+
+	if err != nil {
+		return dto.OrderQuote{}, err
+	}
+
+	// invalid coupons are reported as problems and order is quoted without their discount
+	problems := make([]dto.CheckoutProblem, 0)
+	couponMap := make(map[string]models.Coupon, len(coupons))
+	now := time.Now()
+
+	for _, couponID := range couponUsed {
+		coupon, ok := coupons[couponID]
+
+		if !ok {
+			problems = append(problems, dto.CheckoutProblem{
+				Code:     common.CheckoutProblemCouponNotFound,
+				Message:  fmt.Sprintf("Coupon %s not found", couponID),
+				CouponID: &couponID,
+			})
+			continue
+		}
+
+		if err = checkCoupon(coupon, data.UserID, couponUsageCount[couponID], now); err != nil {
+			problems = append(problems, dto.CheckoutProblem{
+				Code:     common.CheckoutProblemCouponInvalid,
+				Message:  status.Convert(err).Message(),
+				CouponID: &couponID,
+			})
+			continue
+		}
+
+		couponMap[couponID] = coupon
+	}
+
+	quote := r.calculateOrder(data, couponMap)
+	quote.Problems = append(problems, quote.Problems...)
+
+	return quote, nil
+}
+
+// calculateOrder calculates money of order items and order from checkout request, it does not read or write anything.
+// Coupons which are not in couponMap are skipped, coupons which can not be applied to item or order are
+// returned as problems and their discount is not applied
+func (r *paymentRepository) calculateOrder(data dto.CheckoutRequest, couponMap map[string]models.Coupon) dto.OrderQuote {
+	// subtotals: tong tien chua tinh thue (khi gia chua gom thue) va giam gia
+	// discount_amount: tong tien giam
+	// total_amount: tong tien phai tra
+	var taxAmount, totalDiscountAmount, subTotal, shippingFee money.Money
+	orderItems := make([]models.OrderItem, 0, len(data.Items))
+	couponMap, problems := convertCoupons(couponMap, data.Currency, data.ExchangeRates)
+
+	// order paid by cod waits for supplier, other methods wait for payment gateway
+	statusOrder := common.PendingPayment
+
+	if data.MethodType == common.Cod {
+		statusOrder = common.Pending
+	}
+
+	// step 1: calculate money
+	for _, item := range data.Items {
+		unitPrice := item.OriginalUnitPrice
+		discountAmount := money.Zero
+
+		if item.DiscountUnitPrice.IsPositive() && item.DiscountUnitPrice.Cmp(item.OriginalUnitPrice) < 0 {
+			unitPrice = item.DiscountUnitPrice
+		}
+
+		// calculate subtotal
+		itemSubtotal := unitPrice.Mul(item.Quantity)
+		subTotal = subTotal.Add(itemSubtotal)
+		shippingFee = shippingFee.Add(item.ShippingFee)
+
+		// calculate discount amount
+		if item.CouponID != nil {
+			if coupon, ok := couponMap[*item.CouponID]; ok {
+				if problem := checkItemCoupon(coupon, item, itemSubtotal); problem != nil {
+					problems = append(problems, *problem)
+				} else {
+					discountAmount = calculateCouponDiscount(coupon, itemSubtotal)
+					totalDiscountAmount = totalDiscountAmount.Add(discountAmount)
+				}
+			}
+		}
+
+		orderItems = append(orderItems, models.OrderItem{
+			ProductName:            item.ProductName,
+			ProductVariantImageURL: item.ProductVariantImageURL,
+			ProductVariantName:     item.ProductVariantName,
+			Quantity:               item.Quantity,
+			UnitPrice:              unitPrice,
+			TotalPrice:             itemSubtotal,
+			EstimatedDeliveryDate:  item.EstimatedDeliveryDate,
+			Status:                 statusOrder,
+			ShippingFee:            item.ShippingFee,
+			ProductVariantID:       item.ProductVariantID,
+			DiscountAmount:         discountAmount,
+			SupplierID:             item.SupplierID,
+			ProductID:              item.ProductID,
+			CouponID:               item.CouponID,
+			ReservationID:          &item.ReservationID,
+			Currency:               item.Currency,
+			ExchangeRate:           item.ExchangeRate,
+		})
+	}
+
+	// coupon of whole order is checked with subtotal of all suppliers,
+	// then its discount is spread into order items by what is left to pay of each item
+	if data.OrderCouponID != nil {
+		if coupon, ok := couponMap[*data.OrderCouponID]; ok {
+			switch {
+			case coupon.Scope != common.CouponScopeOrder:
+				problems = append(problems, dto.CheckoutProblem{
+					Code:     common.CheckoutProblemCouponNotApplicable,
+					Message:  fmt.Sprintf("Coupon %s is not applied to whole order", coupon.ID),
+					CouponID: data.OrderCouponID,
+				})
+			case subTotal.Cmp(coupon.MinimumOrderAmount) < 0:
+				problems = append(problems, dto.CheckoutProblem{
+					Code: common.CheckoutProblemCouponBelowMinimum,
+					Message: fmt.Sprintf("Total amount %s for coupon %s is below minimum order amount %s",
+						subTotal, coupon.ID, coupon.MinimumOrderAmount),
+					CouponID: data.OrderCouponID,
+				})
+			default:
+				orderDiscountAmount := spreadOrderDiscount(orderItems,
+					calculateCouponDiscount(coupon, money.Max(subTotal.Sub(totalDiscountAmount), money.Zero)))
+				totalDiscountAmount = totalDiscountAmount.Add(orderDiscountAmount)
+			}
+		}
+	}
+
+	// step 2: calculate tax of each item from what is left to pay after discounts, rounded per item
+	for idx := range orderItems {
+		orderItems[idx].TaxAmount = r.taxCalculator.CalculateTax(data.Items[idx].TaxClass,
+			remainingOfOrderItem(orderItems[idx]))
+		taxAmount = taxAmount.Add(orderItems[idx].TaxAmount)
+	}
+
+	pricesIncludeTax := r.taxCalculator.PricesIncludeTax()
+	totalAmount := subTotal.Sub(totalDiscountAmount).Add(shippingFee)
+
+	// tax of tax-inclusive prices is already in subtotal
+	if !pricesIncludeTax {
+		totalAmount = totalAmount.Add(taxAmount)
+	}
+
+	return dto.OrderQuote{
+		Items:            orderItems,
+		SubTotal:         subTotal,
+		DiscountAmount:   totalDiscountAmount,
+		TaxAmount:        taxAmount,
+		ShippingFee:      shippingFee,
+		TotalAmount:      totalAmount,
+		PricesIncludeTax: pricesIncludeTax,
+		Currency:         data.Currency,
+		Problems:         problems,
+	}
+}
+
+// convertCoupons converts amounts of coupons into currency of order, coupons whose currency has no exchange rate
+// are returned as problems and left out
+func convertCoupons(couponMap map[string]models.Coupon, currency string,
+	exchangeRates dto.ExchangeRates) (map[string]models.Coupon, []dto.CheckoutProblem) {
+	res := make(map[string]models.Coupon, len(couponMap))
+	problems := make([]dto.CheckoutProblem, 0)
+
+	for couponID, coupon := range couponMap {
+		rate, err := exchangeRates.Rate(coupon.Currency, currency)
+
+		if err != nil {
+			problems = append(problems, dto.CheckoutProblem{
+				Code:     common.CheckoutProblemCouponInvalid,
+				Message:  status.Convert(err).Message(),
+				CouponID: &coupon.ID,
+			})
+			continue
+		}
+
+		coupon.MaximumDiscountAmount = coupon.MaximumDiscountAmount.Convert(rate)
+		coupon.MinimumOrderAmount = coupon.MinimumOrderAmount.Convert(rate)
+
+		// percent is same in every currency
+		if coupon.DiscountType == "fixed_amount" {
+			coupon.DiscountValue = coupon.DiscountValue.Convert(rate)
+		}
+
+		coupon.Currency = currency
+		res[couponID] = coupon
+	}
+
+	return res, problems
+}
+
+// checkItemCoupon returns problem when coupon of item can not be applied to it
+func checkItemCoupon(coupon models.Coupon, item dto.CheckoutItemRequest, itemSubtotal money.Money) *dto.CheckoutProblem {
+	switch coupon.Scope {
+	case common.CouponScopeOrder:
+		return &dto.CheckoutProblem{
+			Code: common.CheckoutProblemCouponNotApplicable,
+			Message: fmt.Sprintf("Coupon %s is applied to whole order, not to product %s",
+				coupon.ID, item.ProductVariantID),
+			ProductVariantID: &item.ProductVariantID,
+			CouponID:         item.CouponID,
+		}
+	case common.CouponScopeCategory:
+		if coupon.CategoryID == nil || *coupon.CategoryID != item.CategoryID {
+			return &dto.CheckoutProblem{
+				Code: common.CheckoutProblemCouponNotApplicable,
+				Message: fmt.Sprintf("Coupon %s is not applied to category of product %s",
+					coupon.ID, item.ProductVariantID),
+				ProductVariantID: &item.ProductVariantID,
+				CouponID:         item.CouponID,
+			}
+		}
+	}
+
+	if itemSubtotal.Cmp(coupon.MinimumOrderAmount) < 0 {
+		return &dto.CheckoutProblem{
+			Code: common.CheckoutProblemCouponBelowMinimum,
+			Message: fmt.Sprintf("Total amount %s for coupon %s is below minimum order amount %s",
+				itemSubtotal, coupon.ID, coupon.MinimumOrderAmount),
+			ProductVariantID: &item.ProductVariantID,
+			CouponID:         item.CouponID,
+		}
+	}
+
+	return nil
+}
+
+// calculateCouponDiscount returns discount of coupon for amount, it is not over maximum discount amount of coupon and amount
+func calculateCouponDiscount(coupon models.Coupon, amount money.Money) money.Money {
+	discountAmount := money.Zero
+
+	switch coupon.DiscountType {
+	case "percentage":
+		discountAmount = amount.Percent(coupon.DiscountValue)
+	case "fixed_amount":
+		discountAmount = coupon.DiscountValue
+	}
+
+	return money.Min(discountAmount, money.Min(coupon.MaximumDiscountAmount, amount))
+}
+
+// remainingOfOrderItem returns what is left to pay of order item after discount, it is never negative
+func remainingOfOrderItem(orderItem models.OrderItem) money.Money {
+	return money.Max(orderItem.TotalPrice.Sub(orderItem.DiscountAmount), money.Zero)
+}
+
+// spreadOrderDiscount adds discount of whole order into discount_amount of order items, in proportion to
+// what is left to pay of each item. Each part is rounded to minor unit, the last item takes the rest,
+// so sum of parts is always same as discount which is returned
+func spreadOrderDiscount(orderItems []models.OrderItem, discountAmount money.Money) money.Money {
+	base := money.Zero
+	lastIdx := -1
+
+	for idx, orderItem := range orderItems {
+		if remaining := remainingOfOrderItem(orderItem); remaining.IsPositive() {
+			base = base.Add(remaining)
+			lastIdx = idx
+		}
+	}
+
+	if base.IsZero() || !discountAmount.IsPositive() {
+		return money.Zero
+	}
+
+	spread := money.Zero
+
+	for idx := range orderItems {
+		remaining := remainingOfOrderItem(orderItems[idx])
+
+		if remaining.IsZero() {
+			continue
+		}
+
+		part := discountAmount.MulRatio(remaining.Minor(), base.Minor())
+
+		if idx == lastIdx {
+			part = discountAmount.Sub(spread)
+		}
+
+		orderItems[idx].DiscountAmount = orderItems[idx].DiscountAmount.Add(part)
+		spread = spread.Add(part)
+	}
+
+	return discountAmount
+}
+
+func (r *paymentRepository) validateCoupons(ctx context.Context, tx pkg.Tx, couponUsed []string, couponUsedCount map[string]int64,
+	userID int64) (map[string]models.Coupon, error) {
 	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "validateCoupons"))
 	defer span.End()
 
+	couponMap, err := r.getCoupons(ctx, tx, couponUsed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	for _, coupon := range couponMap {
+		if err = checkCoupon(coupon, userID, couponUsedCount[coupon.ID], now); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(couponMap) != len(couponUsed) {
+		return nil, status.Error(codes.NotFound, "Some coupons not found")
+	}
+
+	return couponMap, nil
+}
+
+// getCoupons returns coupons by ids, coupons which are not found are not in result
+func (r *paymentRepository) getCoupons(ctx context.Context, db pkg.CommonOperation, couponIDs []string) (map[string]models.Coupon, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "getCoupons"))
+	defer span.End()
+
 	couponMap := make(map[string]models.Coupon)
 
+	if len(couponIDs) == 0 {
+		return couponMap, nil
+	}
+
 	queryGetCoupons, args, err := squirrel.Select("id", "discount_type", "discount_value",
 		"maximum_discount_amount", "minimum_order_amount", "usage_limit", "usage_count",
-		"start_date", "end_date", "is_active").
+		"start_date", "end_date", "is_active", "scope", "category_id", "user_id").
 		From("coupons").
-		Where(squirrel.Eq{"id": couponUsed}).
+		Where(squirrel.Eq{"id": couponIDs}).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 
@@ -263,53 +579,55 @@ func (r *paymentRepository) validateCoupons(ctx context.Context, tx pkg.Tx, coup
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	rows, err := tx.Query(ctx, queryGetCoupons, args...)
+	rows, err := db.Query(ctx, queryGetCoupons, args...)
 	if err != nil {
 		span.RecordError(err)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	defer rows.Close()
 
-	now := time.Now()
-
 	for rows.Next() {
 		var coupon models.Coupon
 
 		if err = rows.Scan(&coupon.ID, &coupon.DiscountType, &coupon.DiscountValue,
 			&coupon.MaximumDiscountAmount, &coupon.MinimumOrderAmount, &coupon.UsageLimit, &coupon.UsageCount,
-			&coupon.StartDate, &coupon.EndDate, &coupon.IsActive); err != nil {
+			&coupon.StartDate, &coupon.EndDate, &coupon.IsActive, &coupon.Scope, &coupon.CategoryID, &coupon.UserID); err != nil {
 			span.RecordError(err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
-		// validate coupon
-		if !coupon.IsActive {
-			return nil, status.Errorf(codes.FailedPrecondition, "Coupon %s is not active", coupon.ID)
-		}
+		couponMap[coupon.ID] = coupon
+	}
 
-		if now.Before(coupon.StartDate) || now.After(coupon.EndDate) {
-			return nil, status.Errorf(codes.FailedPrecondition, "Coupon %s is expired or not yet valid", coupon.ID)
-		}
+	return couponMap, nil
+}
 
-		if coupon.UsageCount+couponUsedCount[coupon.ID] > coupon.UsageLimit {
-			return nil, status.Errorf(codes.FailedPrecondition,
-				"Coupon %s usage limit exceeded. Current: %d, This order: %d, Limit: %d",
-				coupon.ID, coupon.UsageCount, couponUsedCount[coupon.ID], coupon.UsageLimit)
-		}
+// checkCoupon returns error when coupon can not be used by user usedCount more times at now
+func checkCoupon(coupon models.Coupon, userID int64, usedCount int64, now time.Time) error {
+	if !coupon.IsActive {
+		return status.Errorf(codes.FailedPrecondition, "Coupon %s is not active", coupon.ID)
+	}
 
-		couponMap[coupon.ID] = coupon
+	if coupon.UserID != nil && *coupon.UserID != userID {
+		return status.Errorf(codes.FailedPrecondition, "Coupon %s is assigned to other user", coupon.ID)
 	}
 
-	if len(couponMap) != len(couponUsed) {
-		return nil, status.Error(codes.NotFound, "Some coupons not found")
+	if now.Before(coupon.StartDate) || now.After(coupon.EndDate) {
+		return status.Errorf(codes.FailedPrecondition, "Coupon %s is expired or not yet valid", coupon.ID)
 	}
 
-	return couponMap, nil
+	if coupon.UsageCount+usedCount > coupon.UsageLimit {
+		return status.Errorf(codes.FailedPrecondition,
+			"Coupon %s usage limit exceeded. Current: %d, This order: %d, Limit: %d",
+			coupon.ID, coupon.UsageCount, usedCount, coupon.UsageLimit)
+	}
+
+	return nil
 }
 
-func (r *paymentRepository) processOrder(ctx context.Context, tx pkg.Tx, orderItems []models.OrderItem, couponUsageCount map[string]int64,
-	couponMap map[string]models.Coupon, userID int64) error {
-	// order_items, update cart_items, coupons
+func (r *paymentRepository) processOrder(ctx context.Context, tx pkg.Tx, orderID string, orderItems []models.OrderItem,
+	couponUsageCount map[string]int64, userID int64) error {
+	// order_items, update cart_items, coupons, coupon_redemptions
 	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "processOrder"))
 	defer span.End()
 
@@ -317,13 +635,15 @@ func (r *paymentRepository) processOrder(ctx context.Context, tx pkg.Tx, orderIt
 	insertOrderItemsBuilder := squirrel.Insert("order_items").
 		Columns("order_id", "product_name", "product_variant_image_url", "product_variant_name",
 			"quantity", "unit_price", "total_price", "estimated_delivery_date", "status",
-			"shipping_fee", "product_variant_id", "discount_amount", "tax_amount", "supplier_id", "product_id")
+			"shipping_fee", "product_variant_id", "discount_amount", "tax_amount", "supplier_id", "product_id", "coupon_id",
+			"reservation_id", "currency", "exchange_rate")
 
 	for _, orderItem := range orderItems {
 		insertOrderItemsBuilder = insertOrderItemsBuilder.Values(orderItem.OrderID,
 			orderItem.ProductName, orderItem.ProductVariantImageURL, orderItem.ProductVariantName,
 			orderItem.Quantity, orderItem.UnitPrice, orderItem.TotalPrice, orderItem.EstimatedDeliveryDate, orderItem.Status,
-			orderItem.ShippingFee, orderItem.ProductVariantID, orderItem.DiscountAmount, orderItem.TaxAmount, orderItem.SupplierID, orderItem.ProductID)
+			orderItem.ShippingFee, orderItem.ProductVariantID, orderItem.DiscountAmount, orderItem.TaxAmount, orderItem.SupplierID, orderItem.ProductID, orderItem.CouponID,
+			orderItem.ReservationID, orderItem.Currency, orderItem.ExchangeRate)
 	}
 
 	insertOrderItems, args, errBuildQuery := insertOrderItemsBuilder.PlaceholderFormat(squirrel.Dollar).ToSql()
@@ -376,51 +696,479 @@ func (r *paymentRepository) processOrder(ctx context.Context, tx pkg.Tx, orderIt
 		return status.Error(codes.Internal, err.Error())
 	}
 
-	// update coupons
+	// update coupons, usage checked by validateCoupons is only a snapshot, so it is taken again atomically
+	if err := redeemCoupons(ctx, tx, orderID, userID, couponUsageCount); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
 
-	var valuesParts []string
-	args = []interface{}{}
-	argIndex := 1
+func (r *paymentRepository) SavePaymentAttempt(ctx context.Context, orderID string, gateway common.MethodType,
+	paymentStatus common.PaymentStatus, gatewayResponse []byte, errorMessage *string) error {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "SavePaymentAttempt"))
+	defer span.End()
 
-	for couponID, usageCount := range couponUsageCount {
-		valuesParts = append(valuesParts, fmt.Sprintf("($%d::uuid, $%d::integer)", argIndex, argIndex+1))
-		args = append(args, couponID, couponMap[couponID].UsageCount+usageCount)
-		argIndex += 2
+	// one payment per order item, amount of each item is part of total_amount of order
+	upsertSql := `insert into payment_history (order_item_id, user_payment_method_id, amount, currency, status, payment_gateway,
+				payment_gateway_response, error_message)
+			select oi.id, o.user_payment_method_id, oi.total_price - oi.discount_amount + oi.shipping_fee
+				+ case when o.prices_include_tax then 0 else oi.tax_amount end, o.currency, $2, $3,
+				jsonb_build_object('create', $4::jsonb), $5
+			from order_items oi
+			inner join orders o on oi.order_id = o.id
+			where oi.order_id = $1
+			on conflict (order_item_id) do update
+			set status = excluded.status,
+				payment_gateway = excluded.payment_gateway,
+				payment_gateway_response = coalesce(payment_history.payment_gateway_response, '{}'::jsonb) || excluded.payment_gateway_response,
+				error_message = excluded.error_message`
+
+	if err := r.db.Exec(ctx, upsertSql, orderID, paymentStatus, gateway, string(gatewayResponse), errorMessage); err != nil {
+		span.RecordError(err)
+		return status.Error(codes.Internal, err.Error())
 	}
 
-	if len(valuesParts) > 0 {
-		rawSQL := fmt.Sprintf(`
-			UPDATE coupons 
-			SET usage_count = updates.new_usage_count
-			FROM (VALUES %s) AS updates(coupon_id, new_usage_count)
-			WHERE coupons.id = updates.coupon_id
-		`, strings.Join(valuesParts, ", "))
+	return nil
+}
+
+func (r *paymentRepository) UpdateOrderPaymentResult(ctx context.Context, methodCode common.MethodType, data dto.PaymentResult,
+	nextStatus common.StatusOrder, paymentStatus common.PaymentStatus) ([]string, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "UpdateOrderPaymentResult"))
+	defer span.End()
+
+	var sagaIDs []string
 
-		if err := tx.Exec(ctx, rawSQL, args...); err != nil {
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		// lock order, so repeated ipn of the same order are handled one by one
+		var totalAmount money.Money
+		var shippingMethod common.MethodType
+		var currency string
+
+		if err := tx.QueryRow(ctx, `select total_amount, shipping_method, currency from orders where id = $1 for update`, data.OrderID).
+			Scan(&totalAmount, &shippingMethod, &currency); err != nil {
 			span.RecordError(err)
+
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.NotFound, "Order not found")
+			}
+
 			return status.Error(codes.Internal, err.Error())
 		}
+
+		if shippingMethod != methodCode {
+			return status.Errorf(codes.NotFound, "Order is not paid with %v", methodCode)
+		}
+
+		// gateway retries ipn until it receives response, so ignore transaction was handled
+		checkDuplicateSql := `select exists(
+				select 1 from payment_history ph
+				inner join order_items oi on ph.order_item_id = oi.id
+				where oi.order_id = $1 and ph.transaction_id = $2)`
+
+		var isHandled bool
+
+		if err := tx.QueryRow(ctx, checkDuplicateSql, data.OrderID, data.TransactionID).Scan(&isHandled); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if isHandled {
+			return status.Error(codes.AlreadyExists, "Payment of order was already confirmed")
+		}
+
+		// payment gateways charge total amount in whole units. Total amount is what was charged at checkout,
+		// so it still includes items which were closed before payment, their part is refunded below
+		if data.Amount != money.FromUnits(totalAmount.GatewayUnits()) {
+			return status.Errorf(codes.InvalidArgument, "Amount %s is not match with total amount of order", data.Amount)
+		}
+
+		// lock order items of order before change status
+		rows, err := tx.Query(ctx, `select id, status, reservation_id from order_items where order_id = $1 for update`, data.OrderID)
+
+		if err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		orderItems := make([]models.OrderItem, 0)
+
+		for rows.Next() {
+			var orderItem models.OrderItem
+
+			if err = rows.Scan(&orderItem.ID, &orderItem.Status, &orderItem.ReservationID); err != nil {
+				rows.Close()
+				span.RecordError(err)
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			orderItems = append(orderItems, orderItem)
+		}
+
+		rows.Close()
+
+		// items which customer cancelled or system closed before payment keep their status
+		openItems := make([]models.OrderItem, 0, len(orderItems))
+		openItemIDs := make([]string, 0, len(orderItems))
+		closedItemIDs := make([]string, 0)
+
+		for _, orderItem := range orderItems {
+			if orderItem.Status.IsClosed() {
+				closedItemIDs = append(closedItemIDs, orderItem.ID)
+				continue
+			}
+
+			// item which is not waiting for payment was paid by other transaction
+			if !orderItem.Status.CanTransitionTo(nextStatus, common.ActorSystem) {
+				return status.Errorf(codes.AlreadyExists, "Can not change status of order item from %v to %v",
+					orderItem.Status, nextStatus)
+			}
+
+			openItems = append(openItems, orderItem)
+			openItemIDs = append(openItemIDs, orderItem.ID)
+		}
+
+		if len(openItemIDs) > 0 {
+			sqlUpdate, args, err := squirrel.Update("order_items").
+				Set("status", nextStatus).
+				Where(squirrel.Eq{"id": openItemIDs}).
+				PlaceholderFormat(squirrel.Dollar).
+				ToSql()
+
+			if err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			if err = tx.Exec(ctx, sqlUpdate, args...); err != nil {
+				return status.Error(codes.Internal, err.Error())
+			}
+		}
+
+		for _, orderItem := range openItems {
+			if err = insertOrderItemHistory(ctx, tx, data.OrderID, orderItem.ID, orderItem.Status, nextStatus,
+				common.ActorSystem, nil, nil); err != nil {
+				span.RecordError(err)
+				return err
+			}
+		}
+
+		var errorMessage *string
+
+		if paymentStatus == common.PaymentStatusFailed {
+			errorMessage = &data.Message
+		}
+
+		// closed items were charged too when payment succeeded, so their payment is saved to be refunded
+		paidItemIDs := openItemIDs
+
+		if paymentStatus == common.PaymentStatusCompleted {
+			paidItemIDs = append(paidItemIDs, closedItemIDs...)
+		}
+
+		// save result of payment, create payment when it was not saved at checkout
+		upsertPaymentSql := `insert into payment_history (order_item_id, user_payment_method_id, amount, currency, status,
+				transaction_id, payment_gateway, payment_gateway_response, error_message, paid_at)
+			select oi.id, o.user_payment_method_id, oi.total_price - oi.discount_amount + oi.shipping_fee
+				+ case when o.prices_include_tax then 0 else oi.tax_amount end, o.currency, $2, $3, $4,
+				jsonb_build_object('ipn', $5::jsonb), $6, case when $2 = 'completed' then current_timestamp end
+			from order_items oi
+			inner join orders o on oi.order_id = o.id
+			where oi.order_id = $1 and oi.id = any($7)
+			on conflict (order_item_id) do update
+			set status = excluded.status,
+				transaction_id = excluded.transaction_id,
+				payment_gateway_response = coalesce(payment_history.payment_gateway_response, '{}'::jsonb) || excluded.payment_gateway_response,
+				error_message = excluded.error_message,
+				paid_at = excluded.paid_at`
+
+		if err = tx.Exec(ctx, upsertPaymentSql, data.OrderID, paymentStatus, data.TransactionID, methodCode,
+			data.RawData, errorMessage, paidItemIDs); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		// buyer paid for items which were closed before payment -> queue refund
+		if paymentStatus == common.PaymentStatusCompleted && len(closedItemIDs) > 0 {
+			// refund is queued on behalf of buyer, like refund of item which is cancelled after payment
+			insertRefundSql := `insert into payment_refunds (payment_history_id, amount, status, processed_by)
+				select ph.id, ph.amount, $1, o.user_id
+				from payment_history ph
+				inner join order_items oi on ph.order_item_id = oi.id
+				inner join orders o on oi.order_id = o.id
+				where ph.order_item_id = any($2) and ph.status = $3`
+
+			if err = tx.Exec(ctx, insertRefundSql, common.RefundStatusPending, closedItemIDs,
+				common.PaymentStatusCompleted); err != nil {
+				span.RecordError(err)
+				return status.Error(codes.Internal, err.Error())
+			}
+		}
+
+		paymentEvent := &order_proto_gen.OrderEvent{
+			EventType: string(common.OrderEventPaymentSucceeded),
+			OrderId:   data.OrderID,
+			Payload: &order_proto_gen.OrderEvent_PaymentSucceeded{
+				PaymentSucceeded: &order_proto_gen.PaymentSucceededEvent{
+					MethodCode:    string(methodCode),
+					TransactionId: data.TransactionID,
+					Amount:        dto.ToMoneyProto(data.Amount, currency),
+				},
+			},
+		}
+
+		if paymentStatus == common.PaymentStatusFailed {
+			paymentEvent = &order_proto_gen.OrderEvent{
+				EventType: string(common.OrderEventPaymentFailed),
+				OrderId:   data.OrderID,
+				Payload: &order_proto_gen.OrderEvent_PaymentFailed{
+					PaymentFailed: &order_proto_gen.PaymentFailedEvent{
+						MethodCode:    string(methodCode),
+						TransactionId: data.TransactionID,
+						Reason:        data.Message,
+					},
+				},
+			}
+		}
+
+		if err = insertOutboxEvent(ctx, tx, paymentEvent); err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		// buyer did not pay, so coupons of order can be used again
+		if paymentStatus == common.PaymentStatusFailed {
+			if err = reverseCouponRedemptions(ctx, tx, data.OrderID, openItemIDs); err != nil {
+				span.RecordError(err)
+				return err
+			}
+		}
+
+		// paid items keep their holds until supplier confirms them, holds of unpaid items are given back.
+		// reservations of closed items were released when they were closed
+		stockAction := common.OrderItemStockKeep
+
+		if paymentStatus == common.PaymentStatusFailed {
+			stockAction = common.OrderItemStockRelease
+		}
+
+		if sagaIDs, err = insertReservationStockSagas(ctx, tx, openItems, stockAction); err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return sagaIDs, nil
 }
 
-func (r *paymentRepository) UpdateOrderStatusFromMomo(ctx context.Context, data *order_proto_gen.UpdateOrderStatusFromMomoRequest) error {
-	return r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
-		sqlUpdate, args, err := squirrel.Update("order_items").
-			Set("status", data.Status).
-			Where(squirrel.Eq{"order_id": data.OrderId}).
-			PlaceholderFormat(squirrel.Dollar).
-			ToSql()
+func (r *paymentRepository) GetExpiredUnpaidOrders(ctx context.Context, createdBefore time.Time, limit int64) ([]models.Order, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetExpiredUnpaidOrders"))
+	defer span.End()
+
+	// cod orders never wait for payment, every other method is paid via payment gateway
+	selectSql := `select o.id, o.user_id, o.shipping_method, o.total_amount, o.created_at
+			from orders o
+			where o.shipping_method <> $1 and o.created_at < $2
+			and exists (select 1 from order_items oi where oi.order_id = o.id and oi.status = $3)
+			order by o.created_at asc
+			limit $4`
+
+	rows, err := r.db.Query(ctx, selectSql, common.Cod, createdBefore, common.PendingPayment, limit)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	defer rows.Close()
+
+	orders := make([]models.Order, 0)
+
+	for rows.Next() {
+		var order models.Order
+
+		if err = rows.Scan(&order.ID, &order.UserID, &order.ShippingMethod, &order.TotalAmount, &order.CreatedAt); err != nil {
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+func (r *paymentRepository) ExpireUnpaidOrder(ctx context.Context, orderID string, nextStatus common.StatusOrder,
+	reason string) (int, []string, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "ExpireUnpaidOrder"))
+	defer span.End()
+
+	var expiredItems int
+	var sagaIDs []string
+
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		// ipn may come at the same time, so only take items still waiting for payment
+		orderItems, err := cancelUnsettledOrderItems(ctx, tx, orderID,
+			[]common.StatusOrder{common.PendingPayment}, nextStatus, reason)
 
 		if err != nil {
-			return status.Error(codes.Internal, err.Error())
+			span.RecordError(err)
+			return err
 		}
 
-		if err = tx.Exec(ctx, sqlUpdate, args...); err != nil {
+		if sagaIDs, err = insertReservationStockSagas(ctx, tx, orderItems, common.OrderItemStockRelease); err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		expiredItems = len(orderItems)
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return expiredItems, sagaIDs, nil
+}
+
+func (r *paymentRepository) CancelCheckoutOrder(ctx context.Context, sagaID string, reason string) error {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "CancelCheckoutOrder"))
+	defer span.End()
+
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		var orderID string
+
+		if err := tx.QueryRow(ctx, `select id from orders where saga_id = $1`, sagaID).Scan(&orderID); err != nil {
+			// checkout failed before order was created
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+
+			span.RecordError(err)
 			return status.Error(codes.Internal, err.Error())
 		}
 
+		// reservations are released by checkout saga, so they are not released here
+		if _, err := cancelUnsettledOrderItems(ctx, tx, orderID,
+			[]common.StatusOrder{common.PendingPayment, common.Pending}, common.Cancelled, reason); err != nil {
+			span.RecordError(err)
+			return err
+		}
+
 		return nil
 	})
 }
+
+// cancelUnsettledOrderItems changes items of order which are in one of statuses to nextStatus by system,
+// coupon usages of them are given back and their pending payments are failed. It returns changed items,
+// their reservations must be released by caller
+func cancelUnsettledOrderItems(ctx context.Context, tx pkg.Tx, orderID string, statuses []common.StatusOrder,
+	nextStatus common.StatusOrder, reason string) ([]models.OrderItem, error) {
+	rows, err := tx.Query(ctx, `select id, status, reservation_id from order_items where order_id = $1 and status = any($2) for update`,
+		orderID, statuses)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	orderItems := make([]models.OrderItem, 0)
+	orderItemIDs := make([]string, 0)
+
+	for rows.Next() {
+		var orderItem models.OrderItem
+
+		if err = rows.Scan(&orderItem.ID, &orderItem.Status, &orderItem.ReservationID); err != nil {
+			rows.Close()
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if !orderItem.Status.CanTransitionTo(nextStatus, common.ActorSystem) {
+			rows.Close()
+			return nil, status.Errorf(codes.FailedPrecondition, "Can not change status of order item from %v to %v",
+				orderItem.Status, nextStatus)
+		}
+
+		orderItems = append(orderItems, orderItem)
+		orderItemIDs = append(orderItemIDs, orderItem.ID)
+	}
+
+	rows.Close()
+
+	if len(orderItemIDs) == 0 {
+		return orderItems, nil
+	}
+
+	updateSql := `update order_items set status = $1 where id = any($2)`
+
+	if nextStatus == common.Cancelled {
+		updateSql = `update order_items set status = $1, cancelled_reason = $3 where id = any($2)`
+	}
+
+	args := []interface{}{nextStatus, orderItemIDs}
+
+	if nextStatus == common.Cancelled {
+		args = append(args, reason)
+	}
+
+	if err = tx.Exec(ctx, updateSql, args...); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, orderItem := range orderItems {
+		if err = insertOrderItemHistory(ctx, tx, orderID, orderItem.ID, orderItem.Status, nextStatus,
+			common.ActorSystem, &reason, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	// give back coupon usage of cancelled items
+	if err = reverseCouponRedemptions(ctx, tx, orderID, orderItemIDs); err != nil {
+		return nil, err
+	}
+
+	updatePaymentSql := `update payment_history set status = $1, error_message = $2
+			where order_item_id = any($3) and status = $4`
+
+	if err = tx.Exec(ctx, updatePaymentSql, common.PaymentStatusFailed, reason, orderItemIDs,
+		common.PaymentStatusPending); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return orderItems, nil
+}
+
+// insertReservationStockSagas saves order item stock sagas which keep or release reservations of order items,
+// they are run after transaction is committed, so partner is not called while order is locked
+func insertReservationStockSagas(ctx context.Context, tx pkg.Tx, orderItems []models.OrderItem,
+	action common.OrderItemStockAction) ([]string, error) {
+	sagaIDs := make([]string, 0, len(orderItems))
+
+	for _, orderItem := range orderItems {
+		// order items created before reservation hold no stock until supplier confirms them
+		if orderItem.ReservationID == nil {
+			continue
+		}
+
+		sagaID, err := insertSaga(ctx, tx, common.SagaTypeOrderItemStock, dto.OrderItemStockSagaState{
+			OrderItemID:   orderItem.ID,
+			Action:        action,
+			ReservationID: orderItem.ReservationID,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		sagaIDs = append(sagaIDs, sagaID)
+	}
+
+	return sagaIDs, nil
+}
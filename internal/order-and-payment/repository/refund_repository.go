@@ -0,0 +1,354 @@
+package repository
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"slices"
+)
+
+type refundRepository struct {
+	tracer pkg.Tracer
+	db     pkg.Database
+}
+
+func NewRefundRepository(tracer pkg.Tracer, db pkg.Database) IRefundRepository {
+	return &refundRepository{
+		tracer: tracer,
+		db:     db,
+	}
+}
+
+func (r *refundRepository) CreateRefund(ctx context.Context, paymentHistoryID string, amount money.Money, processedBy int64) (*models.PaymentRefund, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "CreateRefund"))
+	defer span.End()
+
+	refund := models.PaymentRefund{
+		PaymentHistoryID: paymentHistoryID,
+		Amount:           amount,
+		Status:           common.RefundStatusPending,
+		ProcessedBy:      processedBy,
+	}
+
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		// lock payment to avoid refund over paid amount when admins refund at the same time
+		selectPaymentSql := `select amount, currency, status from payment_history where id = $1 for update`
+
+		var paymentAmount money.Money
+		var paymentStatus common.PaymentStatus
+
+		if err := tx.QueryRow(ctx, selectPaymentSql, paymentHistoryID).Scan(&paymentAmount, &refund.Currency,
+			&paymentStatus); err != nil {
+			span.RecordError(err)
+
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.NotFound, "Payment not found")
+			}
+
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		refundableStatus := []common.PaymentStatus{common.PaymentStatusCompleted, common.PaymentStatusPartiallyRefunded}
+
+		if !slices.Contains(refundableStatus, paymentStatus) {
+			return status.Errorf(codes.FailedPrecondition, "Payment with status %v can not be refunded", paymentStatus)
+		}
+
+		// failed refunds do not hold any money
+		sumRefundSql := `select coalesce(sum(amount), 0) from payment_refunds where payment_history_id = $1 and status <> $2`
+
+		var refundedAmount money.Money
+
+		if err := tx.QueryRow(ctx, sumRefundSql, paymentHistoryID, common.RefundStatusFailed).Scan(&refundedAmount); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if refundableAmount := paymentAmount.Sub(refundedAmount); amount.Cmp(refundableAmount) > 0 {
+			return status.Errorf(codes.FailedPrecondition,
+				"Refund amount %s exceeds refundable amount %s", amount, refundableAmount)
+		}
+
+		insertSql := `insert into payment_refunds (payment_history_id, amount, status, processed_by)
+				values ($1, $2, $3, $4) returning id`
+
+		if err := tx.QueryRow(ctx, insertSql, paymentHistoryID, amount, refund.Status, processedBy).Scan(&refund.ID); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &refund, nil
+}
+
+func (r *refundRepository) StartProcessingRefund(ctx context.Context, refundID string) (*models.PaymentRefund, *models.PaymentHistory, *models.Order, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "StartProcessingRefund"))
+	defer span.End()
+
+	var refund models.PaymentRefund
+	var payment models.PaymentHistory
+	var order models.Order
+
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		selectSql := `select pr.id, pr.payment_history_id, pr.amount, ph.currency, pr.status, pr.processed_by,
+				ph.order_item_id, ph.amount, ph.status, ph.transaction_id, ph.payment_gateway,
+				o.id, o.created_at
+				from payment_refunds pr
+				inner join payment_history ph on pr.payment_history_id = ph.id
+				inner join order_items oi on ph.order_item_id = oi.id
+				inner join orders o on oi.order_id = o.id
+				where pr.id = $1
+				for update of pr`
+
+		if err := tx.QueryRow(ctx, selectSql, refundID).Scan(&refund.ID, &refund.PaymentHistoryID, &refund.Amount,
+			&refund.Currency, &refund.Status, &refund.ProcessedBy, &payment.OrderItemID, &payment.Amount, &payment.Status,
+			&payment.TransactionID, &payment.PaymentGateway, &order.ID, &order.CreatedAt); err != nil {
+			span.RecordError(err)
+
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.NotFound, "Refund not found")
+			}
+
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		// failed refunds can be retried
+		processableStatus := []common.RefundStatus{common.RefundStatusPending, common.RefundStatusFailed}
+
+		if !slices.Contains(processableStatus, refund.Status) {
+			return status.Errorf(codes.FailedPrecondition, "Refund with status %v can not be processed", refund.Status)
+		}
+
+		// failed refund does not hold money, so other refunds could be created meanwhile. Lock payment like
+		// CreateRefund does and check refund amount is still refundable before it is retried
+		if refund.Status == common.RefundStatusFailed {
+			if err := tx.Exec(ctx, `select 1 from payment_history where id = $1 for update`, refund.PaymentHistoryID); err != nil {
+				span.RecordError(err)
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			sumRefundSql := `select coalesce(sum(amount), 0) from payment_refunds
+					where payment_history_id = $1 and status <> $2 and id <> $3`
+
+			var refundedAmount money.Money
+
+			if err := tx.QueryRow(ctx, sumRefundSql, refund.PaymentHistoryID, common.RefundStatusFailed, refundID).
+				Scan(&refundedAmount); err != nil {
+				span.RecordError(err)
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			if refundableAmount := payment.Amount.Sub(refundedAmount); refund.Amount.Cmp(refundableAmount) > 0 {
+				return status.Errorf(codes.FailedPrecondition,
+					"Refund amount %s exceeds refundable amount %s", refund.Amount, refundableAmount)
+			}
+		}
+
+		updateSql := `update payment_refunds set status = $1 where id = $2`
+
+		if err := tx.Exec(ctx, updateSql, common.RefundStatusProcessing, refundID); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		refund.Status = common.RefundStatusProcessing
+		payment.ID = refund.PaymentHistoryID
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return &refund, &payment, &order, nil
+}
+
+func (r *refundRepository) CompleteRefund(ctx context.Context, refundID, transactionID string, gatewayResponse []byte) (*models.PaymentRefund, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "CompleteRefund"))
+	defer span.End()
+
+	var refund models.PaymentRefund
+
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		updateRefundSql := `update payment_refunds
+				set status = $1, transaction_id = $2, payment_gateway_response = $3, refunded_at = current_timestamp
+				where id = $4 and status = $5
+				returning id, payment_history_id, amount, status, transaction_id, processed_by, refunded_at`
+
+		if err := tx.QueryRow(ctx, updateRefundSql, common.RefundStatusCompleted, transactionID, gatewayResponse,
+			refundID, common.RefundStatusProcessing).Scan(&refund.ID, &refund.PaymentHistoryID, &refund.Amount,
+			&refund.Status, &refund.TransactionID, &refund.ProcessedBy, &refund.RefundedAt); err != nil {
+			span.RecordError(err)
+
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.FailedPrecondition, "Refund is not in processing status")
+			}
+
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		selectPaymentSql := `select ph.order_item_id, oi.order_id, ph.amount, ph.currency,
+				(select coalesce(sum(pr.amount), 0) from payment_refunds pr where pr.payment_history_id = ph.id and pr.status = $1)
+				from payment_history ph
+				inner join order_items oi on ph.order_item_id = oi.id
+				where ph.id = $2
+				for update of ph`
+
+		var orderItemID, orderID string
+		var paymentAmount, refundedAmount money.Money
+
+		if err := tx.QueryRow(ctx, selectPaymentSql, common.RefundStatusCompleted, refund.PaymentHistoryID).
+			Scan(&orderItemID, &orderID, &paymentAmount, &refund.Currency, &refundedAmount); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		paymentStatus := common.PaymentStatusPartiallyRefunded
+
+		if refundedAmount.Cmp(paymentAmount) >= 0 {
+			paymentStatus = common.PaymentStatusRefunded
+		}
+
+		updatePaymentSql := `update payment_history set status = $1 where id = $2`
+
+		if err := tx.Exec(ctx, updatePaymentSql, paymentStatus, refund.PaymentHistoryID); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if err := insertOutboxEvent(ctx, tx, &order_proto_gen.OrderEvent{
+			EventType: string(common.OrderEventRefundCompleted),
+			OrderId:   orderID,
+			Payload: &order_proto_gen.OrderEvent_RefundCompleted{
+				RefundCompleted: &order_proto_gen.RefundCompletedEvent{
+					RefundId:      refund.ID,
+					OrderItemId:   orderItemID,
+					Amount:        dto.ToMoneyProto(refund.Amount, refund.Currency),
+					TransactionId: refund.TransactionID,
+					PaymentStatus: string(paymentStatus),
+				},
+			},
+		}); err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		// order item is only refunded when whole paid amount was given back
+		if paymentStatus != common.PaymentStatusRefunded {
+			return nil
+		}
+
+		var orderItemStatus common.StatusOrder
+
+		if err := tx.QueryRow(ctx, `select status from order_items where id = $1 for update`, orderItemID).
+			Scan(&orderItemStatus); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		// money is already given back by gateway, so keep status of order item when it is being shipped
+		if !orderItemStatus.CanTransitionTo(common.Refunded, common.ActorSystem) {
+			return nil
+		}
+
+		updateOrderItemSql := `update order_items set status = $1 where id = $2`
+
+		if err := tx.Exec(ctx, updateOrderItemSql, common.Refunded, orderItemID); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if err := insertOrderItemHistory(ctx, tx, orderID, orderItemID, orderItemStatus, common.Refunded,
+			common.ActorSystem, nil, &refund.ProcessedBy); err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &refund, nil
+}
+
+func (r *refundRepository) FailRefund(ctx context.Context, refundID string, gatewayResponse []byte) (*models.PaymentRefund, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "FailRefund"))
+	defer span.End()
+
+	updateSql := `update payment_refunds set status = $1, payment_gateway_response = $2
+			where id = $3 and status = $4
+			returning id, payment_history_id, amount,
+				(select currency from payment_history where id = payment_refunds.payment_history_id),
+				status, transaction_id, processed_by, refunded_at`
+
+	var refund models.PaymentRefund
+
+	if err := r.db.QueryRow(ctx, updateSql, common.RefundStatusFailed, gatewayResponse, refundID,
+		common.RefundStatusProcessing).Scan(&refund.ID, &refund.PaymentHistoryID, &refund.Amount, &refund.Currency, &refund.Status,
+		&refund.TransactionID, &refund.ProcessedBy, &refund.RefundedAt); err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.FailedPrecondition, "Refund is not in processing status")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &refund, nil
+}
+
+func (r *refundRepository) GetRefunds(ctx context.Context, paymentHistoryID string) ([]models.PaymentRefund, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetRefunds"))
+	defer span.End()
+
+	selectSql := `select pr.id, pr.payment_history_id, pr.amount, ph.currency, pr.status, pr.transaction_id,
+				pr.processed_by, pr.refunded_at
+			from payment_refunds pr
+			inner join payment_history ph on pr.payment_history_id = ph.id
+			where pr.payment_history_id = $1`
+
+	rows, err := r.db.Query(ctx, selectSql, paymentHistoryID)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	defer rows.Close()
+
+	refunds := make([]models.PaymentRefund, 0)
+
+	for rows.Next() {
+		var refund models.PaymentRefund
+
+		if err = rows.Scan(&refund.ID, &refund.PaymentHistoryID, &refund.Amount, &refund.Currency, &refund.Status,
+			&refund.TransactionID, &refund.ProcessedBy, &refund.RefundedAt); err != nil {
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		refunds = append(refunds, refund)
+	}
+
+	return refunds, nil
+}
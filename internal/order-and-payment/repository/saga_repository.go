@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+const sagaColumns = `id, saga_type, status, current_step, state, last_error, attempts, next_attempt_at, created_at, updated_at`
+
+type sagaRepository struct {
+	tracer pkg.Tracer
+	db     pkg.Database
+}
+
+func NewSagaRepository(tracer pkg.Tracer, db pkg.Database) ISagaRepository {
+	return &sagaRepository{
+		tracer: tracer,
+		db:     db,
+	}
+}
+
+func (r *sagaRepository) CreateSaga(ctx context.Context, sagaType common.SagaType, state []byte) (*models.Saga, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "CreateSaga"))
+	defer span.End()
+
+	insertSql := `insert into sagas (saga_type, status, state) values ($1, $2, $3) returning ` + sagaColumns
+
+	saga, err := scanSaga(r.db.QueryRow(ctx, insertSql, sagaType, common.SagaStatusRunning, state))
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return saga, nil
+}
+
+func (r *sagaRepository) GetSaga(ctx context.Context, sagaID string) (*models.Saga, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetSaga"))
+	defer span.End()
+
+	saga, err := scanSaga(r.db.QueryRow(ctx, `select `+sagaColumns+` from sagas where id = $1`, sagaID))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "saga not found")
+		}
+
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return saga, nil
+}
+
+func (r *sagaRepository) UpdateSaga(ctx context.Context, saga *models.Saga) error {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "UpdateSaga"))
+	defer span.End()
+
+	updateSql := `update sagas
+			set status = $1, current_step = $2, state = $3, last_error = $4, attempts = $5, next_attempt_at = $6
+			where id = $7`
+
+	if err := r.db.Exec(ctx, updateSql, saga.Status, saga.CurrentStep, saga.State, saga.LastError, saga.Attempts,
+		saga.NextAttemptAt, saga.ID); err != nil {
+		span.RecordError(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func (r *sagaRepository) ClaimStaleSagas(ctx context.Context, staleBefore time.Time, limit int64) ([]models.Saga, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "ClaimStaleSagas"))
+	defer span.End()
+
+	// touching updated_at makes claimed sagas not stale, so they are not claimed again while being resumed
+	claimSql := `update sagas
+			set updated_at = current_timestamp
+			where id in (
+				select id from sagas
+				where status in ($1, $2) and updated_at < $3 and next_attempt_at <= current_timestamp
+				order by updated_at asc
+				limit $4
+				for update skip locked
+			)
+			returning ` + sagaColumns
+
+	rows, err := r.db.Query(ctx, claimSql, common.SagaStatusRunning, common.SagaStatusCompensating, staleBefore, limit)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	defer rows.Close()
+
+	sagas := make([]models.Saga, 0)
+
+	for rows.Next() {
+		saga, errScan := scanSaga(rows)
+
+		if errScan != nil {
+			span.RecordError(errScan)
+			return nil, status.Error(codes.Internal, errScan.Error())
+		}
+
+		sagas = append(sagas, *saga)
+	}
+
+	return sagas, nil
+}
+
+func scanSaga(row pkg.Row) (*models.Saga, error) {
+	var saga models.Saga
+
+	if err := row.Scan(&saga.ID, &saga.SagaType, &saga.Status, &saga.CurrentStep, &saga.State, &saga.LastError,
+		&saga.Attempts, &saga.NextAttemptAt, &saga.CreatedAt, &saga.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	return &saga, nil
+}
+
+// insertSaga saves saga in transaction of change which starts it, so saga is only run when change is committed.
+// Saga is picked up by recoverer if it is not continued after commit
+func insertSaga(ctx context.Context, tx pkg.Tx, sagaType common.SagaType, state any) (string, error) {
+	rawState, err := json.Marshal(state)
+
+	if err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	var sagaID string
+
+	if err = tx.QueryRow(ctx, `insert into sagas (saga_type, status, state) values ($1, $2, $3) returning id`,
+		sagaType, common.SagaStatusRunning, rawState).Scan(&sagaID); err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	return sagaID, nil
+}
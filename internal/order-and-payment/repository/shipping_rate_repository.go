@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type shippingRateRepository struct {
+	tracer pkg.Tracer
+	db     pkg.Database
+}
+
+func NewShippingRateRepository(tracer pkg.Tracer, db pkg.Database) IShippingRateRepository {
+	return &shippingRateRepository{
+		tracer: tracer,
+		db:     db,
+	}
+}
+
+func (r *shippingRateRepository) GetShippingRates(ctx context.Context, shippingClasses []string) ([]models.ShippingRate, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetShippingRates"))
+	defer span.End()
+
+	queryBuilder := squirrel.Select("id", "shipping_class", "zone", "base_fee", "base_weight_grams",
+		"fee_per_extra_kg", "created_at", "updated_at").
+		From("shipping_rates").
+		OrderBy("shipping_class asc", "zone asc").
+		PlaceholderFormat(squirrel.Dollar)
+
+	if len(shippingClasses) > 0 {
+		queryBuilder = queryBuilder.Where(squirrel.Eq{"shipping_class": shippingClasses})
+	}
+
+	query, args, err := queryBuilder.ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	defer rows.Close()
+
+	shippingRates := make([]models.ShippingRate, 0)
+
+	for rows.Next() {
+		var shippingRate models.ShippingRate
+
+		if err = rows.Scan(&shippingRate.ID, &shippingRate.ShippingClass, &shippingRate.Zone, &shippingRate.BaseFee,
+			&shippingRate.BaseWeightGrams, &shippingRate.FeePerExtraKg, &shippingRate.CreatedAt, &shippingRate.UpdatedAt); err != nil {
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		shippingRates = append(shippingRates, shippingRate)
+	}
+
+	return shippingRates, nil
+}
+
+func (r *shippingRateRepository) CreateShippingRate(ctx context.Context, data *order_proto_gen.CreateShippingRateRequest) (int64, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "CreateShippingRate"))
+	defer span.End()
+
+	query, args, err := squirrel.Insert("shipping_rates").
+		Columns("shipping_class", "zone", "base_fee", "base_weight_grams", "fee_per_extra_kg").
+		Values(data.ShippingClass, data.Zone, dto.FromMoneyProto(data.BaseFee), data.BaseWeightGrams, dto.FromMoneyProto(data.FeePerExtraKg)).
+		Suffix("on conflict (shipping_class, zone) do nothing returning id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+
+	var id int64
+
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, status.Errorf(codes.AlreadyExists, "Shipping class %s already has rate for zone %s",
+				data.ShippingClass, data.Zone)
+		}
+
+		span.RecordError(err)
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return id, nil
+}
+
+func (r *shippingRateRepository) UpdateShippingRate(ctx context.Context, data *order_proto_gen.UpdateShippingRateRequest) error {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "UpdateShippingRate"))
+	defer span.End()
+
+	query, args, err := squirrel.Update("shipping_rates").
+		Set("base_fee", dto.FromMoneyProto(data.BaseFee)).
+		Set("base_weight_grams", data.BaseWeightGrams).
+		Set("fee_per_extra_kg", dto.FromMoneyProto(data.FeePerExtraKg)).
+		Where(squirrel.Eq{"id": data.Id}).
+		Suffix("returning id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	var id int64
+
+	if err = r.db.QueryRow(ctx, query, args...).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.NotFound, "Shipping rate not found")
+		}
+
+		span.RecordError(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func (r *shippingRateRepository) DeleteShippingRate(ctx context.Context, id int64) error {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "DeleteShippingRate"))
+	defer span.End()
+
+	var deletedID int64
+
+	if err := r.db.QueryRow(ctx, `delete from shipping_rates where id = $1 returning id`, id).Scan(&deletedID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Error(codes.NotFound, "Shipping rate not found")
+		}
+
+		span.RecordError(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
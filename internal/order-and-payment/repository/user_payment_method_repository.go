@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type userPaymentMethodRepository struct {
+	tracer pkg.Tracer
+	db     pkg.Database
+}
+
+func NewUserPaymentMethodRepository(tracer pkg.Tracer, db pkg.Database) IUserPaymentMethodRepository {
+	return &userPaymentMethodRepository{
+		tracer: tracer,
+		db:     db,
+	}
+}
+
+// selectUserPaymentMethodSql never returns deleted payment methods
+const selectUserPaymentMethodSql = `select upm.id, upm.user_id, upm.payment_method_id, pm.code, pm.name, upm.is_default,
+		upm.card_holder_name, upm.provider_token, upm.encrypted_card_number, coalesce(upm.card_last4, ''), upm.card_brand,
+		upm.card_expiry_month, upm.card_expiry_year, upm.created_at, upm.updated_at
+		from user_payment_methods upm
+		inner join payment_methods pm on upm.payment_method_id = pm.id
+		where upm.deleted_at is null`
+
+func (r *userPaymentMethodRepository) GetUserPaymentMethods(ctx context.Context, userID int64) ([]models.UserPaymentMethod, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetUserPaymentMethods"))
+	defer span.End()
+
+	rows, err := r.db.Query(ctx, selectUserPaymentMethodSql+` and upm.user_id = $1 order by upm.is_default desc, upm.created_at desc`,
+		userID)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	defer rows.Close()
+
+	userPaymentMethods := make([]models.UserPaymentMethod, 0)
+
+	for rows.Next() {
+		userPaymentMethod, err := scanUserPaymentMethod(rows)
+
+		if err != nil {
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		userPaymentMethods = append(userPaymentMethods, *userPaymentMethod)
+	}
+
+	return userPaymentMethods, nil
+}
+
+func (r *userPaymentMethodRepository) GetUserPaymentMethodByID(ctx context.Context, userID, userPaymentMethodID int64) (*models.UserPaymentMethod, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetUserPaymentMethodByID"))
+	defer span.End()
+
+	userPaymentMethod, err := scanUserPaymentMethod(r.db.QueryRow(ctx,
+		selectUserPaymentMethodSql+` and upm.user_id = $1 and upm.id = $2`, userID, userPaymentMethodID))
+
+	if err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "Payment method not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return userPaymentMethod, nil
+}
+
+func (r *userPaymentMethodRepository) CreateUserPaymentMethod(ctx context.Context, data models.UserPaymentMethod) (*models.UserPaymentMethod, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "CreateUserPaymentMethod"))
+	defer span.End()
+
+	var userPaymentMethodID int64
+
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		selectMethodSql := `select id from payment_methods where code = $1 and is_active = true`
+
+		if err := tx.QueryRow(ctx, selectMethodSql, data.PaymentMethodCode).Scan(&data.PaymentMethodID); err != nil {
+			span.RecordError(err)
+
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Errorf(codes.FailedPrecondition, "Payment method %v is not supported", data.PaymentMethodCode)
+			}
+
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		// lock payment methods of user, so two requests do not make two default methods
+		lockSql := `select id from user_payment_methods where user_id = $1 and deleted_at is null for update`
+
+		rows, err := tx.Query(ctx, lockSql, data.UserID)
+
+		if err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		var existed int
+
+		for rows.Next() {
+			existed++
+		}
+
+		rows.Close()
+
+		// first payment method of user is default one
+		if existed == 0 {
+			data.IsDefault = true
+		}
+
+		if data.IsDefault {
+			if err = unsetDefaultUserPaymentMethod(ctx, tx, data.UserID); err != nil {
+				span.RecordError(err)
+				return err
+			}
+		}
+
+		insertSql := `insert into user_payment_methods (user_id, payment_method_id, is_default, card_holder_name,
+				provider_token, encrypted_card_number, card_last4, card_brand, card_expiry_month, card_expiry_year)
+				values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+				returning id`
+
+		if err = tx.QueryRow(ctx, insertSql, data.UserID, data.PaymentMethodID, data.IsDefault, data.CardHolderName,
+			data.ProviderToken, data.EncryptedCardNumber, data.CardLast4, data.CardBrand, data.CardExpiryMonth,
+			data.CardExpiryYear).Scan(&userPaymentMethodID); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetUserPaymentMethodByID(ctx, data.UserID, userPaymentMethodID)
+}
+
+func (r *userPaymentMethodRepository) SetDefaultUserPaymentMethod(ctx context.Context, userID, userPaymentMethodID int64) error {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "SetDefaultUserPaymentMethod"))
+	defer span.End()
+
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		if _, err := lockUserPaymentMethod(ctx, tx, userID, userPaymentMethodID); err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		if err := unsetDefaultUserPaymentMethod(ctx, tx, userID); err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		updateSql := `update user_payment_methods set is_default = true where id = $1`
+
+		if err := tx.Exec(ctx, updateSql, userPaymentMethodID); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		return nil
+	})
+}
+
+// DeleteUserPaymentMethod keeps row for payment history, but card data of it is removed
+func (r *userPaymentMethodRepository) DeleteUserPaymentMethod(ctx context.Context, userID, userPaymentMethodID int64) error {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "DeleteUserPaymentMethod"))
+	defer span.End()
+
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		wasDefault, err := lockUserPaymentMethod(ctx, tx, userID, userPaymentMethodID)
+
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		deleteSql := `update user_payment_methods
+				set deleted_at = current_timestamp, is_default = false, provider_token = null, encrypted_card_number = null
+				where id = $1`
+
+		if err = tx.Exec(ctx, deleteSql, userPaymentMethodID); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if !wasDefault {
+			return nil
+		}
+
+		// latest payment method becomes default one
+		promoteSql := `update user_payment_methods set is_default = true
+				where id = (
+					select id from user_payment_methods
+					where user_id = $1 and deleted_at is null
+					order by created_at desc
+					limit 1
+				)`
+
+		if err = tx.Exec(ctx, promoteSql, userID); err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		return nil
+	})
+}
+
+// lockUserPaymentMethod locks payment method of user and returns whether it is default one
+func lockUserPaymentMethod(ctx context.Context, tx pkg.Tx, userID, userPaymentMethodID int64) (bool, error) {
+	lockSql := `select is_default from user_payment_methods where id = $1 and user_id = $2 and deleted_at is null for update`
+
+	var isDefault bool
+
+	if err := tx.QueryRow(ctx, lockSql, userPaymentMethodID, userID).Scan(&isDefault); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, status.Error(codes.NotFound, "Payment method not found")
+		}
+
+		return false, status.Error(codes.Internal, err.Error())
+	}
+
+	return isDefault, nil
+}
+
+func unsetDefaultUserPaymentMethod(ctx context.Context, tx pkg.Tx, userID int64) error {
+	updateSql := `update user_payment_methods set is_default = false where user_id = $1 and is_default = true`
+
+	if err := tx.Exec(ctx, updateSql, userID); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func scanUserPaymentMethod(row pkg.Row) (*models.UserPaymentMethod, error) {
+	var userPaymentMethod models.UserPaymentMethod
+
+	if err := row.Scan(&userPaymentMethod.ID, &userPaymentMethod.UserID, &userPaymentMethod.PaymentMethodID,
+		&userPaymentMethod.PaymentMethodCode, &userPaymentMethod.PaymentMethodName, &userPaymentMethod.IsDefault,
+		&userPaymentMethod.CardHolderName, &userPaymentMethod.ProviderToken, &userPaymentMethod.EncryptedCardNumber,
+		&userPaymentMethod.CardLast4, &userPaymentMethod.CardBrand, &userPaymentMethod.CardExpiryMonth,
+		&userPaymentMethod.CardExpiryYear, &userPaymentMethod.CreatedAt, &userPaymentMethod.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	return &userPaymentMethod, nil
+}
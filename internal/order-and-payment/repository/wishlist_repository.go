@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"github.com/Masterminds/squirrel"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type wishlistRepository struct {
+	tracer pkg.Tracer
+	db     pkg.Database
+}
+
+func NewWishlistRepository(tracer pkg.Tracer, db pkg.Database) IWishlistRepository {
+	return &wishlistRepository{
+		tracer: tracer,
+		db:     db,
+	}
+}
+
+func (w *wishlistRepository) AddWishlistItem(ctx context.Context, userID int64, item models.WishlistItem) (string, error) {
+	ctx, span := w.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "AddWishlistItem"))
+	defer span.End()
+
+	var wishlistItemID string
+
+	err := w.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		var err error
+
+		wishlistItemID, err = w.insertWishlistItem(ctx, tx, userID, item)
+
+		if err != nil {
+			span.RecordError(err)
+		}
+
+		return err
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return wishlistItemID, nil
+}
+
+func (w *wishlistRepository) GetWishlistItems(ctx context.Context, userID int64) ([]models.WishlistItem, error) {
+	ctx, span := w.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetWishlistItems"))
+	defer span.End()
+
+	query, args, err := squirrel.Select("wi.id", "wi.wishlist_id", "wi.product_id", "wi.product_variant_id",
+		"wi.saved_price", "wi.currency", "wi.added_at", "wi.updated_at").
+		From("wishlist_items wi").
+		Join("wishlists w on w.id = wi.wishlist_id").
+		Where(squirrel.Eq{"w.user_id": userID}).
+		OrderBy("wi.added_at desc").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	rows, err := w.db.Query(ctx, query, args...)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	defer rows.Close()
+
+	items := make([]models.WishlistItem, 0)
+
+	for rows.Next() {
+		var item models.WishlistItem
+
+		if err = rows.Scan(&item.ID, &item.WishlistID, &item.ProductID, &item.ProductVariantID, &item.SavedPrice,
+			&item.Currency, &item.AddedAt, &item.UpdatedAt); err != nil {
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func (w *wishlistRepository) GetWishlistItem(ctx context.Context, userID int64, wishlistItemID string) (*models.WishlistItem, error) {
+	ctx, span := w.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "GetWishlistItem"))
+	defer span.End()
+
+	query, args, err := squirrel.Select("wi.id", "wi.wishlist_id", "wi.product_id", "wi.product_variant_id",
+		"wi.saved_price", "wi.currency", "wi.added_at", "wi.updated_at").
+		From("wishlist_items wi").
+		Join("wishlists w on w.id = wi.wishlist_id").
+		Where(squirrel.Eq{"w.user_id": userID}).
+		Where(squirrel.Eq{"wi.id": wishlistItemID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var item models.WishlistItem
+
+	if err = w.db.QueryRow(ctx, query, args...).Scan(&item.ID, &item.WishlistID, &item.ProductID,
+		&item.ProductVariantID, &item.SavedPrice, &item.Currency, &item.AddedAt, &item.UpdatedAt); err != nil {
+		span.RecordError(err)
+
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "Wishlist item not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &item, nil
+}
+
+func (w *wishlistRepository) DeleteWishlistItems(ctx context.Context, userID int64, wishlistItemIDs []string) error {
+	ctx, span := w.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "DeleteWishlistItems"))
+	defer span.End()
+
+	sqlDelete, args, err := squirrel.Delete("wishlist_items").
+		Where(squirrel.Eq{"id": wishlistItemIDs}).
+		Where("wishlist_id in (select id from wishlists where user_id = ?)", userID).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+
+	if err != nil {
+		span.RecordError(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if err = w.db.Exec(ctx, sqlDelete, args...); err != nil {
+		span.RecordError(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func (w *wishlistRepository) MoveCartItemToWishlist(ctx context.Context, userID int64, cartItemID string, item models.WishlistItem) (string, error) {
+	ctx, span := w.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "MoveCartItemToWishlist"))
+	defer span.End()
+
+	var wishlistItemID string
+
+	err := w.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		var err error
+
+		wishlistItemID, err = w.insertWishlistItem(ctx, tx, userID, item)
+
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		var deletedID string
+		sqlDelete := `delete from cart_items
+			where id = $1 and cart_id in (select id from carts where user_id = $2)
+			returning id`
+
+		if err = tx.QueryRow(ctx, sqlDelete, cartItemID, userID).Scan(&deletedID); err != nil {
+			span.RecordError(err)
+
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.NotFound, "Cart item not found")
+			}
+
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return wishlistItemID, nil
+}
+
+// insertWishlistItem creates wishlist of user when it does not exist. Item which is already in wishlist keeps
+// its saved price, so price drop is still shown after it is saved again
+func (w *wishlistRepository) insertWishlistItem(ctx context.Context, tx pkg.Tx, userID int64, item models.WishlistItem) (string, error) {
+	var wishlistID int64
+	sqlUpsertWishlist := `insert into wishlists (user_id) values ($1)
+		on conflict (user_id) do update set user_id = excluded.user_id
+		returning id`
+
+	if err := tx.QueryRow(ctx, sqlUpsertWishlist, userID).Scan(&wishlistID); err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	var wishlistItemID string
+	sqlInsert := `insert into wishlist_items (wishlist_id, product_id, product_variant_id, saved_price, currency)
+		values ($1, $2, $3, $4, $5)
+		on conflict (wishlist_id, product_variant_id) do update set product_variant_id = excluded.product_variant_id
+		returning id`
+
+	if err := tx.QueryRow(ctx, sqlInsert, wishlistID, item.ProductID, item.ProductVariantID, item.SavedPrice,
+		item.Currency).Scan(&wishlistItemID); err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	return wishlistItemID, nil
+}
@@ -0,0 +1,266 @@
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/env"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/repository"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"math"
+	"sync"
+	"time"
+)
+
+// maxBackoffSeconds limits wait time before failed step of saga is retried
+const maxBackoffSeconds = 300
+
+type coordinator struct {
+	tracer      pkg.Tracer
+	sagaRepo    repository.ISagaRepository
+	envManager  *env.EnvManager
+	mu          sync.RWMutex
+	definitions map[common.SagaType]Definition
+}
+
+func NewCoordinator(tracer pkg.Tracer, sagaRepo repository.ISagaRepository, envManager *env.EnvManager) ICoordinator {
+	return &coordinator{
+		tracer:      tracer,
+		sagaRepo:    sagaRepo,
+		envManager:  envManager,
+		definitions: make(map[common.SagaType]Definition),
+	}
+}
+
+func (c *coordinator) Register(definition Definition) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.definitions[definition.Type] = definition
+}
+
+func (c *coordinator) Start(ctx context.Context, sagaType common.SagaType, state any) (string, error) {
+	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "Start"))
+	defer span.End()
+
+	definition, ok := c.getDefinition(sagaType)
+
+	if !ok {
+		return "", status.Error(codes.Internal, fmt.Sprintf("saga %s is not registered", sagaType))
+	}
+
+	rawState, err := json.Marshal(state)
+
+	if err != nil {
+		span.RecordError(err)
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	saga, err := c.sagaRepo.CreateSaga(ctx, sagaType, rawState)
+
+	if err != nil {
+		return "", err
+	}
+
+	return saga.ID, c.run(ctx, definition, saga, state)
+}
+
+func (c *coordinator) Continue(ctx context.Context, sagaID string) error {
+	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "Continue"))
+	defer span.End()
+
+	saga, err := c.sagaRepo.GetSaga(ctx, sagaID)
+
+	if err != nil {
+		return err
+	}
+
+	definition, ok := c.getDefinition(saga.SagaType)
+
+	if !ok {
+		return status.Error(codes.Internal, fmt.Sprintf("saga %s is not registered", saga.SagaType))
+	}
+
+	state := definition.NewState()
+
+	if err = json.Unmarshal(saga.State, state); err != nil {
+		span.RecordError(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return c.run(ctx, definition, saga, state)
+}
+
+func (c *coordinator) ResumeSagas(ctx context.Context) error {
+	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "ResumeSagas"))
+	defer span.End()
+
+	config := c.envManager.OrderAndPaymentServerConfig
+	staleBefore := time.Now().Add(-time.Duration(config.SagaStaleTimeout) * time.Second)
+
+	sagas, err := c.sagaRepo.ClaimStaleSagas(ctx, staleBefore, int64(config.SagaRecoverBatchSize))
+
+	if err != nil {
+		return err
+	}
+
+	for i := range sagas {
+		saga := &sagas[i]
+		definition, ok := c.getDefinition(saga.SagaType)
+
+		if !ok {
+			log.Printf("Skip saga %s, type %s is not registered", saga.ID, saga.SagaType)
+			continue
+		}
+
+		state := definition.NewState()
+
+		if err = json.Unmarshal(saga.State, state); err != nil {
+			span.RecordError(err)
+			log.Printf("Failed to decode state of saga %s: %v", saga.ID, err)
+			continue
+		}
+
+		// requester of saga with backward recovery is gone, so step which was interrupted is not retried
+		// and saga is undone
+		if saga.Status == common.SagaStatusRunning && definition.Recovery == RecoveryBackward {
+			saga.Status = common.SagaStatusCompensating
+
+			if err = c.save(ctx, saga, state); err != nil {
+				log.Printf("Failed to start compensation of saga %s: %v", saga.ID, err)
+				continue
+			}
+		}
+
+		if err = c.run(ctx, definition, saga, state); err != nil {
+			log.Printf("Failed to resume saga %s: %v", saga.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// run executes remaining steps of running saga or compensates compensating saga
+func (c *coordinator) run(ctx context.Context, definition Definition, saga *models.Saga, state any) error {
+	if saga.Status == common.SagaStatusCompensating {
+		return c.compensate(ctx, definition, saga, state)
+	}
+
+	if saga.Status != common.SagaStatusRunning {
+		return nil
+	}
+
+	for saga.CurrentStep < len(definition.Steps) {
+		step := definition.Steps[saga.CurrentStep]
+
+		if errStep := step.Execute(ctx, saga.ID, state); errStep != nil {
+			if definition.Recovery == RecoveryForward {
+				c.retryLater(saga, step.Name, errStep)
+
+				if err := c.save(ctx, saga, state); err != nil {
+					return err
+				}
+
+				return errStep
+			}
+
+			lastError := fmt.Sprintf("step %s: %v", step.Name, errStep)
+			saga.Status = common.SagaStatusCompensating
+			saga.LastError = &lastError
+
+			if err := c.save(ctx, saga, state); err != nil {
+				return errStep
+			}
+
+			if err := c.compensate(ctx, definition, saga, state); err != nil {
+				log.Printf("Failed to compensate saga %s, it is retried later: %v", saga.ID, err)
+			}
+
+			return errStep
+		}
+
+		saga.CurrentStep++
+		saga.Attempts = 0
+
+		if saga.CurrentStep == len(definition.Steps) {
+			saga.Status = common.SagaStatusCompleted
+		}
+
+		if err := c.save(ctx, saga, state); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// compensate undoes steps from current step down to first step, saga is left compensating and retried later
+// when compensation of step fails
+func (c *coordinator) compensate(ctx context.Context, definition Definition, saga *models.Saga, state any) error {
+	for saga.CurrentStep >= 0 {
+		if saga.CurrentStep < len(definition.Steps) {
+			step := definition.Steps[saga.CurrentStep]
+
+			if step.Compensate != nil {
+				if errStep := step.Compensate(ctx, saga.ID, state); errStep != nil {
+					c.retryLater(saga, step.Name, errStep)
+
+					if err := c.save(ctx, saga, state); err != nil {
+						return err
+					}
+
+					return errStep
+				}
+			}
+		}
+
+		saga.CurrentStep--
+		saga.Attempts = 0
+
+		if saga.CurrentStep < 0 {
+			saga.Status = common.SagaStatusCompensated
+		}
+
+		if err := c.save(ctx, saga, state); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *coordinator) retryLater(saga *models.Saga, stepName string, errStep error) {
+	lastError := fmt.Sprintf("step %s: %v", stepName, errStep)
+	backoff := math.Min(math.Pow(2, float64(saga.Attempts)), maxBackoffSeconds)
+
+	saga.Attempts++
+	saga.LastError = &lastError
+	saga.NextAttemptAt = time.Now().Add(time.Duration(backoff) * time.Second)
+}
+
+func (c *coordinator) save(ctx context.Context, saga *models.Saga, state any) error {
+	rawState, err := json.Marshal(state)
+
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	saga.State = rawState
+
+	return c.sagaRepo.UpdateSaga(ctx, saga)
+}
+
+func (c *coordinator) getDefinition(sagaType common.SagaType) (Definition, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	definition, ok := c.definitions[sagaType]
+
+	return definition, ok
+}
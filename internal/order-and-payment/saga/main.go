@@ -0,0 +1,43 @@
+package saga
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+)
+
+// Recovery is what coordinator does when step of saga fails or saga is resumed after crash
+type Recovery int
+
+const (
+	// RecoveryBackward compensates failed step and steps before it in reverse order
+	RecoveryBackward Recovery = iota
+	// RecoveryForward retries failed step later until it succeeds, it is used when done steps must not be undone
+	RecoveryForward
+)
+
+type Step struct {
+	Name    string
+	Execute func(ctx context.Context, sagaID string, state any) error
+	// Compensate undoes Execute, it is nil when step has nothing to undo. It is also called for step which failed
+	// or was interrupted, so it must be safe to call when Execute was not done or only partly done
+	Compensate func(ctx context.Context, sagaID string, state any) error
+}
+
+type Definition struct {
+	Type     common.SagaType
+	Recovery Recovery
+	// NewState returns pointer to empty state of saga, state is saved as json after each step
+	NewState func() any
+	Steps    []Step
+}
+
+type ICoordinator interface {
+	Register(definition Definition)
+	// Start saves saga with state and runs its steps, state must be pointer which is returned by NewState of
+	// definition. Error of failed step is returned after compensation of saga with backward recovery
+	Start(ctx context.Context, sagaType common.SagaType, state any) (string, error)
+	// Continue runs saga which is saved but not finished, e.g. saga which is saved in transaction of other change
+	Continue(ctx context.Context, sagaID string) error
+	// ResumeSagas continues sagas which are left unfinished by crash or wait for retry
+	ResumeSagas(ctx context.Context) error
+}
@@ -2,23 +2,36 @@ package service
 
 import (
 	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/repository"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
+	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/pkg"
 	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"slices"
 )
 
 type cartService struct {
-	tracer   pkg.Tracer
-	cartRepo repository.ICartRepository
+	tracer           pkg.Tracer
+	cartRepo         repository.ICartRepository
+	orderRepo        repository.IOrderRepository
+	exchangeRateRepo repository.IExchangeRateRepository
+	partnerClient    partner_proto_gen.PartnerServiceClient
 }
 
-func NewCartService(tracer pkg.Tracer, cartRepo repository.ICartRepository) ICartService {
+func NewCartService(tracer pkg.Tracer, cartRepo repository.ICartRepository, orderRepo repository.IOrderRepository,
+	exchangeRateRepo repository.IExchangeRateRepository, partnerClient partner_proto_gen.PartnerServiceClient) ICartService {
 	return &cartService{
-		tracer:   tracer,
-		cartRepo: cartRepo,
+		tracer:           tracer,
+		cartRepo:         cartRepo,
+		orderRepo:        orderRepo,
+		exchangeRateRepo: exchangeRateRepo,
+		partnerClient:    partnerClient,
 	}
 }
 
@@ -26,7 +39,13 @@ func (s *cartService) AddItemToCart(ctx context.Context, data *order_proto_gen.A
 	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "AddItemToCart"))
 	defer span.End()
 
-	if err := s.cartRepo.AddItemToCart(ctx, data); err != nil {
+	price, err := cartUnitPrice(ctx, s.partnerClient, data.ProductVariantId, data.Quantity)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.cartRepo.AddItemToCart(ctx, data, price); err != nil {
 		return nil, err
 	}
 
@@ -45,13 +64,62 @@ func (s *cartService) GetCart(ctx context.Context, data *order_proto_gen.GetCart
 
 	var cartItemsResponse []*order_proto_gen.CartResponse
 
+	if len(cartItems) == 0 {
+		return &order_proto_gen.GetCartResponse{
+			CartResponse: cartItemsResponse,
+		}, nil
+	}
+
+	partnerItemMap, err := s.getCartProductInfo(ctx, cartItems)
+
+	if err != nil {
+		return nil, err
+	}
+
 	for _, item := range cartItems {
-		cartItemsResponse = append(cartItemsResponse, &order_proto_gen.CartResponse{
+		itemRes := &order_proto_gen.CartResponse{
 			CartItemId:       item.ID,
 			ProductId:        item.ProductID,
 			Quantity:         item.Quantity,
 			ProductVariantId: item.ProductVariantID,
-		})
+		}
+		cartItemsResponse = append(cartItemsResponse, itemRes)
+
+		partnerItem, ok := partnerItemMap[item.ProductVariantID]
+
+		if !ok {
+			itemRes.Warnings = append(itemRes.Warnings, &order_proto_gen.CartItemWarning{
+				Type: string(common.CartWarningUnavailable),
+			})
+			continue
+		}
+
+		if partnerItem.AvailableQuantity < item.Quantity {
+			maxQuantity := max(partnerItem.AvailableQuantity, 0)
+			itemRes.Warnings = append(itemRes.Warnings, &order_proto_gen.CartItemWarning{
+				Type:        string(common.CartWarningInsufficientStock),
+				MaxQuantity: &maxQuantity,
+			})
+		}
+
+		price, currency, err := cartItemUnitPrice(partnerItem)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// items which were added before prices were saved have no price to compare with
+		if item.LastSeenPrice == nil || item.LastSeenCurrency == nil {
+			continue
+		}
+
+		if *item.LastSeenCurrency != currency || item.LastSeenPrice.Cmp(price) != 0 {
+			itemRes.Warnings = append(itemRes.Warnings, &order_proto_gen.CartItemWarning{
+				Type:     string(common.CartWarningPriceChanged),
+				OldPrice: dto.ToMoneyProto(*item.LastSeenPrice, *item.LastSeenCurrency),
+				NewPrice: dto.ToMoneyProto(price, currency),
+			})
+		}
 	}
 
 	return &order_proto_gen.GetCartResponse{
@@ -59,11 +127,192 @@ func (s *cartService) GetCart(ctx context.Context, data *order_proto_gen.GetCart
 	}, nil
 }
 
+func (s *cartService) AcknowledgeCartItemChanges(ctx context.Context, data *order_proto_gen.AcknowledgeCartItemChangesRequest) (*order_proto_gen.AcknowledgeCartItemChangesResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "AcknowledgeCartItemChanges"))
+	defer span.End()
+
+	if len(data.CartItemIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No cart item IDs provided")
+	}
+
+	cartItems, err := s.cartRepo.GetCart(ctx, data.UserId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cartItemIDs := slices.Compact(slices.Sorted(slices.Values(data.CartItemIds)))
+	acknowledgedItems := make([]*models.CartItem, 0, len(cartItemIDs))
+
+	for _, item := range cartItems {
+		if slices.Contains(cartItemIDs, item.ID) {
+			acknowledgedItems = append(acknowledgedItems, item)
+		}
+	}
+
+	if len(acknowledgedItems) != len(cartItemIDs) {
+		return nil, status.Error(codes.NotFound, "Cart item not found")
+	}
+
+	partnerItemMap, err := s.getCartProductInfo(ctx, acknowledgedItems)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// current price becomes last price seen, so warning of price change is cleared,
+	// unavailable items have no price and stock warnings are shown until stock changes
+	prices := make([]dto.CartItemPrice, 0, len(acknowledgedItems))
+
+	for _, item := range acknowledgedItems {
+		partnerItem, ok := partnerItemMap[item.ProductVariantID]
+
+		if !ok {
+			continue
+		}
+
+		price, currency, err := cartItemUnitPrice(partnerItem)
+
+		if err != nil {
+			return nil, err
+		}
+
+		prices = append(prices, dto.CartItemPrice{
+			CartItemID: item.ID,
+			Price:      price,
+			Currency:   currency,
+		})
+	}
+
+	if err = s.cartRepo.UpdateLastSeenPrices(ctx, data.UserId, prices); err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.AcknowledgeCartItemChangesResponse{
+		CartItemIds: data.CartItemIds,
+	}, nil
+}
+
+// getCartProductInfo returns current product info of cart items by product variant id, deactivated variants are left out
+func (s *cartService) getCartProductInfo(ctx context.Context, cartItems []*models.CartItem) (map[string]*partner_proto_gen.ProdInfoForPaymentResponse, error) {
+	in := &partner_proto_gen.GetProdInfoForPaymentRequest{
+		IncludeUnavailable: true,
+	}
+
+	for _, item := range cartItems {
+		in.Items = append(in.Items, &partner_proto_gen.ProdInfoForPaymentRequest{
+			ProductVariantId: item.ProductVariantID,
+			Quantity:         item.Quantity,
+		})
+	}
+
+	resultPartner, err := s.partnerClient.GetProdInfoForPayment(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	partnerItemMap := make(map[string]*partner_proto_gen.ProdInfoForPaymentResponse, len(resultPartner.Items))
+
+	for _, item := range resultPartner.Items {
+		partnerItemMap[item.ProductVariantId] = item
+	}
+
+	return partnerItemMap, nil
+}
+
+// cartUnitPrice returns current price of one unit of product variant which buyer sees when it is put into cart
+func cartUnitPrice(ctx context.Context, partnerClient partner_proto_gen.PartnerServiceClient,
+	productVariantID string, quantity int64) (dto.UnitPrice, error) {
+	resultPartner, err := partnerClient.GetProdInfoForPayment(ctx, &partner_proto_gen.GetProdInfoForPaymentRequest{
+		Items: []*partner_proto_gen.ProdInfoForPaymentRequest{
+			{
+				ProductVariantId: productVariantID,
+				Quantity:         quantity,
+			},
+		},
+		IncludeUnavailable: true,
+	})
+
+	if err != nil {
+		return dto.UnitPrice{}, err
+	}
+
+	for _, item := range resultPartner.Items {
+		if item.ProductVariantId != productVariantID {
+			continue
+		}
+
+		price, currency, err := cartItemUnitPrice(item)
+
+		if err != nil {
+			return dto.UnitPrice{}, err
+		}
+
+		return dto.UnitPrice{
+			Price:    price,
+			Currency: currency,
+		}, nil
+	}
+
+	// deactivated variants are left out of partner response
+	return dto.UnitPrice{}, status.Error(codes.NotFound, "Product is not sold anymore")
+}
+
+// cartItemUnitPrice returns price of one unit which buyer sees in cart, in currency of product
+func cartItemUnitPrice(partnerItem *partner_proto_gen.ProdInfoForPaymentResponse) (money.Money, string, error) {
+	currency := partnerItem.Currency
+
+	if currency == "" {
+		currency = common.DefaultCurrency
+	}
+
+	unitPrice, discountUnitPrice, err := parsePartnerPrices(partnerItem.OriginalUnitPrice, partnerItem.DiscountUnitPrice)
+
+	if err != nil {
+		return money.Zero, "", err
+	}
+
+	if discountUnitPrice.IsPositive() && discountUnitPrice.Cmp(unitPrice) < 0 {
+		unitPrice = discountUnitPrice
+	}
+
+	return unitPrice, currency, nil
+}
+
+// parsePartnerPrices parses original and discount price which partner service sends as decimal text
+func parsePartnerPrices(original, discount string) (money.Money, money.Money, error) {
+	originalPrice, err := money.Parse(original)
+
+	if err != nil {
+		return money.Zero, money.Zero, status.Errorf(codes.Internal, "invalid price %q from partner service", original)
+	}
+
+	discountPrice, err := money.Parse(discount)
+
+	if err != nil {
+		return money.Zero, money.Zero, status.Errorf(codes.Internal, "invalid price %q from partner service", discount)
+	}
+
+	return originalPrice, discountPrice, nil
+}
+
 func (s *cartService) UpdateCart(ctx context.Context, data *order_proto_gen.UpdateCartItemRequest) (*order_proto_gen.UpdateCartItemResponse, error) {
 	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "UpdateCart"))
 	defer span.End()
 
-	updatedItem, err := s.cartRepo.UpdateCartItem(ctx, data)
+	// quantity 0 deletes item, so there is no price to save
+	var price dto.UnitPrice
+
+	if data.Quantity > 0 {
+		var err error
+
+		if price, err = cartUnitPrice(ctx, s.partnerClient, data.ProductVariantId, data.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	updatedItem, err := s.cartRepo.UpdateCartItem(ctx, data, price)
 
 	if err != nil {
 		return nil, err
@@ -102,3 +351,267 @@ func (s *cartService) CreateCart(ctx context.Context, userID int64) error {
 
 	return nil
 }
+
+func (s *cartService) ReorderToCart(ctx context.Context, data *order_proto_gen.ReorderToCartRequest) (*order_proto_gen.ReorderToCartResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "ReorderToCart"))
+	defer span.End()
+
+	orderItems, err := s.orderRepo.GetOrderItemsOfOrder(ctx, data.OrderId, data.UserId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// current prices are used to report price changes and are saved as last prices seen in cart
+	in := &partner_proto_gen.GetProdInfoForPaymentRequest{
+		IncludeUnavailable: true,
+	}
+
+	for _, item := range orderItems {
+		in.Items = append(in.Items, &partner_proto_gen.ProdInfoForPaymentRequest{
+			ProductVariantId: item.ProductVariantID,
+			Quantity:         item.Quantity,
+		})
+	}
+
+	resultPartner, err := s.partnerClient.GetProdInfoForPayment(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	partnerItemMap := make(map[string]*partner_proto_gen.ProdInfoForPaymentResponse, len(resultPartner.Items))
+
+	for _, item := range resultPartner.Items {
+		partnerItemMap[item.ProductVariantId] = item
+	}
+
+	exchangeRates, err := s.exchangeRateRepo.GetExchangeRates(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rates := dto.NewExchangeRates(exchangeRates)
+
+	// prices are computed before any item is added, so missing exchange rate does not leave reorder half done
+	newUnitPrices := make(map[string]money.Money, len(orderItems))
+	cartPrices := make(map[string]dto.UnitPrice, len(orderItems))
+
+	for _, item := range orderItems {
+		partnerItem, ok := partnerItemMap[item.ProductVariantID]
+
+		if !ok {
+			continue
+		}
+
+		newUnitPrice, err := currentUnitPrice(partnerItem, item.OrderCurrency, rates)
+
+		if err != nil {
+			return nil, err
+		}
+
+		newUnitPrices[item.ProductVariantID] = newUnitPrice
+
+		cartPrice, currency, err := cartItemUnitPrice(partnerItem)
+
+		if err != nil {
+			return nil, err
+		}
+
+		cartPrices[item.ProductVariantID] = dto.UnitPrice{
+			Price:    cartPrice,
+			Currency: currency,
+		}
+	}
+
+	res := &order_proto_gen.ReorderToCartResponse{}
+
+	for _, item := range orderItems {
+		itemRes := &order_proto_gen.ReorderItemResponse{
+			ProductId:          item.ProductID,
+			ProductVariantId:   item.ProductVariantID,
+			ProductName:        item.ProductName,
+			ProductVariantName: item.ProductVariantName,
+			Quantity:           item.Quantity,
+			OldUnitPrice:       dto.ToMoneyProto(item.UnitPrice, item.OrderCurrency),
+		}
+
+		newUnitPrice, ok := newUnitPrices[item.ProductVariantID]
+
+		if !ok {
+			reason := "Product is not sold anymore"
+			itemRes.Reason = &reason
+			res.UnavailableItems = append(res.UnavailableItems, itemRes)
+			continue
+		}
+
+		if _, err = s.partnerClient.CheckAvailableProduct(ctx, &partner_proto_gen.CheckAvailableProductRequest{
+			ProductVariantId: item.ProductVariantID,
+			Quantity:         item.Quantity,
+		}); err != nil {
+			if !isUnavailableProductError(err) {
+				return nil, err
+			}
+
+			reason := status.Convert(err).Message()
+			itemRes.Reason = &reason
+			res.UnavailableItems = append(res.UnavailableItems, itemRes)
+			continue
+		}
+
+		// quantity in cart is added up, so item can still be unavailable when cart already has some of it
+		if err = s.cartRepo.AddItemToCart(ctx, &order_proto_gen.AddItemToCartRequest{
+			UserId:           data.UserId,
+			ProductId:        item.ProductID,
+			ProductVariantId: item.ProductVariantID,
+			Quantity:         item.Quantity,
+		}, cartPrices[item.ProductVariantID]); err != nil {
+			if !isUnavailableProductError(err) {
+				return nil, err
+			}
+
+			reason := status.Convert(err).Message()
+			itemRes.Reason = &reason
+			res.UnavailableItems = append(res.UnavailableItems, itemRes)
+			continue
+		}
+
+		res.AddedItems = append(res.AddedItems, itemRes)
+
+		if newUnitPrice.Cmp(item.UnitPrice) != 0 {
+			itemRes.NewUnitPrice = dto.ToMoneyProto(newUnitPrice, item.OrderCurrency)
+			res.PriceChangedItems = append(res.PriceChangedItems, itemRes)
+		}
+	}
+
+	return res, nil
+}
+
+// isUnavailableProductError reports whether partner rejected product because it is not found or has not enough inventory
+func isUnavailableProductError(err error) bool {
+	code := status.Code(err)
+
+	return code == codes.NotFound || code == codes.Canceled
+}
+
+// currentUnitPrice returns price which checkout would charge now for one unit, in currency of order
+func currentUnitPrice(partnerItem *partner_proto_gen.ProdInfoForPaymentResponse, currency string, rates dto.ExchangeRates) (money.Money, error) {
+	itemCurrency := partnerItem.Currency
+
+	// prices of products which have no currency are in base currency
+	if itemCurrency == "" {
+		itemCurrency = common.DefaultCurrency
+	}
+
+	rate, err := rates.Rate(itemCurrency, currency)
+
+	if err != nil {
+		return money.Zero, err
+	}
+
+	unitPrice, discountUnitPrice, err := parsePartnerPrices(partnerItem.OriginalUnitPrice, partnerItem.DiscountUnitPrice)
+
+	if err != nil {
+		return money.Zero, err
+	}
+
+	unitPrice = unitPrice.Convert(rate)
+	discountUnitPrice = discountUnitPrice.Convert(rate)
+
+	if discountUnitPrice.IsPositive() && discountUnitPrice.Cmp(unitPrice) < 0 {
+		unitPrice = discountUnitPrice
+	}
+
+	return unitPrice, nil
+}
+
+func (s *cartService) MergeGuestCart(ctx context.Context, data *order_proto_gen.MergeGuestCartRequest) (*order_proto_gen.MergeGuestCartResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "MergeGuestCart"))
+	defer span.End()
+
+	if len(data.Items) == 0 {
+		return &order_proto_gen.MergeGuestCartResponse{}, nil
+	}
+
+	// same variant can be added many times into guest cart, it is merged as one item
+	items := make([]models.CartItem, 0, len(data.Items))
+	itemIndexes := make(map[string]int, len(data.Items))
+	in := &partner_proto_gen.GetProdInfoForPaymentRequest{
+		IncludeUnavailable: true,
+	}
+
+	for _, item := range data.Items {
+		if item.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Quantity of product %s must be greater than 0", item.ProductVariantId)
+		}
+
+		if idx, ok := itemIndexes[item.ProductVariantId]; ok {
+			items[idx].Quantity += item.Quantity
+			continue
+		}
+
+		// product id is taken from partner service, so guest cart can not put variant under other product
+		itemIndexes[item.ProductVariantId] = len(items)
+		items = append(items, models.CartItem{
+			ProductVariantID: item.ProductVariantId,
+			Quantity:         item.Quantity,
+		})
+	}
+
+	for _, item := range items {
+		in.Items = append(in.Items, &partner_proto_gen.ProdInfoForPaymentRequest{
+			ProductVariantId: item.ProductVariantID,
+			Quantity:         item.Quantity,
+		})
+	}
+
+	resultPartner, err := s.partnerClient.GetProdInfoForPayment(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// products which are not sold anymore are left out of partner response, so their available quantity is 0
+	availableQuantities := make(map[string]int64, len(resultPartner.Items))
+
+	for _, item := range resultPartner.Items {
+		availableQuantities[item.ProductVariantId] = item.AvailableQuantity
+
+		idx, ok := itemIndexes[item.ProductVariantId]
+
+		if !ok {
+			continue
+		}
+
+		price, currency, err := cartItemUnitPrice(item)
+
+		if err != nil {
+			return nil, err
+		}
+
+		items[idx].ProductID = item.ProductId
+		items[idx].LastSeenPrice = &price
+		items[idx].LastSeenCurrency = &currency
+	}
+
+	mergedItems, err := s.cartRepo.MergeCartItems(ctx, data.UserId, items, availableQuantities)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &order_proto_gen.MergeGuestCartResponse{
+		Items: make([]*order_proto_gen.MergedCartItemResponse, 0, len(mergedItems)),
+	}
+
+	for _, item := range mergedItems {
+		res.Items = append(res.Items, &order_proto_gen.MergedCartItemResponse{
+			ProductVariantId: item.ProductVariantID,
+			Quantity:         item.Quantity,
+			Capped:           item.Capped,
+		})
+	}
+
+	return res, nil
+}
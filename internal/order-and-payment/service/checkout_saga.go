@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/adaptor"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/saga"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
+	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+// checkoutSagaState is state of checkout saga, it is saved after each step. Token of saved payment method
+// is not part of it, it is read again when payment is created
+type checkoutSagaState struct {
+	// Request is checkout request encoded by protobuf
+	Request        []byte                                `json:"request"`
+	CreatedAt      time.Time                             `json:"created_at"`
+	ReservationIDs []string                              `json:"reservation_ids"`
+	AdditionInfo   map[string]dto.AdditionalInfoCheckout `json:"addition_info"`
+	OrderID        string                                `json:"order_id"`
+	StatusOrder    common.StatusOrder                    `json:"status_order"`
+	TotalAmount    money.Money                           `json:"total_amount"`
+	PaymentURL     *string                               `json:"payment_url"`
+}
+
+func (st *checkoutSagaState) request() (*order_proto_gen.CheckoutRequest, error) {
+	data := new(order_proto_gen.CheckoutRequest)
+
+	if err := proto.Unmarshal(st.Request, data); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return data, nil
+}
+
+func (s *paymentService) checkoutSagaDefinition() saga.Definition {
+	return saga.Definition{
+		Type:     common.SagaTypeCheckout,
+		Recovery: saga.RecoveryBackward,
+		NewState: func() any {
+			return new(checkoutSagaState)
+		},
+		Steps: []saga.Step{
+			{Name: "reserve_stock", Execute: s.reserveCheckoutStock, Compensate: s.releaseCheckoutStock},
+			{Name: "create_order", Execute: s.createCheckoutOrder, Compensate: s.cancelCheckoutOrder},
+			// failed payment is kept in payment history of order, so it has nothing to undo
+			{Name: "create_payment", Execute: s.createCheckoutPayment},
+		},
+	}
+}
+
+// reserveCheckoutStock gets information of products from partner service and holds inventory of them,
+// so other buyers can not check out the same units. Holds are keyed by saga id, so retry does not hold twice
+func (s *paymentService) reserveCheckoutStock(ctx context.Context, sagaID string, state any) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "reserveCheckoutStock"))
+	defer span.End()
+
+	st := state.(*checkoutSagaState)
+	data, err := st.request()
+
+	if err != nil {
+		return err
+	}
+
+	in := new(partner_proto_gen.GetProdInfoForPaymentRequest)
+
+	for _, item := range data.Items {
+		in.Items = append(in.Items, &partner_proto_gen.ProdInfoForPaymentRequest{
+			ProductVariantId: item.ProductVariantId,
+			Quantity:         item.Quantity,
+		})
+	}
+
+	resultPartner, err := s.partnerClient.GetProdInfoForPayment(ctx, in)
+
+	if err != nil {
+		return err
+	}
+
+	// enrich information about product
+	st.AdditionInfo, err = toAdditionInfo(resultPartner)
+
+	if err != nil {
+		return err
+	}
+
+	reserveIn := &partner_proto_gen.ReserveInventoryRequest{
+		TtlSeconds:  s.reservationTTLSeconds(common.MethodType(data.MethodType)),
+		PerformedBy: data.UserId,
+		SagaId:      &sagaID,
+	}
+
+	for _, item := range data.Items {
+		reserveIn.Items = append(reserveIn.Items, &partner_proto_gen.ReserveInventoryItem{
+			ProductVariantId: item.ProductVariantId,
+			Quantity:         item.Quantity,
+		})
+	}
+
+	resultReserve, err := s.partnerClient.ReserveInventory(ctx, reserveIn)
+
+	if err != nil {
+		return err
+	}
+
+	st.ReservationIDs = make([]string, 0, len(resultReserve.Reservations))
+
+	for _, reservation := range resultReserve.Reservations {
+		info := st.AdditionInfo[reservation.ProductVariantId]
+		info.ReservationID = reservation.ReservationId
+		st.AdditionInfo[reservation.ProductVariantId] = info
+
+		st.ReservationIDs = append(st.ReservationIDs, reservation.ReservationId)
+	}
+
+	return nil
+}
+
+// releaseCheckoutStock gives back inventory held by checkout, releasing is idempotent in partner service.
+// Holds are released by saga id too, because reservation ids are lost when reserving timed out after
+// partner service made them or service crashed before state was saved
+func (s *paymentService) releaseCheckoutStock(ctx context.Context, sagaID string, state any) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "releaseCheckoutStock"))
+	defer span.End()
+
+	st := state.(*checkoutSagaState)
+
+	if _, err := s.partnerClient.ReleaseReservation(ctx, &partner_proto_gen.ReleaseReservationRequest{
+		ReservationIds: st.ReservationIDs,
+		SagaId:         &sagaID,
+	}); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// createCheckoutOrder calculates shipping fee of package of each supplier, then creates order in db
+func (s *paymentService) createCheckoutOrder(ctx context.Context, sagaID string, state any) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "createCheckoutOrder"))
+	defer span.End()
+
+	st := state.(*checkoutSagaState)
+	data, err := st.request()
+
+	if err != nil {
+		return err
+	}
+
+	dataOrder := dto.CheckoutRequest{}.FromDto(data, st.AdditionInfo)
+	dataOrder.CreatedAt = st.CreatedAt
+	dataOrder.SagaID = &sagaID
+
+	if err = s.applyExchangeRates(ctx, &dataOrder); err != nil {
+		return err
+	}
+
+	if err = s.applyShippingFees(ctx, &dataOrder); err != nil {
+		return err
+	}
+
+	st.OrderID, st.StatusOrder, st.TotalAmount, err = s.paymentRepo.CreateOrder(ctx, dataOrder)
+
+	return err
+}
+
+// cancelCheckoutOrder cancels order of checkout, reservations of it are released by previous step
+func (s *paymentService) cancelCheckoutOrder(ctx context.Context, sagaID string, state any) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "cancelCheckoutOrder"))
+	defer span.End()
+
+	return s.paymentRepo.CancelCheckoutOrder(ctx, sagaID, "Checkout was not completed")
+}
+
+// createCheckoutPayment calls payment gateway to get payment url, cod order has nothing to pay
+func (s *paymentService) createCheckoutPayment(ctx context.Context, sagaID string, state any) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "createCheckoutPayment"))
+	defer span.End()
+
+	st := state.(*checkoutSagaState)
+	data, err := st.request()
+
+	if err != nil {
+		return err
+	}
+
+	methodType := common.MethodType(data.MethodType)
+
+	if methodType == common.Cod {
+		return nil
+	}
+
+	paymentGateway, err := s.paymentGateways.Get(methodType)
+
+	if err != nil {
+		return err
+	}
+
+	var providerToken string
+
+	if data.UserPaymentMethodId != nil {
+		if providerToken, err = s.getProviderTokenForCheckout(ctx, data.UserId, *data.UserPaymentMethodId, methodType); err != nil {
+			return err
+		}
+	}
+
+	paymentURL, err := s.createPayment(ctx, paymentGateway, adaptor.CreatePaymentRequest{
+		OrderID:       st.OrderID,
+		TotalAmount:   st.TotalAmount,
+		OrderInfo:     "Pay with Minh Plaza",
+		ClientIP:      data.ClientIp,
+		CreatedAt:     st.CreatedAt,
+		ProviderToken: providerToken,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	st.PaymentURL = &paymentURL
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/env"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/repository"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// maxCouponCampaignCodes keeps one campaign small enough to be exported into one csv file
+const maxCouponCampaignCodes = 1000000
+
+var codePrefixRegex = regexp.MustCompile(`^[A-Z0-9]{0,12}$`)
+
+type couponCampaignService struct {
+	tracer             pkg.Tracer
+	couponCampaignRepo repository.ICouponCampaignRepository
+	storage            pkg.Storage
+	envManager         *env.EnvManager
+}
+
+func NewCouponCampaignService(tracer pkg.Tracer, couponCampaignRepo repository.ICouponCampaignRepository,
+	storage pkg.Storage, envManager *env.EnvManager) ICouponCampaignService {
+	return &couponCampaignService{
+		tracer:             tracer,
+		couponCampaignRepo: couponCampaignRepo,
+		storage:            storage,
+		envManager:         envManager,
+	}
+}
+
+func (c *couponCampaignService) CreateCouponCampaign(ctx context.Context, data *order_proto_gen.CreateCouponCampaignRequest) (*order_proto_gen.CreateCouponCampaignResponse, error) {
+	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CreateCouponCampaign"))
+	defer span.End()
+
+	if err := validateCouponScope(common.CouponScope(data.Scope), data.CategoryId); err != nil {
+		return nil, err
+	}
+
+	if err := validateDiscountValue(data.DiscountValue); err != nil {
+		return nil, err
+	}
+
+	if !codePrefixRegex.MatchString(data.CodePrefix) {
+		return nil, status.Error(codes.InvalidArgument, "Code prefix must have at most 12 upper case letters or digits")
+	}
+
+	if len(data.UserIds) > 0 && data.TotalCodes != int64(len(data.UserIds)) {
+		return nil, status.Errorf(codes.InvalidArgument, "Total codes %d must be same as number of users %d",
+			data.TotalCodes, len(data.UserIds))
+	}
+
+	if data.TotalCodes <= 0 || data.TotalCodes > maxCouponCampaignCodes {
+		return nil, status.Errorf(codes.InvalidArgument, "Total codes must be between 1 and %d", maxCouponCampaignCodes)
+	}
+
+	campaignID, err := c.couponCampaignRepo.CreateCouponCampaign(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.CreateCouponCampaignResponse{
+		Id:     campaignID,
+		Status: string(common.CouponCampaignStatusPending),
+	}, nil
+}
+
+func (c *couponCampaignService) GetCouponCampaigns(ctx context.Context, data *order_proto_gen.GetCouponCampaignsRequest) (*order_proto_gen.GetCouponCampaignsResponse, error) {
+	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetCouponCampaigns"))
+	defer span.End()
+
+	campaigns, totalItems, err := c.couponCampaignRepo.GetCouponCampaigns(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int64(math.Ceil(float64(totalItems) / float64(data.Limit)))
+
+	result := make([]*order_proto_gen.CouponCampaignResponse, 0, len(campaigns))
+
+	for _, campaign := range campaigns {
+		result = append(result, &order_proto_gen.CouponCampaignResponse{
+			Id:                    campaign.ID,
+			Name:                  campaign.Name,
+			DiscountType:          campaign.DiscountType,
+			DiscountValue:         campaign.DiscountValue.String(),
+			MaximumDiscountAmount: dto.ToMoneyProto(campaign.MaximumDiscountAmount, campaign.Currency),
+			MinimumOrderAmount:    dto.ToMoneyProto(campaign.MinimumOrderAmount, campaign.Currency),
+			Currency:              campaign.Currency,
+			StartDate:             timestamppb.New(campaign.StartDate),
+			EndDate:               timestamppb.New(campaign.EndDate),
+			Scope:                 string(campaign.Scope),
+			CategoryId:            campaign.CategoryID,
+			CodePrefix:            campaign.CodePrefix,
+			TotalCodes:            campaign.TotalCodes,
+			GeneratedCodes:        campaign.GeneratedCodes,
+			UsedCodes:             campaign.UsedCodes,
+			RevokedCodes:          campaign.RevokedCodes,
+			Status:                string(campaign.Status),
+			CreatedAt:             timestamppb.New(campaign.CreatedAt),
+		})
+	}
+
+	return &order_proto_gen.GetCouponCampaignsResponse{
+		Data: result,
+		Metadata: &order_proto_gen.OrderMetadata{
+			Limit:       data.Limit,
+			Page:        data.Page,
+			HasPrevious: data.Page > 1,
+			HasNext:     data.Page < totalPages,
+			TotalItems:  totalItems,
+			TotalPages:  totalPages,
+		},
+	}, nil
+}
+
+func (c *couponCampaignService) GenerateCouponCampaignCodes(ctx context.Context) error {
+	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GenerateCouponCampaignCodes"))
+	defer span.End()
+
+	batchSize := int64(c.envManager.OrderAndPaymentServerConfig.CouponCampaignGenerateBatchSize)
+
+	// each batch is committed on its own, so generated codes are kept when service stops in the middle of campaign
+	for {
+		isGenerated, err := c.couponCampaignRepo.GenerateCouponCampaignCodes(ctx, batchSize)
+
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		if !isGenerated {
+			return nil
+		}
+	}
+}
+
+func (c *couponCampaignService) ExportCouponCampaignCodes(ctx context.Context, campaignID string) (*order_proto_gen.ExportCouponCampaignCodesResponse, error) {
+	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "ExportCouponCampaignCodes"))
+	defer span.End()
+
+	campaign, err := c.couponCampaignRepo.GetCouponCampaignByID(ctx, campaignID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if campaign.Status != common.CouponCampaignStatusCompleted {
+		return nil, status.Errorf(codes.FailedPrecondition, "Codes of campaign are being generated (%d/%d)",
+			campaign.GeneratedCodes, campaign.TotalCodes)
+	}
+
+	coupons, err := c.couponCampaignRepo.GetCouponCampaignCodes(ctx, campaignID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err = writer.Write([]string{"code", "user_id", "status"}); err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for _, coupon := range coupons {
+		userID := ""
+
+		if coupon.UserID != nil {
+			userID = strconv.FormatInt(*coupon.UserID, 10)
+		}
+
+		codeStatus := "unused"
+
+		switch {
+		case coupon.UsageCount > 0:
+			codeStatus = "used"
+		case !coupon.IsActive:
+			codeStatus = "revoked"
+		}
+
+		if err = writer.Write([]string{coupon.Code, userID, codeStatus}); err != nil {
+			span.RecordError(err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	writer.Flush()
+
+	if err = writer.Error(); err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	bucket := c.envManager.MinioConfig.MinioBucketCoupons
+	objectName := fmt.Sprintf("campaigns/%s/codes-%s.csv", campaignID, time.Now().Format("20060102150405"))
+
+	if _, err = c.storage.Upload(ctx, pkg.UploadInput{
+		File:        bytes.NewReader(buf.Bytes()),
+		Name:        objectName,
+		Size:        int64(buf.Len()),
+		ContentType: "text/csv",
+	}, bucket); err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// bucket of coupons is private, so file is downloaded by presigned url
+	downloadURL, err := c.storage.GenerateDownloadPresignedURL(ctx, objectName, bucket)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &order_proto_gen.ExportCouponCampaignCodesResponse{
+		DownloadUrl: downloadURL,
+	}, nil
+}
+
+func (c *couponCampaignService) RevokeCouponCampaignCodes(ctx context.Context, data *order_proto_gen.RevokeCouponCampaignCodesRequest) (*order_proto_gen.RevokeCouponCampaignCodesResponse, error) {
+	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "RevokeCouponCampaignCodes"))
+	defer span.End()
+
+	revokedCodes, err := c.couponCampaignRepo.RevokeCouponCampaignCodes(ctx, data.CampaignId, data.CouponIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.RevokeCouponCampaignCodesResponse{
+		RevokedCodes: revokedCodes,
+	}, nil
+}
@@ -2,10 +2,15 @@ package service
 
 import (
 	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/repository"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
 	"github.com/TienMinh25/ecommerce-platform/pkg"
 	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"math"
 )
@@ -45,15 +50,19 @@ func (c *couponService) GetCoupons(ctx context.Context, data *order_proto_gen.Ge
 			Code:                  coupon.Code,
 			Name:                  coupon.Name,
 			DiscountType:          coupon.DiscountType,
-			DiscountValue:         coupon.DiscountValue,
+			DiscountValue:         coupon.DiscountValue.String(),
 			StartDate:             timestamppb.New(coupon.StartDate),
 			EndDate:               timestamppb.New(coupon.EndDate),
-			MinimumOrderAmount:    coupon.MinimumOrderAmount,
-			MaximumDiscountAmount: coupon.MaximumDiscountAmount,
+			MinimumOrderAmount:    dto.ToMoneyProto(coupon.MinimumOrderAmount, coupon.Currency),
+			MaximumDiscountAmount: dto.ToMoneyProto(coupon.MaximumDiscountAmount, coupon.Currency),
 			UsageCount:            coupon.UsageCount,
 			UsageLimit:            coupon.UsageLimit,
 			Currency:              coupon.Currency,
 			IsActive:              coupon.IsActive,
+			Scope:                 string(coupon.Scope),
+			CategoryId:            coupon.CategoryID,
+			UsageLimitPerUser:     coupon.UsageLimitPerUser,
+			UserId:                coupon.UserID,
 		})
 	}
 
@@ -86,9 +95,9 @@ func (c *couponService) GetDetailCoupon(ctx context.Context, id string) (*order_
 		Name:                  res.Name,
 		Description:           res.Description,
 		DiscountType:          res.DiscountType,
-		DiscountValue:         res.DiscountValue,
-		MaximumDiscountAmount: res.MaximumDiscountAmount,
-		MinimumOrderAmount:    res.MinimumOrderAmount,
+		DiscountValue:         res.DiscountValue.String(),
+		MaximumDiscountAmount: dto.ToMoneyProto(res.MaximumDiscountAmount, res.Currency),
+		MinimumOrderAmount:    dto.ToMoneyProto(res.MinimumOrderAmount, res.Currency),
 		Currency:              res.Currency,
 		UsageLimit:            res.UsageLimit,
 		UsageCount:            res.UsageCount,
@@ -97,6 +106,9 @@ func (c *couponService) GetDetailCoupon(ctx context.Context, id string) (*order_
 		EndDate:               timestamppb.New(res.EndDate),
 		CreatedAt:             timestamppb.New(res.CreatedAt),
 		UpdatedAt:             timestamppb.New(res.UpdatedAt),
+		Scope:                 string(res.Scope),
+		CategoryId:            res.CategoryID,
+		UsageLimitPerUser:     res.UsageLimitPerUser,
 	}, nil
 }
 
@@ -104,6 +116,14 @@ func (c *couponService) UpdateCoupon(ctx context.Context, data *order_proto_gen.
 	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "UpdateCoupon"))
 	defer span.End()
 
+	if err := validateCouponScope(common.CouponScope(data.Scope), data.CategoryId); err != nil {
+		return err
+	}
+
+	if err := validateDiscountValue(data.DiscountValue); err != nil {
+		return err
+	}
+
 	if err := c.couponRepo.UpdateCoupon(ctx, data); err != nil {
 		return err
 	}
@@ -145,15 +165,19 @@ func (c *couponService) GetCouponsByClient(ctx context.Context, data *order_prot
 			Code:                  coupon.Code,
 			Name:                  coupon.Name,
 			DiscountType:          coupon.DiscountType,
-			DiscountValue:         coupon.DiscountValue,
+			DiscountValue:         coupon.DiscountValue.String(),
 			StartDate:             timestamppb.New(coupon.StartDate),
 			EndDate:               timestamppb.New(coupon.EndDate),
-			MinimumOrderAmount:    coupon.MinimumOrderAmount,
-			MaximumDiscountAmount: coupon.MaximumDiscountAmount,
+			MinimumOrderAmount:    dto.ToMoneyProto(coupon.MinimumOrderAmount, coupon.Currency),
+			MaximumDiscountAmount: dto.ToMoneyProto(coupon.MaximumDiscountAmount, coupon.Currency),
 			UsageCount:            coupon.UsageCount,
 			UsageLimit:            coupon.UsageLimit,
 			Currency:              coupon.Currency,
 			IsActive:              coupon.IsActive,
+			Scope:                 string(coupon.Scope),
+			CategoryId:            coupon.CategoryID,
+			UsageLimitPerUser:     coupon.UsageLimitPerUser,
+			UserId:                coupon.UserID,
 		})
 	}
 
@@ -174,9 +198,43 @@ func (c *couponService) CreateCoupon(ctx context.Context, data *order_proto_gen.
 	ctx, span := c.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CreateCoupon"))
 	defer span.End()
 
+	if err := validateCouponScope(common.CouponScope(data.Scope), data.CategoryId); err != nil {
+		return err
+	}
+
+	if err := validateDiscountValue(data.DiscountValue); err != nil {
+		return err
+	}
+
 	if err := c.couponRepo.CreateCoupon(ctx, data); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// validateDiscountValue checks discount value is decimal with at most 2 fraction digits
+func validateDiscountValue(value string) error {
+	if _, err := money.Parse(value); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Discount value %q is invalid", value)
+	}
+
+	return nil
+}
+
+// validateCouponScope checks category is set only for coupon of category
+func validateCouponScope(scope common.CouponScope, categoryID *int64) error {
+	if !scope.IsValid() {
+		return status.Error(codes.InvalidArgument, scope.ErrorMessage())
+	}
+
+	if scope == common.CouponScopeCategory && categoryID == nil {
+		return status.Error(codes.InvalidArgument, "Category is required for coupon of category")
+	}
+
+	if scope != common.CouponScopeCategory && categoryID != nil {
+		return status.Errorf(codes.InvalidArgument, "Coupon of %v must not have category", scope)
+	}
+
+	return nil
+}
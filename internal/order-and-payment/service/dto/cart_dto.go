@@ -0,0 +1,25 @@
+package dto
+
+import "github.com/TienMinh25/ecommerce-platform/internal/money"
+
+// MergedCartItem is cart item after guest cart is merged into cart of user
+type MergedCartItem struct {
+	ProductVariantID string
+	// Quantity is quantity in cart of user after merge
+	Quantity int64
+	// Capped is true when only part of guest quantity was added because of available stock
+	Capped bool
+}
+
+// UnitPrice is price of one unit of product which buyer sees when item is put into cart, it is in currency of product
+type UnitPrice struct {
+	Price    money.Money
+	Currency string
+}
+
+// CartItemPrice is price of product which buyer sees for cart item, it is in currency of product
+type CartItemPrice struct {
+	CartItemID string
+	Price      money.Money
+	Currency   string
+}
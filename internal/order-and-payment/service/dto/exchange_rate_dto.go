@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"errors"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExchangeRates maps currency to amount of base currency (common.DefaultCurrency) for one unit of it
+type ExchangeRates money.Rates
+
+func NewExchangeRates(exchangeRates []models.ExchangeRate) ExchangeRates {
+	res := make(ExchangeRates, len(exchangeRates)+1)
+
+	for _, exchangeRate := range exchangeRates {
+		res[exchangeRate.Currency] = exchangeRate.Rate
+	}
+
+	res[common.DefaultCurrency] = money.OneRate
+
+	return res
+}
+
+// Rate returns money.Rates rate which converts amount in currency from into currency to
+func (e ExchangeRates) Rate(from, to string) (money.Rate, error) {
+	rate, err := money.Rates(e).Rate(from, to)
+
+	if err != nil {
+		var missingRate *money.MissingRateError
+
+		if errors.As(err, &missingRate) {
+			return money.Rate{}, status.Errorf(codes.FailedPrecondition, "Exchange rate of currency %s is not set", missingRate.Currency)
+		}
+
+		return money.Rate{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return rate, nil
+}
@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+)
+
+func ToMoneyProto(amount money.Money, currency string) *order_proto_gen.Money {
+	units, nanos := amount.UnitsNanos()
+
+	return &order_proto_gen.Money{
+		CurrencyCode: currency,
+		Units:        units,
+		Nanos:        nanos,
+	}
+}
+
+// FromMoneyProto returns zero when money is not set
+func FromMoneyProto(amount *order_proto_gen.Money) money.Money {
+	return money.FromUnitsNanos(amount.GetUnits(), amount.GetNanos())
+}
@@ -1,7 +1,21 @@
 package dto
 
+import "github.com/TienMinh25/ecommerce-platform/internal/common"
+
 type SupplierInfoForOrderResponse struct {
 	SupplierID        int64
 	SupplierName      string
 	SupplierThumbnail string
 }
+
+// OrderItemStockSagaState is change of stock which follows change of status of order item, it is saved with
+// status change and done by order item stock saga after transaction is committed
+type OrderItemStockSagaState struct {
+	OrderItemID string                      `json:"order_item_id"`
+	Action      common.OrderItemStockAction `json:"action"`
+	// ReservationID is nil for order items created before reservation, they change quantity of product variant directly
+	ReservationID    *string `json:"reservation_id"`
+	ProductVariantID string  `json:"product_variant_id"`
+	Quantity         int64   `json:"quantity"`
+	PerformedBy      *int64  `json:"performed_by"` // nil when status was changed by system
+}
@@ -2,7 +2,9 @@ package dto
 
 import (
 	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
 	"time"
 )
 
@@ -13,6 +15,21 @@ type CheckoutRequest struct {
 	RecipientName   string
 	RecipientPhone  string
 	UserID          int64
+	ClientIP        string
+	CreatedAt       time.Time
+	// UserPaymentMethodID is saved payment method which buyer picked, it is checked by service before order is created
+	UserPaymentMethodID *int64
+	// OrderCouponID is coupon of whole order, its discount is spread into order items
+	OrderCouponID *string
+	// destination of order, it is used to find shipping zone of package of each supplier
+	ShippingProvince string
+	ShippingDistrict string
+	ShippingWard     string
+	// SagaID is checkout saga which creates order, order is cancelled by it when later step of checkout fails
+	SagaID *string
+	// Currency is currency of payment gateway, prices, fees and coupons are converted into it by ExchangeRates
+	Currency      string
+	ExchangeRates ExchangeRates
 }
 
 type CheckoutItemRequest struct {
@@ -23,19 +40,36 @@ type CheckoutItemRequest struct {
 	ProductVariantImageURL string
 	Quantity               int64
 	EstimatedDeliveryDate  time.Time
-	ShippingFee            float64
-	OriginalUnitPrice      float64
-	DiscountUnitPrice      float64
-	TaxClass               string
-	CouponID               *string
-	SupplierID             int64
+	// ShippingFee is part of fee of package of supplier, it is calculated from shipping rates
+	ShippingFee       money.Money
+	OriginalUnitPrice money.Money
+	DiscountUnitPrice money.Money
+	TaxClass          string
+	CouponID          *string
+	SupplierID        int64
+	CategoryID        int64
+	ReservationID     string
+	ShippingClass     string
+	WeightGrams       int64
+	SupplierProvince  *string
+	SupplierDistrict  *string
+	// Currency is currency of product price, prices are converted from it by ExchangeRate
+	Currency     string
+	ExchangeRate money.Rate
 }
 
 type AdditionalInfoCheckout struct {
-	OriginalUnitPrice float64
-	DiscountUnitPrice float64
+	OriginalUnitPrice money.Money
+	DiscountUnitPrice money.Money
 	TaxClass          string
 	SupplierID        int64
+	CategoryID        int64
+	ReservationID     string
+	ShippingClass     string
+	WeightGrams       int64
+	SupplierProvince  *string
+	SupplierDistrict  *string
+	Currency          string
 }
 
 func (c CheckoutRequest) FromDto(data *order_proto_gen.CheckoutRequest, additionInfoMap map[string]AdditionalInfoCheckout) CheckoutRequest {
@@ -50,48 +84,65 @@ func (c CheckoutRequest) FromDto(data *order_proto_gen.CheckoutRequest, addition
 			ProductVariantImageURL: item.ProductVariantImageUrl,
 			Quantity:               item.Quantity,
 			EstimatedDeliveryDate:  item.EstimatedDeliveryDate.AsTime(),
-			ShippingFee:            item.ShippingFee,
 			OriginalUnitPrice:      additionInfoMap[item.ProductVariantId].OriginalUnitPrice,
 			DiscountUnitPrice:      additionInfoMap[item.ProductVariantId].DiscountUnitPrice,
 			TaxClass:               additionInfoMap[item.ProductVariantId].TaxClass,
 			CouponID:               item.CouponId,
 			SupplierID:             additionInfoMap[item.ProductVariantId].SupplierID,
+			CategoryID:             additionInfoMap[item.ProductVariantId].CategoryID,
+			ReservationID:          additionInfoMap[item.ProductVariantId].ReservationID,
+			ShippingClass:          additionInfoMap[item.ProductVariantId].ShippingClass,
+			WeightGrams:            additionInfoMap[item.ProductVariantId].WeightGrams,
+			SupplierProvince:       additionInfoMap[item.ProductVariantId].SupplierProvince,
+			SupplierDistrict:       additionInfoMap[item.ProductVariantId].SupplierDistrict,
+			Currency:               additionInfoMap[item.ProductVariantId].Currency,
+			ExchangeRate:           money.OneRate,
 		}
 	}
 
 	return CheckoutRequest{
-		Items:           res,
-		MethodType:      common.MethodType(data.MethodType),
-		ShippingAddress: data.ShippingAddress,
-		RecipientName:   data.RecipientName,
-		RecipientPhone:  data.RecipientPhone,
-		UserID:          data.UserId,
+		Items:               res,
+		MethodType:          common.MethodType(data.MethodType),
+		ShippingAddress:     data.ShippingAddress,
+		RecipientName:       data.RecipientName,
+		RecipientPhone:      data.RecipientPhone,
+		UserID:              data.UserId,
+		ClientIP:            data.ClientIp,
+		UserPaymentMethodID: data.UserPaymentMethodId,
+		OrderCouponID:       data.OrderCouponId,
+		ShippingProvince:    data.ShippingProvince,
+		ShippingDistrict:    data.ShippingDistrict,
+		ShippingWard:        data.ShippingWard,
 	}
 }
 
-type MomoPaymentCreateRequest struct {
-	PartnerCode string `json:"partnerCode"`
-	RequestID   string `json:"requestId"`
-	Amount      int64  `json:"amount"`
-	OrderID     string `json:"orderId"`
-	OrderInfo   string `json:"orderInfo"`
-	RedirectUrl string `json:"redirectUrl"`
-	IpnUrl      string `json:"ipnUrl"`
-	RequestType string `json:"requestType"`
-	ExtraData   string `json:"extraData"`
-	Lang        string `json:"lang"`
-	AutoCapture bool   `json:"autoCapture"`
-	Signature   string `json:"signature"`
+// CheckoutProblem is why checkout would fail, ids are set when problem belongs to one item or one coupon
+type CheckoutProblem struct {
+	Code             common.CheckoutProblemCode
+	Message          string
+	ProductVariantID *string
+	CouponID         *string
+}
+
+// OrderQuote is money of order which is calculated same as checkout, but nothing is written
+type OrderQuote struct {
+	Items            []models.OrderItem
+	SubTotal         money.Money
+	DiscountAmount   money.Money
+	TaxAmount        money.Money
+	ShippingFee      money.Money
+	TotalAmount      money.Money
+	PricesIncludeTax bool
+	Currency         string
+	Problems         []CheckoutProblem
 }
 
-type MomoPaymentCreateResponse struct {
-	PartnerCode  string `json:"partnerCode"`
-	RequestID    string `json:"requestId"`
-	OrderID      string `json:"orderId"`
-	Amount       int64  `json:"amount"`
-	ResponseTime int64  `json:"responseTime"`
-	Message      string `json:"message"`
-	ResultCode   int    `json:"resultCode"`
-	PayURL       string `json:"payUrl"`
-	ShortLink    string `json:"shortLink"`
+// PaymentResult is result of payment which is sent or queried from payment gateway
+type PaymentResult struct {
+	OrderID       string
+	TransactionID string
+	Amount        money.Money
+	Message       string
+	// raw data of gateway in json, saved into payment_gateway_response of payment history
+	RawData []byte
 }
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/repository"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"os"
+	"regexp"
+)
+
+// currencyRegex matches ISO 4217 codes
+var currencyRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+
+type exchangeRateService struct {
+	tracer           pkg.Tracer
+	exchangeRateRepo repository.IExchangeRateRepository
+}
+
+func NewExchangeRateService(tracer pkg.Tracer, exchangeRateRepo repository.IExchangeRateRepository) IExchangeRateService {
+	return &exchangeRateService{
+		tracer:           tracer,
+		exchangeRateRepo: exchangeRateRepo,
+	}
+}
+
+func (s *exchangeRateService) GetExchangeRates(ctx context.Context) (*order_proto_gen.GetExchangeRatesResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetExchangeRates"))
+	defer span.End()
+
+	exchangeRates, err := s.exchangeRateRepo.GetExchangeRates(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*order_proto_gen.ExchangeRateResponse, 0, len(exchangeRates))
+
+	for _, exchangeRate := range exchangeRates {
+		result = append(result, &order_proto_gen.ExchangeRateResponse{
+			Currency:  exchangeRate.Currency,
+			Rate:      exchangeRate.Rate.String(),
+			UpdatedAt: timestamppb.New(exchangeRate.UpdatedAt),
+		})
+	}
+
+	return &order_proto_gen.GetExchangeRatesResponse{
+		BaseCurrency: common.DefaultCurrency,
+		Data:         result,
+	}, nil
+}
+
+func (s *exchangeRateService) UpdateExchangeRates(ctx context.Context, data *order_proto_gen.UpdateExchangeRatesRequest) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "UpdateExchangeRates"))
+	defer span.End()
+
+	exchangeRates := make([]models.ExchangeRate, 0, len(data.Rates))
+
+	for _, item := range data.Rates {
+		rate, err := money.ParseRate(item.Rate)
+
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "Rate %q of currency %s is invalid", item.Rate, item.Currency)
+		}
+
+		exchangeRates = append(exchangeRates, models.ExchangeRate{
+			Currency: item.Currency,
+			Rate:     rate,
+		})
+	}
+
+	if err := validateExchangeRates(exchangeRates); err != nil {
+		return err
+	}
+
+	return s.exchangeRateRepo.UpsertExchangeRates(ctx, exchangeRates)
+}
+
+func (s *exchangeRateService) LoadExchangeRatesFromFile(ctx context.Context, path string) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "LoadExchangeRatesFromFile"))
+	defer span.End()
+
+	content, err := os.ReadFile(path)
+
+	if err != nil {
+		span.RecordError(err)
+		return status.Errorf(codes.Internal, "Exchange rate file %s can not be read: %v", path, err)
+	}
+
+	rateMap := make(map[string]money.Rate)
+
+	if err = json.Unmarshal(content, &rateMap); err != nil {
+		span.RecordError(err)
+		return status.Errorf(codes.InvalidArgument, "Exchange rate file %s is invalid: %v", path, err)
+	}
+
+	exchangeRates := make([]models.ExchangeRate, 0, len(rateMap))
+
+	for currency, rate := range rateMap {
+		exchangeRates = append(exchangeRates, models.ExchangeRate{
+			Currency: currency,
+			Rate:     rate,
+		})
+	}
+
+	if err = validateExchangeRates(exchangeRates); err != nil {
+		return err
+	}
+
+	return s.exchangeRateRepo.UpsertExchangeRates(ctx, exchangeRates)
+}
+
+// validateExchangeRates checks currencies are codes other than base currency and rates are greater than 0
+func validateExchangeRates(exchangeRates []models.ExchangeRate) error {
+	if len(exchangeRates) == 0 {
+		return status.Error(codes.InvalidArgument, "Exchange rates are required")
+	}
+
+	for _, exchangeRate := range exchangeRates {
+		if !currencyRegex.MatchString(exchangeRate.Currency) {
+			return status.Errorf(codes.InvalidArgument, "Currency %s must be code of 3 upper case letters", exchangeRate.Currency)
+		}
+
+		if exchangeRate.Currency == common.DefaultCurrency {
+			return status.Errorf(codes.InvalidArgument, "Rate of base currency %s is always 1", common.DefaultCurrency)
+		}
+
+		if !exchangeRate.Rate.IsPositive() {
+			return status.Errorf(codes.InvalidArgument, "Rate of currency %s must be greater than 0", exchangeRate.Currency)
+		}
+	}
+
+	return nil
+}
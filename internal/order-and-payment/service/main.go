@@ -11,6 +11,17 @@ type ICartService interface {
 	UpdateCart(ctx context.Context, data *order_proto_gen.UpdateCartItemRequest) (*order_proto_gen.UpdateCartItemResponse, error)
 	RemoveCartItem(ctx context.Context, data *order_proto_gen.RemoveCartItemRequest) (*order_proto_gen.RemoveCartItemResponse, error)
 	CreateCart(ctx context.Context, userID int64) error
+	ReorderToCart(ctx context.Context, data *order_proto_gen.ReorderToCartRequest) (*order_proto_gen.ReorderToCartResponse, error)
+	MergeGuestCart(ctx context.Context, data *order_proto_gen.MergeGuestCartRequest) (*order_proto_gen.MergeGuestCartResponse, error)
+	AcknowledgeCartItemChanges(ctx context.Context, data *order_proto_gen.AcknowledgeCartItemChangesRequest) (*order_proto_gen.AcknowledgeCartItemChangesResponse, error)
+}
+
+type IWishlistService interface {
+	AddItemToWishlist(ctx context.Context, data *order_proto_gen.AddItemToWishlistRequest) (*order_proto_gen.AddItemToWishlistResponse, error)
+	GetWishlist(ctx context.Context, data *order_proto_gen.GetWishlistRequest) (*order_proto_gen.GetWishlistResponse, error)
+	RemoveWishlistItems(ctx context.Context, data *order_proto_gen.RemoveWishlistItemsRequest) (*order_proto_gen.RemoveWishlistItemsResponse, error)
+	MoveWishlistItemToCart(ctx context.Context, data *order_proto_gen.MoveWishlistItemToCartRequest) error
+	MoveCartItemToWishlist(ctx context.Context, data *order_proto_gen.MoveCartItemToWishlistRequest) (*order_proto_gen.MoveCartItemToWishlistResponse, error)
 }
 
 type ICouponService interface {
@@ -22,16 +33,60 @@ type ICouponService interface {
 	CreateCoupon(ctx context.Context, data *order_proto_gen.CreateCouponRequest) error
 }
 
+type ICouponCampaignService interface {
+	CreateCouponCampaign(ctx context.Context, data *order_proto_gen.CreateCouponCampaignRequest) (*order_proto_gen.CreateCouponCampaignResponse, error)
+	GetCouponCampaigns(ctx context.Context, data *order_proto_gen.GetCouponCampaignsRequest) (*order_proto_gen.GetCouponCampaignsResponse, error)
+	GenerateCouponCampaignCodes(ctx context.Context) error
+	ExportCouponCampaignCodes(ctx context.Context, campaignID string) (*order_proto_gen.ExportCouponCampaignCodesResponse, error)
+	RevokeCouponCampaignCodes(ctx context.Context, data *order_proto_gen.RevokeCouponCampaignCodesRequest) (*order_proto_gen.RevokeCouponCampaignCodesResponse, error)
+}
+
 type IPaymentService interface {
 	GetPaymentMethods(ctx context.Context) (*order_proto_gen.GetPaymentMethodsResponse, error)
 	CreateOrder(ctx context.Context, data *order_proto_gen.CheckoutRequest) (*order_proto_gen.CheckoutResponse, error)
-	UpdateOrderStatusFromMomo(ctx context.Context, data *order_proto_gen.UpdateOrderStatusFromMomoRequest) error
+	QuoteCheckout(ctx context.Context, data *order_proto_gen.CheckoutRequest) (*order_proto_gen.QuoteCheckoutResponse, error)
+	HandlePaymentCallback(ctx context.Context, data *order_proto_gen.HandlePaymentCallbackRequest) (*order_proto_gen.HandlePaymentCallbackResponse, error)
+	ExpireUnpaidOrders(ctx context.Context) error
 }
 
 type IOrderService interface {
 	GetMyOrders(ctx context.Context, data *order_proto_gen.GetMyOrdersRequest) (*order_proto_gen.GetMyOrdersResponse, error)
 	GetSupplierOrders(ctx context.Context, data *order_proto_gen.GetSupplierOrdersRequest) (*order_proto_gen.GetSupplierOrdersResponse, error)
 	UpdateOrderItem(ctx context.Context, data *order_proto_gen.UpdateOrderItemRequest) error
+	CancelOrderItem(ctx context.Context, data *order_proto_gen.CancelOrderItemRequest) error
+	GetOrderItemTimeline(ctx context.Context, data *order_proto_gen.GetOrderItemTimelineRequest) (*order_proto_gen.GetOrderItemTimelineResponse, error)
+}
+
+type IRefundService interface {
+	CreateRefund(ctx context.Context, data *order_proto_gen.CreateRefundRequest) (*order_proto_gen.CreateRefundResponse, error)
+	ProcessRefund(ctx context.Context, refundID string) (*order_proto_gen.ProcessRefundResponse, error)
+	GetRefunds(ctx context.Context, paymentHistoryID string) (*order_proto_gen.GetRefundsResponse, error)
+}
+
+type IUserPaymentMethodService interface {
+	GetUserPaymentMethods(ctx context.Context, userID int64) (*order_proto_gen.GetUserPaymentMethodsResponse, error)
+	CreateUserPaymentMethod(ctx context.Context, data *order_proto_gen.CreateUserPaymentMethodRequest) (*order_proto_gen.CreateUserPaymentMethodResponse, error)
+	SetDefaultUserPaymentMethod(ctx context.Context, userID, userPaymentMethodID int64) error
+	DeleteUserPaymentMethod(ctx context.Context, userID, userPaymentMethodID int64) error
+}
+
+type IShippingRateService interface {
+	GetShippingRates(ctx context.Context, data *order_proto_gen.GetShippingRatesRequest) (*order_proto_gen.GetShippingRatesResponse, error)
+	CreateShippingRate(ctx context.Context, data *order_proto_gen.CreateShippingRateRequest) (*order_proto_gen.CreateShippingRateResponse, error)
+	UpdateShippingRate(ctx context.Context, data *order_proto_gen.UpdateShippingRateRequest) error
+	DeleteShippingRate(ctx context.Context, id int64) error
+}
+
+type IExchangeRateService interface {
+	GetExchangeRates(ctx context.Context) (*order_proto_gen.GetExchangeRatesResponse, error)
+	UpdateExchangeRates(ctx context.Context, data *order_proto_gen.UpdateExchangeRatesRequest) error
+	// LoadExchangeRatesFromFile sets rates from json file like {"USD": "25350.5"}
+	LoadExchangeRatesFromFile(ctx context.Context, path string) error
+}
+
+type IOutboxService interface {
+	RelayOutboxEvents(ctx context.Context) error
+	CleanupOutboxEvents(ctx context.Context) error
 }
 
 type IDelivererService interface {
@@ -2,30 +2,54 @@ package service
 
 import (
 	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/repository"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/saga"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
 	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/pkg"
 	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"log"
 	"math"
+	"sort"
 )
 
 type orderService struct {
 	tracer          pkg.Tracer
 	orderRepository repository.IOrderRepository
 	partnerClient   partner_proto_gen.PartnerServiceClient
+	sagaCoordinator saga.ICoordinator
 }
 
 func NewOrderService(tracer pkg.Tracer, orderRepository repository.IOrderRepository,
-	partnerClient partner_proto_gen.PartnerServiceClient) IOrderService {
-	return &orderService{
+	partnerClient partner_proto_gen.PartnerServiceClient,
+	sagaCoordinator saga.ICoordinator) IOrderService {
+	s := &orderService{
 		tracer:          tracer,
 		orderRepository: orderRepository,
 		partnerClient:   partnerClient,
+		sagaCoordinator: sagaCoordinator,
 	}
+
+	// status of order item is already changed when stock is kept or released, so stock change is retried until
+	// it succeeds. Keeping or releasing does not fail for lack of stock, stock of confirm is taken before status change
+	sagaCoordinator.Register(saga.Definition{
+		Type:     common.SagaTypeOrderItemStock,
+		Recovery: saga.RecoveryForward,
+		NewState: func() any {
+			return new(dto.OrderItemStockSagaState)
+		},
+		Steps: []saga.Step{
+			{Name: "update_stock", Execute: s.updateOrderItemStock},
+		},
+	})
+
+	return s
 }
 
 func (s *orderService) GetMyOrders(ctx context.Context, data *order_proto_gen.GetMyOrdersRequest) (*order_proto_gen.GetMyOrdersResponse, error) {
@@ -117,11 +141,11 @@ func (s *orderService) getSupplierInfoForOrders(ctx context.Context, data []mode
 			ProductVariantName:    item.ProductVariantName,
 			ProductThumbnailUrl:   item.ProductVariantImageURL,
 			Quantity:              item.Quantity,
-			UnitPrice:             item.UnitPrice,
-			TotalPrice:            item.TotalPrice,
-			DiscountAmount:        item.DiscountAmount,
-			TaxAmount:             item.TaxAmount,
-			ShippingFee:           item.ShippingFee,
+			UnitPrice:             dto.ToMoneyProto(item.UnitPrice, item.OrderCurrency),
+			TotalPrice:            dto.ToMoneyProto(item.TotalPrice, item.OrderCurrency),
+			DiscountAmount:        dto.ToMoneyProto(item.DiscountAmount, item.OrderCurrency),
+			TaxAmount:             dto.ToMoneyProto(item.TaxAmount, item.OrderCurrency),
+			ShippingFee:           dto.ToMoneyProto(item.ShippingFee, item.OrderCurrency),
 			Status:                string(item.Status),
 			TrackingNumber:        item.TrackingNumber,
 			ShippingAddress:       item.ShippingAddress,
@@ -187,11 +211,11 @@ func (s *orderService) GetSupplierOrders(ctx context.Context, data *order_proto_
 			ProductVariantName:    item.ProductVariantName,
 			ProductThumbnailUrl:   item.ProductVariantImageURL,
 			Quantity:              item.Quantity,
-			UnitPrice:             item.UnitPrice,
-			TotalPrice:            item.TotalPrice,
-			DiscountAmount:        item.DiscountAmount,
-			TaxAmount:             item.TaxAmount,
-			ShippingFee:           item.ShippingFee,
+			UnitPrice:             dto.ToMoneyProto(item.UnitPrice, item.OrderCurrency),
+			TotalPrice:            dto.ToMoneyProto(item.TotalPrice, item.OrderCurrency),
+			DiscountAmount:        dto.ToMoneyProto(item.DiscountAmount, item.OrderCurrency),
+			TaxAmount:             dto.ToMoneyProto(item.TaxAmount, item.OrderCurrency),
+			ShippingFee:           dto.ToMoneyProto(item.ShippingFee, item.OrderCurrency),
 			Status:                string(item.Status),
 			TrackingNumber:        item.TrackingNumber,
 			ShippingAddress:       item.ShippingAddress,
@@ -215,9 +239,184 @@ func (s *orderService) UpdateOrderItem(ctx context.Context, data *order_proto_ge
 	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "UpdateOrderItem"))
 	defer span.End()
 
-	if err := s.orderRepository.UpdateOrderItem(ctx, data); err != nil {
+	resPartner, err := s.partnerClient.GetSupplierID(ctx, &partner_proto_gen.GetSupplierIDRequest{
+		UserId: data.UserId,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// stock is taken before order item is confirmed, so confirm is rejected when stock is gone
+	if common.StatusOrder(data.Status) == common.Confirmed {
+		if err = s.commitOrderItemStock(ctx, resPartner.SupplierId, data); err != nil {
+			return err
+		}
+	}
+
+	sagaID, err := s.orderRepository.UpdateOrderItem(ctx, resPartner.SupplierId, data)
+
+	if err != nil {
+		return err
+	}
+
+	continueOrderItemStockSagas(ctx, s.sagaCoordinator, sagaID)
+
+	return nil
+}
+
+func (s *orderService) CancelOrderItem(ctx context.Context, data *order_proto_gen.CancelOrderItemRequest) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CancelOrderItem"))
+	defer span.End()
+
+	sagaID, err := s.orderRepository.CancelOrderItem(ctx, data)
+
+	if err != nil {
 		return err
 	}
 
+	continueOrderItemStockSagas(ctx, s.sagaCoordinator, sagaID)
+
 	return nil
 }
+
+// continueOrderItemStockSagas changes stock after status of order items was committed, saga which fails here
+// is retried by saga recoverer, so status change is not failed by it
+func continueOrderItemStockSagas(ctx context.Context, sagaCoordinator saga.ICoordinator, sagaIDs ...string) {
+	for _, sagaID := range sagaIDs {
+		if sagaID == "" {
+			continue
+		}
+
+		if err := sagaCoordinator.Continue(ctx, sagaID); err != nil {
+			log.Printf("Failed to change stock of order item in saga %v, it is retried later: %v", sagaID, err)
+		}
+	}
+}
+
+// commitOrderItemStock turns held stock of order item into sold stock, order items created before reservation
+// take stock directly. Both are done only once per order item, so confirm can be retried when status change fails
+func (s *orderService) commitOrderItemStock(ctx context.Context, supplierID int64, data *order_proto_gen.UpdateOrderItemRequest) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "commitOrderItemStock"))
+	defer span.End()
+
+	orderItem, err := s.orderRepository.GetSupplierOrderItem(ctx, supplierID, data.OrderItemId)
+
+	if err != nil {
+		return err
+	}
+
+	if !orderItem.Status.CanTransitionTo(common.Confirmed, common.ActorSupplier) {
+		return status.Errorf(codes.FailedPrecondition, "Can not change status of order item from %v to %v",
+			orderItem.Status, common.Confirmed)
+	}
+
+	if orderItem.ReservationID != nil {
+		_, err = s.partnerClient.CommitReservation(ctx, &partner_proto_gen.CommitReservationRequest{
+			ReservationId: *orderItem.ReservationID,
+			PerformedBy:   data.UserId,
+		})
+	} else {
+		_, err = s.partnerClient.UpdateQuantityProductVariantWhenConfirmed(ctx, &partner_proto_gen.UpdateQuantityProductVariantWhenConfirmedRequest{
+			Quantity:         orderItem.Quantity,
+			ProductVariantId: orderItem.ProductVariantID,
+			OrderItemId:      &orderItem.ID,
+		})
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+// updateOrderItemStock keeps or releases reservation of order item, order items created before reservation
+// change quantity of product variant directly
+func (s *orderService) updateOrderItemStock(ctx context.Context, sagaID string, state any) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "updateOrderItemStock"))
+	defer span.End()
+
+	st := state.(*dto.OrderItemStockSagaState)
+	var err error
+
+	switch st.Action {
+	case common.OrderItemStockRelease:
+		if st.ReservationID != nil {
+			_, err = s.partnerClient.ReleaseReservation(ctx, &partner_proto_gen.ReleaseReservationRequest{
+				ReservationIds: []string{*st.ReservationID},
+				PerformedBy:    st.PerformedBy,
+			})
+		} else {
+			_, err = s.partnerClient.UpdateQuantityProductVariantWhenCancelled(ctx, &partner_proto_gen.UpdateQuantityProductVariantWhenCancelledRequest{
+				Quantity:         st.Quantity,
+				ProductVariantId: st.ProductVariantID,
+				OrderItemId:      &st.OrderItemID,
+			})
+		}
+	case common.OrderItemStockKeep:
+		// hold has no expiry until supplier confirms or cancels order item
+		_, err = s.partnerClient.ExtendReservation(ctx, &partner_proto_gen.ExtendReservationRequest{
+			ReservationIds: []string{*st.ReservationID},
+			TtlSeconds:     0,
+		})
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *orderService) GetOrderItemTimeline(ctx context.Context, data *order_proto_gen.GetOrderItemTimelineRequest) (*order_proto_gen.GetOrderItemTimelineResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetOrderItemTimeline"))
+	defer span.End()
+
+	histories, orderDeliverer, err := s.orderRepository.GetOrderItemTimeline(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*order_proto_gen.OrderItemTimelineEvent, 0, len(histories)+2)
+
+	for _, history := range histories {
+		events = append(events, &order_proto_gen.OrderItemTimelineEvent{
+			Status:    string(history.Status),
+			Actor:     string(history.Actor),
+			Notes:     history.Notes,
+			CreatedAt: timestamppb.New(history.CreatedAt),
+		})
+	}
+
+	// merge pickup and delivery time of deliverer into timeline
+	if orderDeliverer != nil {
+		if orderDeliverer.PickupTime != nil {
+			events = append(events, &order_proto_gen.OrderItemTimelineEvent{
+				Status:    "picked_up",
+				Actor:     string(common.ActorDeliverer),
+				CreatedAt: timestamppb.New(*orderDeliverer.PickupTime),
+			})
+		}
+
+		if orderDeliverer.DeliveryTime != nil {
+			events = append(events, &order_proto_gen.OrderItemTimelineEvent{
+				Status:    string(common.Delivered),
+				Actor:     string(common.ActorDeliverer),
+				Notes:     orderDeliverer.DeliveryNotes,
+				CreatedAt: timestamppb.New(*orderDeliverer.DeliveryTime),
+			})
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CreatedAt.AsTime().Before(events[j].CreatedAt.AsTime())
+	})
+
+	return &order_proto_gen.GetOrderItemTimelineResponse{
+		Events: events,
+	}, nil
+}
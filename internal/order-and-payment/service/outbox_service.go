@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/env"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/repository"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"time"
+)
+
+type outboxService struct {
+	tracer        pkg.Tracer
+	outboxRepo    repository.IOutboxRepository
+	messageBroker pkg.MessageQueue
+	envManager    *env.EnvManager
+}
+
+func NewOutboxService(tracer pkg.Tracer, outboxRepo repository.IOutboxRepository, messageBroker pkg.MessageQueue,
+	envManager *env.EnvManager) IOutboxService {
+	return &outboxService{
+		tracer:        tracer,
+		outboxRepo:    outboxRepo,
+		messageBroker: messageBroker,
+		envManager:    envManager,
+	}
+}
+
+func (o *outboxService) RelayOutboxEvents(ctx context.Context) error {
+	ctx, span := o.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "RelayOutboxEvents"))
+	defer span.End()
+
+	batchSize := int64(o.envManager.OrderAndPaymentServerConfig.OutboxRelayBatchSize)
+
+	// order id is key of message, so events of one order stay in one partition and keep their order
+	publish := func(ctx context.Context, event models.OutboxEvent) error {
+		return o.messageBroker.ProduceWithKey(ctx, o.envManager.TopicOrderEvents, []byte(event.AggregateID), event.Payload)
+	}
+
+	// keep relaying while batches are full, so backlog is published without waiting for next tick
+	for {
+		publishedEvents, err := o.outboxRepo.RelayOutboxEvents(ctx, batchSize, publish)
+
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		if int64(publishedEvents) < batchSize {
+			return nil
+		}
+	}
+}
+
+func (o *outboxService) CleanupOutboxEvents(ctx context.Context) error {
+	ctx, span := o.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CleanupOutboxEvents"))
+	defer span.End()
+
+	publishedBefore := time.Now().Add(-time.Duration(o.envManager.OrderAndPaymentServerConfig.OutboxRetention) * time.Hour)
+
+	if err := o.outboxRepo.DeletePublishedOutboxEvents(ctx, publishedBefore); err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	return nil
+}
@@ -2,46 +2,78 @@ package service
 
 import (
 	"context"
-	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"github.com/TienMinh25/ecommerce-platform/internal/common"
 	"github.com/TienMinh25/ecommerce-platform/internal/env"
-	"github.com/TienMinh25/ecommerce-platform/internal/httpclient"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"github.com/TienMinh25/ecommerce-platform/internal/notifications/enum"
+	"github.com/TienMinh25/ecommerce-platform/internal/notifications/transport/grpc/proto/notification_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/adaptor"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/repository"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/saga"
 	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
 	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/pkg"
 	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
-	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"math"
-	"net/http"
+	"google.golang.org/protobuf/proto"
+	"log"
+	"time"
 )
 
 type paymentService struct {
-	tracer        pkg.Tracer
-	paymentRepo   repository.IPaymentRepository
-	partnerClient partner_proto_gen.PartnerServiceClient
-	envManager    *env.EnvManager
-	httpClient    pkg.HTTPClient
+	tracer                pkg.Tracer
+	paymentRepo           repository.IPaymentRepository
+	userPaymentMethodRepo repository.IUserPaymentMethodRepository
+	partnerClient         partner_proto_gen.PartnerServiceClient
+	envManager            *env.EnvManager
+	paymentGateways       adaptor.IPaymentGatewayRegistry
+	messageBroker         pkg.MessageQueue
+	shippingRateRepo      repository.IShippingRateRepository
+	exchangeRateRepo      repository.IExchangeRateRepository
+	// checkoutIdempotencyRepo keeps idempotency keys of checkout, so retry of checkout does not create other order
+	checkoutIdempotencyRepo repository.ICheckoutIdempotencyRepository
+	sagaCoordinator         saga.ICoordinator
 }
 
+// expireUnpaidOrdersBatchSize limits number of orders expired in one tick
+const expireUnpaidOrdersBatchSize = 100
+
+// maxIdempotencyKeyLength is same as length of idempotency_key column
+const maxIdempotencyKeyLength = 255
+
 func NewPaymentService(tracer pkg.Tracer, couponRepo repository.IPaymentRepository,
+	userPaymentMethodRepo repository.IUserPaymentMethodRepository,
 	partnerClient partner_proto_gen.PartnerServiceClient,
 	envManager *env.EnvManager,
-	httpClient pkg.HTTPClient) IPaymentService {
-	return &paymentService{
-		tracer:        tracer,
-		paymentRepo:   couponRepo,
-		partnerClient: partnerClient,
-		envManager:    envManager,
-		httpClient:    httpClient,
+	paymentGateways adaptor.IPaymentGatewayRegistry,
+	messageBroker pkg.MessageQueue,
+	shippingRateRepo repository.IShippingRateRepository,
+	exchangeRateRepo repository.IExchangeRateRepository,
+	checkoutIdempotencyRepo repository.ICheckoutIdempotencyRepository,
+	sagaCoordinator saga.ICoordinator) IPaymentService {
+	s := &paymentService{
+		tracer:                  tracer,
+		paymentRepo:             couponRepo,
+		userPaymentMethodRepo:   userPaymentMethodRepo,
+		partnerClient:           partnerClient,
+		envManager:              envManager,
+		paymentGateways:         paymentGateways,
+		messageBroker:           messageBroker,
+		shippingRateRepo:        shippingRateRepo,
+		exchangeRateRepo:        exchangeRateRepo,
+		checkoutIdempotencyRepo: checkoutIdempotencyRepo,
+		sagaCoordinator:         sagaCoordinator,
 	}
+
+	sagaCoordinator.Register(s.checkoutSagaDefinition())
+
+	return s
 }
 
 func (s *paymentService) GetPaymentMethods(ctx context.Context) (*order_proto_gen.GetPaymentMethodsResponse, error) {
@@ -71,7 +103,143 @@ func (s *paymentService) CreateOrder(ctx context.Context, data *order_proto_gen.
 	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CreateOrder"))
 	defer span.End()
 
-	in := new(partner_proto_gen.GetProdInfoForPaymentRequest)
+	if data.IdempotencyKey == nil {
+		return s.createOrder(ctx, data)
+	}
+
+	idempotencyKey := *data.IdempotencyKey
+
+	if len(idempotencyKey) == 0 || len(idempotencyKey) > maxIdempotencyKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Idempotency key must have 1 to %d characters", maxIdempotencyKeyLength)
+	}
+
+	requestHash, err := hashCheckoutRequest(data)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	expiredBefore := time.Now().Add(-time.Duration(s.envManager.OrderAndPaymentServerConfig.CheckoutIdempotencyKeyTTL) * time.Hour)
+	lease := time.Duration(s.envManager.OrderAndPaymentServerConfig.CheckoutIdempotencyLease) * time.Second
+	key, acquired, err := s.checkoutIdempotencyRepo.AcquireCheckoutIdempotencyKey(ctx, data.UserId, idempotencyKey,
+		requestHash, expiredBefore, lease)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// key is used by other live checkout, replay it when it is retry of same checkout
+	if !acquired {
+		if key.RequestHash != requestHash {
+			return nil, status.Error(codes.AlreadyExists, "Idempotency key is already used by other checkout")
+		}
+
+		if key.OrderID == nil {
+			return nil, status.Error(codes.Aborted, "Checkout with this idempotency key is in progress")
+		}
+
+		return &order_proto_gen.CheckoutResponse{
+			OrderId:    *key.OrderID,
+			Status:     *key.Status,
+			PaymentUrl: key.PaymentURL,
+		}, nil
+	}
+
+	res, err := s.createOrder(ctx, data)
+
+	if err != nil {
+		// checkout failed, so client can retry it with same key
+		if errRelease := s.checkoutIdempotencyRepo.ReleaseCheckoutIdempotencyKey(ctx, data.UserId, idempotencyKey,
+			*key.LeaseExpiresAt); errRelease != nil {
+			span.RecordError(errRelease)
+			log.Printf("Failed to release idempotency key of failed checkout: %v", errRelease)
+		}
+
+		return nil, err
+	}
+
+	// order is already created, so response is returned even when it can not be saved for retries
+	if errSave := s.checkoutIdempotencyRepo.SaveCheckoutIdempotencyResponse(ctx, data.UserId, idempotencyKey,
+		*key.LeaseExpiresAt, res.OrderId, res.Status, res.PaymentUrl); errSave != nil {
+		span.RecordError(errSave)
+		log.Printf("Failed to save response of checkout %v for idempotency key: %v", res.OrderId, errSave)
+	}
+
+	return res, nil
+}
+
+// hashCheckoutRequest returns sha256 of checkout, ip of buyer and idempotency key are not part of it
+// because they can be changed between retries of same checkout
+func hashCheckoutRequest(data *order_proto_gen.CheckoutRequest) (string, error) {
+	in := proto.Clone(data).(*order_proto_gen.CheckoutRequest)
+	in.ClientIp = ""
+	in.IdempotencyKey = nil
+
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(in)
+
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(raw)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func (s *paymentService) createOrder(ctx context.Context, data *order_proto_gen.CheckoutRequest) (*order_proto_gen.CheckoutResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "createOrder"))
+	defer span.End()
+
+	methodType := common.MethodType(data.MethodType)
+
+	// every method except cod is paid via payment gateway, check it is supported before holding anything
+	if methodType != common.Cod {
+		if _, err := s.paymentGateways.Get(methodType); err != nil {
+			return nil, err
+		}
+	}
+
+	if data.UserPaymentMethodId != nil {
+		if _, err := s.getProviderTokenForCheckout(ctx, data.UserId, *data.UserPaymentMethodId, methodType); err != nil {
+			return nil, err
+		}
+	}
+
+	rawRequest, err := proto.Marshal(data)
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// reserve stock, create order and create payment, earlier steps are undone when later step fails
+	state := &checkoutSagaState{
+		Request: rawRequest,
+		// payment gateway (vnpay) looks up transaction by creation time, so it must be same as created_at of order
+		CreatedAt: time.Now(),
+	}
+
+	if _, err = s.sagaCoordinator.Start(ctx, common.SagaTypeCheckout, state); err != nil {
+		return nil, err
+	}
+
+	// order created event was saved into outbox with order, it is published to kafka by outbox relay
+	return &order_proto_gen.CheckoutResponse{
+		OrderId:    state.OrderID,
+		Status:     string(state.StatusOrder),
+		PaymentUrl: state.PaymentURL,
+	}, nil
+}
+
+func (s *paymentService) QuoteCheckout(ctx context.Context, data *order_proto_gen.CheckoutRequest) (*order_proto_gen.QuoteCheckoutResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "QuoteCheckout"))
+	defer span.End()
+
+	// unavailable items are returned by partner instead of error, so they can be reported as problems
+	in := &partner_proto_gen.GetProdInfoForPaymentRequest{
+		IncludeUnavailable: true,
+	}
 
 	for _, item := range data.Items {
 		in.Items = append(in.Items, &partner_proto_gen.ProdInfoForPaymentRequest{
@@ -80,142 +248,590 @@ func (s *paymentService) CreateOrder(ctx context.Context, data *order_proto_gen.
 		})
 	}
 
-	// step 1: call api to service partner to get some information, check quantity and get info
 	resultPartner, err := s.partnerClient.GetProdInfoForPayment(ctx, in)
 
 	if err != nil {
 		return nil, err
 	}
 
-	additionInfoMap := make(map[string]dto.AdditionalInfoCheckout, 0)
+	partnerItemMap := make(map[string]*partner_proto_gen.ProdInfoForPaymentResponse, len(resultPartner.Items))
+
+	for _, item := range resultPartner.Items {
+		partnerItemMap[item.ProductVariantId] = item
+	}
+
+	additionInfoMap, err := toAdditionInfo(resultPartner)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// items which are not sold anymore have no price, so they are left out of quote.
+	// items which have not enough inventory are still quoted with requested quantity
+	problems := make([]dto.CheckoutProblem, 0)
+	quoteData := proto.Clone(data).(*order_proto_gen.CheckoutRequest)
+	quoteData.Items = make([]*order_proto_gen.CheckoutItemRequest, 0, len(data.Items))
+
+	for _, item := range data.Items {
+		partnerItem, ok := partnerItemMap[item.ProductVariantId]
+
+		if !ok {
+			problems = append(problems, dto.CheckoutProblem{
+				Code:             common.CheckoutProblemProductUnavailable,
+				Message:          fmt.Sprintf("Product %s is not available", item.ProductVariantId),
+				ProductVariantID: &item.ProductVariantId,
+			})
+			continue
+		}
+
+		if partnerItem.AvailableQuantity < item.Quantity {
+			problems = append(problems, dto.CheckoutProblem{
+				Code: common.CheckoutProblemOutOfStock,
+				Message: fmt.Sprintf("Product %s: requested %d, available %d",
+					item.ProductVariantId, item.Quantity, partnerItem.AvailableQuantity),
+				ProductVariantID: &item.ProductVariantId,
+			})
+		}
+
+		quoteData.Items = append(quoteData.Items, item)
+	}
+
+	dataOrder := dto.CheckoutRequest{}.FromDto(quoteData, additionInfoMap)
+
+	if err = s.applyExchangeRates(ctx, &dataOrder); err != nil {
+		return nil, err
+	}
+
+	// missing shipping rates only make shipping fee unknown, other parts of quote are still calculated
+	if err = s.applyShippingFees(ctx, &dataOrder); err != nil {
+		if status.Code(err) != codes.FailedPrecondition {
+			return nil, err
+		}
+
+		problems = append(problems, dto.CheckoutProblem{
+			Code:    common.CheckoutProblemShippingUnavailable,
+			Message: status.Convert(err).Message(),
+		})
+
+		for idx := range dataOrder.Items {
+			dataOrder.Items[idx].ShippingFee = money.Zero
+		}
+	}
+
+	quote, err := s.paymentRepo.QuoteOrder(ctx, dataOrder)
+
+	if err != nil {
+		return nil, err
+	}
+
+	quote.Problems = append(problems, quote.Problems...)
+
+	return toQuoteCheckoutResponse(quote), nil
+}
+
+// toQuoteCheckoutResponse converts quote into response, packages of suppliers keep order of their first item
+func toQuoteCheckoutResponse(quote dto.OrderQuote) *order_proto_gen.QuoteCheckoutResponse {
+	currency := quote.Currency
+
+	res := &order_proto_gen.QuoteCheckoutResponse{
+		Items:            make([]*order_proto_gen.QuoteCheckoutItemResponse, 0, len(quote.Items)),
+		Suppliers:        make([]*order_proto_gen.QuoteCheckoutSupplierResponse, 0),
+		SubTotal:         dto.ToMoneyProto(quote.SubTotal, currency),
+		DiscountAmount:   dto.ToMoneyProto(quote.DiscountAmount, currency),
+		TaxAmount:        dto.ToMoneyProto(quote.TaxAmount, currency),
+		ShippingFee:      dto.ToMoneyProto(quote.ShippingFee, currency),
+		TotalAmount:      dto.ToMoneyProto(quote.TotalAmount, currency),
+		PricesIncludeTax: quote.PricesIncludeTax,
+		Problems:         make([]*order_proto_gen.CheckoutProblemResponse, 0, len(quote.Problems)),
+	}
+
+	type supplierTotal struct {
+		supplierID     int64
+		subTotal       money.Money
+		discountAmount money.Money
+		taxAmount      money.Money
+		shippingFee    money.Money
+		totalAmount    money.Money
+	}
+
+	supplierMap := make(map[int64]*supplierTotal)
+	suppliers := make([]*supplierTotal, 0)
+
+	for _, item := range quote.Items {
+		itemTotal := item.TotalPrice.Sub(item.DiscountAmount).Add(item.ShippingFee)
+
+		// tax of tax-inclusive prices is already in total price
+		if !quote.PricesIncludeTax {
+			itemTotal = itemTotal.Add(item.TaxAmount)
+		}
+
+		res.Items = append(res.Items, &order_proto_gen.QuoteCheckoutItemResponse{
+			ProductVariantId: item.ProductVariantID,
+			SupplierId:       item.SupplierID,
+			Quantity:         item.Quantity,
+			UnitPrice:        dto.ToMoneyProto(item.UnitPrice, currency),
+			TotalPrice:       dto.ToMoneyProto(item.TotalPrice, currency),
+			DiscountAmount:   dto.ToMoneyProto(item.DiscountAmount, currency),
+			TaxAmount:        dto.ToMoneyProto(item.TaxAmount, currency),
+			ShippingFee:      dto.ToMoneyProto(item.ShippingFee, currency),
+			TotalAmount:      dto.ToMoneyProto(itemTotal, currency),
+			CouponId:         item.CouponID,
+		})
+
+		supplier, ok := supplierMap[item.SupplierID]
+
+		if !ok {
+			supplier = &supplierTotal{supplierID: item.SupplierID}
+			supplierMap[item.SupplierID] = supplier
+			suppliers = append(suppliers, supplier)
+		}
+
+		supplier.subTotal = supplier.subTotal.Add(item.TotalPrice)
+		supplier.discountAmount = supplier.discountAmount.Add(item.DiscountAmount)
+		supplier.taxAmount = supplier.taxAmount.Add(item.TaxAmount)
+		supplier.shippingFee = supplier.shippingFee.Add(item.ShippingFee)
+		supplier.totalAmount = supplier.totalAmount.Add(itemTotal)
+	}
+
+	for _, supplier := range suppliers {
+		res.Suppliers = append(res.Suppliers, &order_proto_gen.QuoteCheckoutSupplierResponse{
+			SupplierId:     supplier.supplierID,
+			SubTotal:       dto.ToMoneyProto(supplier.subTotal, currency),
+			DiscountAmount: dto.ToMoneyProto(supplier.discountAmount, currency),
+			TaxAmount:      dto.ToMoneyProto(supplier.taxAmount, currency),
+			ShippingFee:    dto.ToMoneyProto(supplier.shippingFee, currency),
+			TotalAmount:    dto.ToMoneyProto(supplier.totalAmount, currency),
+		})
+	}
+
+	for _, problem := range quote.Problems {
+		res.Problems = append(res.Problems, &order_proto_gen.CheckoutProblemResponse{
+			Code:             string(problem.Code),
+			Message:          problem.Message,
+			ProductVariantId: problem.ProductVariantID,
+			CouponId:         problem.CouponID,
+		})
+	}
+
+	return res
+}
+
+// toAdditionInfo returns information of products from partner service by product variant id
+func toAdditionInfo(resultPartner *partner_proto_gen.GetProdInfoForPaymentResponse) (map[string]dto.AdditionalInfoCheckout, error) {
+	additionInfo := make(map[string]dto.AdditionalInfoCheckout, len(resultPartner.Items))
 
-	// enrich information about product
 	for _, item := range resultPartner.Items {
-		additionInfoMap[item.ProductVariantId] = dto.AdditionalInfoCheckout{
-			OriginalUnitPrice: item.OriginalUnitPrice,
-			DiscountUnitPrice: item.DiscountUnitPrice,
+		originalUnitPrice, discountUnitPrice, err := parsePartnerPrices(item.OriginalUnitPrice, item.DiscountUnitPrice)
+
+		if err != nil {
+			return nil, err
+		}
+
+		additionInfo[item.ProductVariantId] = dto.AdditionalInfoCheckout{
+			OriginalUnitPrice: originalUnitPrice,
+			DiscountUnitPrice: discountUnitPrice,
 			TaxClass:          item.TaxClass,
 			SupplierID:        item.SupplierId,
+			CategoryID:        item.CategoryId,
+			ShippingClass:     item.ShippingClass,
+			WeightGrams:       item.WeightGrams,
+			SupplierProvince:  item.SupplierProvince,
+			SupplierDistrict:  item.SupplierDistrict,
+			Currency:          item.Currency,
+		}
+	}
+
+	return additionInfo, nil
+}
+
+// getProviderTokenForCheckout checks saved payment method picked at checkout belongs to buyer and matches method of order
+func (s *paymentService) getProviderTokenForCheckout(ctx context.Context, userID, userPaymentMethodID int64,
+	methodType common.MethodType) (string, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "getProviderTokenForCheckout"))
+	defer span.End()
+
+	userPaymentMethod, err := s.userPaymentMethodRepo.GetUserPaymentMethodByID(ctx, userID, userPaymentMethodID)
+
+	if err != nil {
+		return "", err
+	}
+
+	if common.MethodType(userPaymentMethod.PaymentMethodCode) != methodType {
+		return "", status.Errorf(codes.FailedPrecondition, "Saved payment method is not %v", methodType)
+	}
+
+	if userPaymentMethod.ProviderToken == nil {
+		return "", nil
+	}
+
+	return *userPaymentMethod.ProviderToken, nil
+}
+
+// reservationTTLSeconds returns how long inventory is held for order, 0 means hold until supplier confirms
+// or order item is cancelled. Hold of online payment lives longer than payment ttl, so expirer of order releases it first
+func (s *paymentService) reservationTTLSeconds(methodType common.MethodType) int64 {
+	if methodType == common.Cod {
+		return 0
+	}
+
+	paymentConfig := s.envManager.PaymentConfig
+
+	return int64((paymentConfig.PaymentTTL + 2*paymentConfig.PaymentExpireInterval) * 60)
+}
+
+func (s *paymentService) createPayment(ctx context.Context, paymentGateway adaptor.IPaymentGateway,
+	data adaptor.CreatePaymentRequest) (string, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "createPayment"))
+	defer span.End()
+
+	result, errGateway := paymentGateway.CreatePayment(ctx, data)
+
+	var attempt []byte
+
+	if result != nil {
+		attempt = result.Attempt
+	}
+
+	if err := s.savePaymentAttempt(ctx, data.OrderID, paymentGateway.Code(), attempt, errGateway); err != nil {
+		span.RecordError(err)
+
+		if errGateway == nil {
+			return "", err
+		}
+	}
+
+	if errGateway != nil {
+		span.RecordError(errGateway)
+		return "", errGateway
+	}
+
+	return result.PaymentURL, nil
+}
+
+// savePaymentAttempt stores request and response of payment gateway into payment history of order,
+// payment is failed when errGateway is not nil
+func (s *paymentService) savePaymentAttempt(ctx context.Context, orderID string, methodCode common.MethodType,
+	attempt []byte, errGateway error) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "savePaymentAttempt"))
+	defer span.End()
+
+	paymentStatus := common.PaymentStatusPending
+	var errorMessage *string
+
+	if errGateway != nil {
+		paymentStatus = common.PaymentStatusFailed
+		message := errGateway.Error()
+
+		if st, ok := status.FromError(errGateway); ok {
+			message = st.Message()
 		}
+
+		errorMessage = &message
 	}
 
-	dataOrder := dto.CheckoutRequest{}.FromDto(data, additionInfoMap)
+	if err := s.paymentRepo.SavePaymentAttempt(ctx, orderID, methodCode, paymentStatus, attempt, errorMessage); err != nil {
+		span.RecordError(err)
+		return err
+	}
 
-	// step 2: create order in db
-	orderID, statusOrder, totalAmount, err := s.paymentRepo.CreateOrder(ctx, dataOrder)
+	return nil
+}
+
+// HandlePaymentCallback saves result of payment sent by payment gateway, outcome of it is returned as acknowledgement
+// which payment gateway expects, so error is only returned when payment gateway of method is unknown
+func (s *paymentService) HandlePaymentCallback(ctx context.Context, data *order_proto_gen.HandlePaymentCallbackRequest) (*order_proto_gen.HandlePaymentCallbackResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "HandlePaymentCallback"))
+	defer span.End()
+
+	methodCode := common.MethodType(data.MethodCode)
+
+	paymentGateway, err := s.paymentGateways.Get(methodCode)
 
 	if err != nil {
 		return nil, err
 	}
 
-	// todo: in the future, integrate with notification in here
-	// todo: send events throw kafka and notification service will consume it
-	// step 3: check type of method to return url or not
-	if data.MethodType == string(common.Cod) {
-		return &order_proto_gen.CheckoutResponse{
-			OrderId:    orderID,
-			Status:     string(statusOrder),
-			PaymentUrl: nil,
-		}, nil
+	outcome := adaptor.CallbackConfirmed
+
+	if err = s.savePaymentCallback(ctx, paymentGateway, data.RawData); err != nil {
+		span.RecordError(err)
+		outcome = callbackOutcome(err)
 	}
 
-	var paymentURL *string
-	// step 4: call to payment gateway (momo) to get payment url
-	switch common.MethodType(data.MethodType) {
-	case common.Momo:
-		payURL, errMomo := s.CreateOrderWithMomo(ctx, orderID, totalAmount)
+	ack := paymentGateway.CallbackAck(outcome)
+
+	return &order_proto_gen.HandlePaymentCallbackResponse{
+		StatusCode:  int32(ack.StatusCode),
+		ContentType: ack.ContentType,
+		Body:        ack.Body,
+	}, nil
+}
+
+func (s *paymentService) savePaymentCallback(ctx context.Context, paymentGateway adaptor.IPaymentGateway, rawData []byte) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "savePaymentCallback"))
+	defer span.End()
 
-		if errMomo != nil {
-			return nil, errMomo
+	result, err := paymentGateway.VerifyCallback(ctx, rawData)
+
+	if err != nil {
+		span.RecordError(err)
+
+		// callback which can not be verified is not trusted
+		if status.Code(err) == codes.InvalidArgument {
+			return status.Error(codes.Unauthenticated, err.Error())
 		}
 
-		paymentURL = &payURL
+		return err
 	}
 
-	return &order_proto_gen.CheckoutResponse{
-		OrderId:    orderID,
-		Status:     string(statusOrder),
-		PaymentUrl: paymentURL,
-	}, nil
+	nextStatus := common.Pending
+	paymentStatus := common.PaymentStatusCompleted
+
+	if !result.IsSuccess {
+		nextStatus = common.PaymentFailed
+		paymentStatus = common.PaymentStatusFailed
+	}
+
+	sagaIDs, err := s.paymentRepo.UpdateOrderPaymentResult(ctx, paymentGateway.Code(), dto.PaymentResult{
+		OrderID:       result.OrderID,
+		TransactionID: result.TransactionID,
+		Amount:        result.Amount,
+		Message:       result.Message,
+		RawData:       result.RawData,
+	}, nextStatus, paymentStatus)
+
+	if err != nil {
+		return err
+	}
+
+	continueOrderItemStockSagas(ctx, s.sagaCoordinator, sagaIDs...)
+
+	return nil
+}
+
+// callbackOutcome maps error of saving payment callback to outcome which is acknowledged to payment gateway
+func callbackOutcome(err error) adaptor.CallbackOutcome {
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		return adaptor.CallbackInvalidSignature
+	case codes.NotFound:
+		return adaptor.CallbackOrderNotFound
+	case codes.InvalidArgument:
+		return adaptor.CallbackInvalidAmount
+	case codes.AlreadyExists:
+		return adaptor.CallbackAlreadyConfirmed
+	}
+
+	return adaptor.CallbackFailed
+}
+
+func (s *paymentService) ExpireUnpaidOrders(ctx context.Context) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "ExpireUnpaidOrders"))
+	defer span.End()
+
+	createdBefore := time.Now().Add(-time.Duration(s.envManager.PaymentConfig.PaymentTTL) * time.Minute)
+
+	orders, err := s.paymentRepo.GetExpiredUnpaidOrders(ctx, createdBefore, expireUnpaidOrdersBatchSize)
+
+	if err != nil {
+		return err
+	}
+
+	for _, order := range orders {
+		if err = s.expireUnpaidOrder(ctx, order); err != nil {
+			// keep expiring other orders, this order will be retried in next tick
+			span.RecordError(err)
+			log.Printf("Failed to expire unpaid order %v: %v", order.ID, err)
+		}
+	}
+
+	return nil
 }
 
-func (s *paymentService) CreateOrderWithMomo(ctx context.Context, orderID string, totalAmount float64) (string, error) {
-	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CreateOrderWithMomo"))
+// expireUnpaidOrder asks payment gateway for status of payment before expiring order, so late payments are not lost
+func (s *paymentService) expireUnpaidOrder(ctx context.Context, order models.Order) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "expireUnpaidOrder"))
 	defer span.End()
 
-	total := int64(math.Ceil(totalAmount))
-	orderInfo := "Pay with Minh Plaza"
-	requestId := uuid.New().String()
-	requestType := "payWithMethod"
+	nextStatus := common.Cancelled
+	reason := fmt.Sprintf("Payment with %v is expired", order.ShippingMethod)
 
-	rawSignature := fmt.Sprintf("accessKey=%v&amount=%v&extraData=%v&ipnUrl=%v&orderId=%v&orderInfo=%v&partnerCode=%v&redirectUrl=%v&requestId=%v&requestType=%v",
-		s.envManager.MomoConfig.MomoAccessKey, total, "", s.envManager.MomoConfig.MomoNotifyURL, orderID, orderInfo, s.envManager.MomoConfig.MomoPartnerCode,
-		s.envManager.MomoConfig.MomoRedirectURL, requestId, requestType)
+	if s.envManager.PaymentConfig.PaymentQueryStatusOnExpired {
+		paymentGateway, err := s.paymentGateways.Get(order.ShippingMethod)
 
-	hmacBuilder := hmac.New(sha256.New, []byte(s.envManager.MomoConfig.MomoSecretKey))
-	hmacBuilder.Write([]byte(rawSignature))
+		if err != nil {
+			return err
+		}
 
-	signature := hex.EncodeToString(hmacBuilder.Sum(nil))
+		result, err := paymentGateway.QueryStatus(ctx, adaptor.QueryStatusRequest{
+			OrderID:   order.ID,
+			CreatedAt: order.CreatedAt,
+		})
 
-	payload := dto.MomoPaymentCreateRequest{
-		PartnerCode: s.envManager.MomoConfig.MomoPartnerCode,
-		RequestID:   requestId,
-		Amount:      total,
-		OrderID:     orderID,
-		OrderInfo:   orderInfo,
-		RedirectUrl: s.envManager.MomoConfig.MomoRedirectURL,
-		IpnUrl:      s.envManager.MomoConfig.MomoNotifyURL,
-		RequestType: requestType,
-		ExtraData:   "",
-		Lang:        "vi",
-		AutoCapture: true,
-		Signature:   signature,
+		if err != nil {
+			return err
+		}
+
+		switch result.Status {
+		case adaptor.QueryStatusPaid:
+			// buyer paid but ipn did not come, so handle it like ipn
+			sagaIDs, err := s.paymentRepo.UpdateOrderPaymentResult(ctx, order.ShippingMethod, dto.PaymentResult{
+				OrderID:       order.ID,
+				TransactionID: result.TransactionID,
+				Amount:        result.Amount,
+				Message:       result.Message,
+				RawData:       result.RawResponse,
+			}, common.Pending, common.PaymentStatusCompleted)
+
+			// ipn came while payment was queried
+			if status.Code(err) == codes.AlreadyExists {
+				return nil
+			}
+
+			if err != nil {
+				return err
+			}
+
+			continueOrderItemStockSagas(ctx, s.sagaCoordinator, sagaIDs...)
+
+			return nil
+		case adaptor.QueryStatusPending:
+			// transaction is still waiting for buyer, it is abandoned after ttl
+		default:
+			nextStatus = common.PaymentFailed
+			reason = result.Message
+		}
 	}
 
-	resApi, err := s.httpClient.SendRequest(ctx, http.MethodPost, fmt.Sprintf("%v/v2/gateway/api/create", s.envManager.MomoConfig.MomoHost),
-		httpclient.WithJSONBody(payload),
-		httpclient.WithHeader("Content-Type", "application/json; charset=UTF-8"))
+	expiredItems, sagaIDs, err := s.paymentRepo.ExpireUnpaidOrder(ctx, order.ID, nextStatus, reason)
 
 	if err != nil {
-		return "", status.Error(codes.Internal, err.Error())
+		return err
+	}
+
+	continueOrderItemStockSagas(ctx, s.sagaCoordinator, sagaIDs...)
+
+	if expiredItems == 0 {
+		return nil
+	}
+
+	s.sendOrderExpiredNotification(ctx, order, nextStatus)
+
+	return nil
+}
+
+func (s *paymentService) sendOrderExpiredNotification(ctx context.Context, order models.Order, nextStatus common.StatusOrder) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "sendOrderExpiredNotification"))
+	defer span.End()
+
+	content := fmt.Sprintf("Đơn hàng %v đã bị hủy do không thanh toán qua %v đúng hạn", order.ID, order.ShippingMethod)
+
+	if nextStatus == common.PaymentFailed {
+		content = fmt.Sprintf("Thanh toán %v cho đơn hàng %v không thành công", order.ShippingMethod, order.ID)
 	}
 
-	var response dto.MomoPaymentCreateResponse
+	message := &notification_proto_gen.SendNotificationRequest{
+		UserId:  order.UserID,
+		Type:    int64(enum.PaymentType),
+		Title:   "Thanh toán không thành công",
+		Content: content,
+		Metadata: map[string]string{
+			"order_id": order.ID,
+			"status":   string(nextStatus),
+		},
+	}
 
-	if err = json.Unmarshal(resApi.RawBody, &response); err != nil {
-		return "", status.Error(codes.Internal, err.Error())
+	rawBytes, err := proto.Marshal(message)
+
+	if err != nil {
+		// notification is not important than order, so just log it
+		span.RecordError(err)
+		log.Printf("Failed to marshal notification message: %v", err)
+		return
 	}
 
-	if response.ResultCode != 0 && response.ResultCode != 9000 {
-		return "", status.Error(codes.FailedPrecondition, response.Message)
+	if err = s.messageBroker.Produce(ctx, s.envManager.TopicOrderNotification, rawBytes); err != nil {
+		span.RecordError(err)
+		log.Printf("Failed to send notification of order %v: %v", order.ID, err)
 	}
+}
 
-	if response.OrderID != orderID {
-		return "", status.Error(codes.FailedPrecondition, "Order is is not match")
+// applyShippingFees sets ShippingFee of checkout items from shipping rates of their shipping classes
+func (s *paymentService) applyShippingFees(ctx context.Context, data *dto.CheckoutRequest) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "applyShippingFees"))
+	defer span.End()
+
+	shippingClasses := []string{common.DefaultShippingClass}
+
+	for _, item := range data.Items {
+		shippingClasses = append(shippingClasses, item.ShippingClass)
 	}
 
-	if response.RequestID != requestId {
-		return "", status.Error(codes.FailedPrecondition, "RequestId is not match")
+	shippingRates, err := s.shippingRateRepo.GetShippingRates(ctx, shippingClasses)
+
+	if err != nil {
+		return err
 	}
 
-	if response.PartnerCode != s.envManager.MomoConfig.MomoPartnerCode {
-		return "", status.Error(codes.FailedPrecondition, "Partner code is not match")
+	// shipping rates are in base currency
+	rate, err := data.ExchangeRates.Rate(common.DefaultCurrency, data.Currency)
+
+	if err != nil {
+		return err
 	}
 
-	if response.Amount != total {
-		return "", status.Error(codes.FailedPrecondition, "Amount money is not match")
+	for idx := range shippingRates {
+		shippingRates[idx].BaseFee = shippingRates[idx].BaseFee.Convert(rate)
+		shippingRates[idx].FeePerExtraKg = shippingRates[idx].FeePerExtraKg.Convert(rate)
 	}
 
-	return response.PayURL, nil
+	return calculatePackageShippingFees(data.Items, shippingRates, data.ShippingProvince, data.ShippingDistrict)
 }
 
-func (s *paymentService) UpdateOrderStatusFromMomo(ctx context.Context, data *order_proto_gen.UpdateOrderStatusFromMomoRequest) error {
-	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "UpdateOrderStatusFromMomo"))
+// applyExchangeRates sets currency of checkout to currency of payment gateway and converts prices of items into it,
+// rate of each item is kept, so it is saved on order item
+func (s *paymentService) applyExchangeRates(ctx context.Context, data *dto.CheckoutRequest) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "applyExchangeRates"))
 	defer span.End()
 
-	if err := s.paymentRepo.UpdateOrderStatusFromMomo(ctx, data); err != nil {
+	// cod is paid in cash to deliverer, so it is settled in base currency
+	data.Currency = common.DefaultCurrency
+
+	if data.MethodType != common.Cod {
+		paymentGateway, err := s.paymentGateways.Get(data.MethodType)
+
+		if err != nil {
+			return err
+		}
+
+		data.Currency = paymentGateway.Currency()
+	}
+
+	exchangeRates, err := s.exchangeRateRepo.GetExchangeRates(ctx)
+
+	if err != nil {
 		return err
 	}
 
+	data.ExchangeRates = dto.NewExchangeRates(exchangeRates)
+
+	for idx, item := range data.Items {
+		// prices of products which have no currency are in base currency
+		if item.Currency == "" {
+			item.Currency = common.DefaultCurrency
+		}
+
+		rate, err := data.ExchangeRates.Rate(item.Currency, data.Currency)
+
+		if err != nil {
+			return err
+		}
+
+		data.Items[idx].Currency = item.Currency
+		data.Items[idx].ExchangeRate = rate
+		data.Items[idx].OriginalUnitPrice = item.OriginalUnitPrice.Convert(rate)
+		data.Items[idx].DiscountUnitPrice = item.DiscountUnitPrice.Convert(rate)
+	}
+
 	return nil
 }
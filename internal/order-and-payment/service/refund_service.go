@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/adaptor"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/repository"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type refundService struct {
+	tracer          pkg.Tracer
+	refundRepo      repository.IRefundRepository
+	paymentGateways adaptor.IPaymentGatewayRegistry
+}
+
+func NewRefundService(tracer pkg.Tracer, refundRepo repository.IRefundRepository,
+	paymentGateways adaptor.IPaymentGatewayRegistry) IRefundService {
+	return &refundService{
+		tracer:          tracer,
+		refundRepo:      refundRepo,
+		paymentGateways: paymentGateways,
+	}
+}
+
+func (s *refundService) CreateRefund(ctx context.Context, data *order_proto_gen.CreateRefundRequest) (*order_proto_gen.CreateRefundResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CreateRefund"))
+	defer span.End()
+
+	amount := dto.FromMoneyProto(data.Amount)
+
+	if !amount.IsPositive() {
+		return nil, status.Error(codes.FailedPrecondition, "Refund amount must be greater than 0")
+	}
+
+	refund, err := s.refundRepo.CreateRefund(ctx, data.PaymentHistoryId, amount, data.ProcessedBy)
+
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err = s.processRefund(ctx, refund.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.CreateRefundResponse{
+		Refund: s.toRefundResponse(refund),
+	}, nil
+}
+
+func (s *refundService) ProcessRefund(ctx context.Context, refundID string) (*order_proto_gen.ProcessRefundResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "ProcessRefund"))
+	defer span.End()
+
+	refund, err := s.processRefund(ctx, refundID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.ProcessRefundResponse{
+		Refund: s.toRefundResponse(refund),
+	}, nil
+}
+
+func (s *refundService) GetRefunds(ctx context.Context, paymentHistoryID string) (*order_proto_gen.GetRefundsResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetRefunds"))
+	defer span.End()
+
+	refunds, err := s.refundRepo.GetRefunds(ctx, paymentHistoryID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &order_proto_gen.GetRefundsResponse{
+		Refunds: make([]*order_proto_gen.RefundResponse, 0, len(refunds)),
+	}
+
+	for _, refund := range refunds {
+		result.Refunds = append(result.Refunds, s.toRefundResponse(&refund))
+	}
+
+	return result, nil
+}
+
+// processRefund moves refund to processing, calls payment gateway and saves the result of gateway
+func (s *refundService) processRefund(ctx context.Context, refundID string) (*models.PaymentRefund, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "processRefund"))
+	defer span.End()
+
+	refund, payment, order, err := s.refundRepo.StartProcessingRefund(ctx, refundID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	transactionID, gatewayResponse, errGateway := s.refundWithGateway(ctx, refund, payment, order)
+
+	if errGateway != nil {
+		span.RecordError(errGateway)
+
+		if _, err = s.refundRepo.FailRefund(ctx, refund.ID, gatewayResponse); err != nil {
+			return nil, err
+		}
+
+		return nil, errGateway
+	}
+
+	return s.refundRepo.CompleteRefund(ctx, refund.ID, transactionID, gatewayResponse)
+}
+
+func (s *refundService) refundWithGateway(ctx context.Context, refund *models.PaymentRefund, payment *models.PaymentHistory,
+	order *models.Order) (string, []byte, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "refundWithGateway"))
+	defer span.End()
+
+	if payment.PaymentGateway == nil {
+		return "", nil, status.Error(codes.FailedPrecondition, "Payment gateway of payment is unknown")
+	}
+
+	if payment.TransactionID == nil {
+		return "", nil, status.Error(codes.FailedPrecondition, "Payment does not have transaction id")
+	}
+
+	paymentGateway, err := s.paymentGateways.Get(common.MethodType(*payment.PaymentGateway))
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, err := paymentGateway.Refund(ctx, adaptor.RefundRequest{
+		RefundID:       refund.ID,
+		OrderID:        order.ID,
+		OrderItemID:    payment.OrderItemID,
+		TransactionID:  *payment.TransactionID,
+		Amount:         refund.Amount,
+		OrderCreatedAt: order.CreatedAt,
+		PaymentAmount:  payment.Amount,
+		ProcessedBy:    refund.ProcessedBy,
+	})
+
+	var gatewayResponse []byte
+
+	if result != nil {
+		gatewayResponse = result.RawResponse
+	}
+
+	if err != nil {
+		return "", gatewayResponse, err
+	}
+
+	return result.TransactionID, gatewayResponse, nil
+}
+
+func (s *refundService) toRefundResponse(refund *models.PaymentRefund) *order_proto_gen.RefundResponse {
+	var refundedAt *timestamppb.Timestamp
+
+	if refund.RefundedAt != nil {
+		refundedAt = timestamppb.New(*refund.RefundedAt)
+	}
+
+	return &order_proto_gen.RefundResponse{
+		Id:               refund.ID,
+		PaymentHistoryId: refund.PaymentHistoryID,
+		Amount:           dto.ToMoneyProto(refund.Amount, refund.Currency),
+		Status:           string(refund.Status),
+		TransactionId:    refund.TransactionID,
+		ProcessedBy:      refund.ProcessedBy,
+		RefundedAt:       refundedAt,
+	}
+}
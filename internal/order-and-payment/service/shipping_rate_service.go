@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/repository"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+)
+
+type shippingRateService struct {
+	tracer           pkg.Tracer
+	shippingRateRepo repository.IShippingRateRepository
+}
+
+func NewShippingRateService(tracer pkg.Tracer, shippingRateRepo repository.IShippingRateRepository) IShippingRateService {
+	return &shippingRateService{
+		tracer:           tracer,
+		shippingRateRepo: shippingRateRepo,
+	}
+}
+
+func (s *shippingRateService) GetShippingRates(ctx context.Context, data *order_proto_gen.GetShippingRatesRequest) (*order_proto_gen.GetShippingRatesResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetShippingRates"))
+	defer span.End()
+
+	var shippingClasses []string
+
+	if data.ShippingClass != nil {
+		shippingClasses = append(shippingClasses, *data.ShippingClass)
+	}
+
+	shippingRates, err := s.shippingRateRepo.GetShippingRates(ctx, shippingClasses)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*order_proto_gen.ShippingRateResponse, 0, len(shippingRates))
+
+	for _, shippingRate := range shippingRates {
+		result = append(result, &order_proto_gen.ShippingRateResponse{
+			Id:              shippingRate.ID,
+			ShippingClass:   shippingRate.ShippingClass,
+			Zone:            string(shippingRate.Zone),
+			BaseFee:         dto.ToMoneyProto(shippingRate.BaseFee, common.DefaultCurrency),
+			BaseWeightGrams: shippingRate.BaseWeightGrams,
+			FeePerExtraKg:   dto.ToMoneyProto(shippingRate.FeePerExtraKg, common.DefaultCurrency),
+			CreatedAt:       timestamppb.New(shippingRate.CreatedAt),
+			UpdatedAt:       timestamppb.New(shippingRate.UpdatedAt),
+		})
+	}
+
+	return &order_proto_gen.GetShippingRatesResponse{
+		Data: result,
+	}, nil
+}
+
+func (s *shippingRateService) CreateShippingRate(ctx context.Context, data *order_proto_gen.CreateShippingRateRequest) (*order_proto_gen.CreateShippingRateResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CreateShippingRate"))
+	defer span.End()
+
+	if strings.TrimSpace(data.ShippingClass) == "" {
+		return nil, status.Error(codes.InvalidArgument, "Shipping class is required")
+	}
+
+	switch common.ShippingZone(data.Zone) {
+	case common.ShippingZoneIntraDistrict, common.ShippingZoneIntraProvince, common.ShippingZoneInterProvince:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Shipping zone %s is not supported", data.Zone)
+	}
+
+	if err := validateShippingFee(dto.FromMoneyProto(data.BaseFee), data.BaseWeightGrams, dto.FromMoneyProto(data.FeePerExtraKg)); err != nil {
+		return nil, err
+	}
+
+	id, err := s.shippingRateRepo.CreateShippingRate(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.CreateShippingRateResponse{
+		Id: id,
+	}, nil
+}
+
+func (s *shippingRateService) UpdateShippingRate(ctx context.Context, data *order_proto_gen.UpdateShippingRateRequest) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "UpdateShippingRate"))
+	defer span.End()
+
+	if err := validateShippingFee(dto.FromMoneyProto(data.BaseFee), data.BaseWeightGrams, dto.FromMoneyProto(data.FeePerExtraKg)); err != nil {
+		return err
+	}
+
+	return s.shippingRateRepo.UpdateShippingRate(ctx, data)
+}
+
+func (s *shippingRateService) DeleteShippingRate(ctx context.Context, id int64) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "DeleteShippingRate"))
+	defer span.End()
+
+	return s.shippingRateRepo.DeleteShippingRate(ctx, id)
+}
+
+func validateShippingFee(baseFee money.Money, baseWeightGrams int64, feePerExtraKg money.Money) error {
+	if baseFee.IsNegative() || baseWeightGrams < 0 || feePerExtraKg.IsNegative() {
+		return status.Error(codes.InvalidArgument, "Base fee, base weight and fee per extra kg must not be negative")
+	}
+
+	return nil
+}
+
+// shippingZone compares origin of supplier with destination of buyer by name of divisions,
+// supplier which has no province of business address is shipped as other province
+func shippingZone(supplierProvince, supplierDistrict *string, province, district string) common.ShippingZone {
+	if supplierProvince == nil || !strings.EqualFold(strings.TrimSpace(*supplierProvince), strings.TrimSpace(province)) {
+		return common.ShippingZoneInterProvince
+	}
+
+	if supplierDistrict != nil && strings.EqualFold(strings.TrimSpace(*supplierDistrict), strings.TrimSpace(district)) {
+		return common.ShippingZoneIntraDistrict
+	}
+
+	return common.ShippingZoneIntraProvince
+}
+
+// calculatePackageShippingFees puts items of each supplier into one package, its fee is fee of the most expensive
+// shipping class of package for total weight. Fee is spread into ShippingFee of items by quantity, each part is rounded
+// to 2 decimals and the last item takes the rest, so sum of parts is always same as fee of package
+func calculatePackageShippingFees(items []dto.CheckoutItemRequest, shippingRates []models.ShippingRate, province, district string) error {
+	rateMap := make(map[string]map[common.ShippingZone]models.ShippingRate)
+
+	for _, shippingRate := range shippingRates {
+		if _, ok := rateMap[shippingRate.ShippingClass]; !ok {
+			rateMap[shippingRate.ShippingClass] = make(map[common.ShippingZone]models.ShippingRate)
+		}
+
+		rateMap[shippingRate.ShippingClass][shippingRate.Zone] = shippingRate
+	}
+
+	// keep order of suppliers as order of items
+	supplierIDs := make([]int64, 0)
+	packages := make(map[int64][]int)
+
+	for idx, item := range items {
+		if _, ok := packages[item.SupplierID]; !ok {
+			supplierIDs = append(supplierIDs, item.SupplierID)
+		}
+
+		packages[item.SupplierID] = append(packages[item.SupplierID], idx)
+	}
+
+	for _, supplierID := range supplierIDs {
+		itemIdxs := packages[supplierID]
+		first := items[itemIdxs[0]]
+		zone := shippingZone(first.SupplierProvince, first.SupplierDistrict, province, district)
+
+		var weightGrams int64 = 0
+		var quantity int64 = 0
+
+		for _, idx := range itemIdxs {
+			weightGrams += items[idx].WeightGrams * items[idx].Quantity
+			quantity += items[idx].Quantity
+		}
+
+		fee := money.Zero
+
+		for _, idx := range itemIdxs {
+			shippingRate, ok := rateMap[items[idx].ShippingClass][zone]
+
+			if !ok {
+				shippingRate, ok = rateMap[common.DefaultShippingClass][zone]
+			}
+
+			if !ok {
+				return status.Errorf(codes.FailedPrecondition, "Shipping class %s has no rate for zone %s",
+					items[idx].ShippingClass, zone)
+			}
+
+			extraKg := (max(weightGrams-shippingRate.BaseWeightGrams, 0) + 999) / 1000
+			fee = money.Max(fee, shippingRate.BaseFee.Add(shippingRate.FeePerExtraKg.Mul(extraKg)))
+		}
+
+		spread := money.Zero
+
+		for i, idx := range itemIdxs {
+			part := fee.MulRatio(items[idx].Quantity, quantity)
+
+			if i == len(itemIdxs)-1 {
+				part = fee.Sub(spread)
+			}
+
+			items[idx].ShippingFee = part
+			spread = spread.Add(part)
+		}
+	}
+
+	return nil
+}
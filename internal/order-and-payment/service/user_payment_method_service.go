@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/env"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/repository"
+	"github.com/TienMinh25/ecommerce-platform/internal/utils"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type userPaymentMethodService struct {
+	tracer                pkg.Tracer
+	userPaymentMethodRepo repository.IUserPaymentMethodRepository
+	envManager            *env.EnvManager
+}
+
+func NewUserPaymentMethodService(tracer pkg.Tracer, userPaymentMethodRepo repository.IUserPaymentMethodRepository,
+	envManager *env.EnvManager) IUserPaymentMethodService {
+	return &userPaymentMethodService{
+		tracer:                tracer,
+		userPaymentMethodRepo: userPaymentMethodRepo,
+		envManager:            envManager,
+	}
+}
+
+func (s *userPaymentMethodService) GetUserPaymentMethods(ctx context.Context, userID int64) (*order_proto_gen.GetUserPaymentMethodsResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetUserPaymentMethods"))
+	defer span.End()
+
+	userPaymentMethods, err := s.userPaymentMethodRepo.GetUserPaymentMethods(ctx, userID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &order_proto_gen.GetUserPaymentMethodsResponse{
+		UserPaymentMethods: make([]*order_proto_gen.UserPaymentMethodResponse, 0, len(userPaymentMethods)),
+	}
+
+	for _, userPaymentMethod := range userPaymentMethods {
+		result.UserPaymentMethods = append(result.UserPaymentMethods, s.toUserPaymentMethodResponse(&userPaymentMethod))
+	}
+
+	return result, nil
+}
+
+func (s *userPaymentMethodService) CreateUserPaymentMethod(ctx context.Context, data *order_proto_gen.CreateUserPaymentMethodRequest) (*order_proto_gen.CreateUserPaymentMethodResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CreateUserPaymentMethod"))
+	defer span.End()
+
+	if common.MethodType(data.PaymentMethodCode) == common.Cod {
+		return nil, status.Error(codes.FailedPrecondition, "Cod can not be saved as payment method")
+	}
+
+	if data.CardExpiryMonth < 1 || data.CardExpiryMonth > 12 {
+		return nil, status.Error(codes.InvalidArgument, "Card expiry month is invalid")
+	}
+
+	now := time.Now()
+
+	if data.CardExpiryYear < int64(now.Year()) ||
+		(data.CardExpiryYear == int64(now.Year()) && data.CardExpiryMonth < int64(now.Month())) {
+		return nil, status.Error(codes.FailedPrecondition, "Card is expired")
+	}
+
+	userPaymentMethod := models.UserPaymentMethod{
+		UserID:            data.UserId,
+		PaymentMethodCode: data.PaymentMethodCode,
+		IsDefault:         data.IsDefault,
+		CardHolderName:    data.CardHolderName,
+		CardBrand:         data.CardBrand,
+		CardExpiryMonth:   &data.CardExpiryMonth,
+		CardExpiryYear:    &data.CardExpiryYear,
+	}
+
+	switch {
+	case data.ProviderToken != nil && *data.ProviderToken != "":
+		if data.CardLast4 == nil || !isDigits(*data.CardLast4) || len(*data.CardLast4) != 4 {
+			return nil, status.Error(codes.InvalidArgument, "Last 4 digits of card are required with provider token")
+		}
+
+		userPaymentMethod.ProviderToken = data.ProviderToken
+		userPaymentMethod.CardLast4 = *data.CardLast4
+	case data.CardNumber != nil && *data.CardNumber != "":
+		// card number never leaves this function as plain text
+		cardNumber := strings.NewReplacer(" ", "", "-", "").Replace(*data.CardNumber)
+
+		if !isValidCardNumber(cardNumber) {
+			return nil, status.Error(codes.InvalidArgument, "Card number is invalid")
+		}
+
+		encryptedCardNumber, err := s.encryptCardNumber(cardNumber)
+
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+
+		userPaymentMethod.EncryptedCardNumber = &encryptedCardNumber
+		userPaymentMethod.CardLast4 = cardNumber[len(cardNumber)-4:]
+
+		if userPaymentMethod.CardBrand == nil {
+			brand := cardBrand(cardNumber)
+			userPaymentMethod.CardBrand = &brand
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Provider token or card number is required")
+	}
+
+	res, err := s.userPaymentMethodRepo.CreateUserPaymentMethod(ctx, userPaymentMethod)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.CreateUserPaymentMethodResponse{
+		UserPaymentMethod: s.toUserPaymentMethodResponse(res),
+	}, nil
+}
+
+func (s *userPaymentMethodService) SetDefaultUserPaymentMethod(ctx context.Context, userID, userPaymentMethodID int64) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "SetDefaultUserPaymentMethod"))
+	defer span.End()
+
+	return s.userPaymentMethodRepo.SetDefaultUserPaymentMethod(ctx, userID, userPaymentMethodID)
+}
+
+func (s *userPaymentMethodService) DeleteUserPaymentMethod(ctx context.Context, userID, userPaymentMethodID int64) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "DeleteUserPaymentMethod"))
+	defer span.End()
+
+	return s.userPaymentMethodRepo.DeleteUserPaymentMethod(ctx, userID, userPaymentMethodID)
+}
+
+func (s *userPaymentMethodService) encryptCardNumber(cardNumber string) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(s.envManager.OrderAndPaymentServerConfig.CardEncryptionKey)
+
+	if err != nil || len(key) != 32 {
+		return "", status.Error(codes.Internal, "Card encryption key is not configured")
+	}
+
+	encrypted, err := utils.EncryptAESGCM(key, cardNumber)
+
+	if err != nil {
+		return "", status.Error(codes.Internal, err.Error())
+	}
+
+	return encrypted, nil
+}
+
+func (s *userPaymentMethodService) toUserPaymentMethodResponse(userPaymentMethod *models.UserPaymentMethod) *order_proto_gen.UserPaymentMethodResponse {
+	res := &order_proto_gen.UserPaymentMethodResponse{
+		Id:                userPaymentMethod.ID,
+		PaymentMethodCode: userPaymentMethod.PaymentMethodCode,
+		PaymentMethodName: userPaymentMethod.PaymentMethodName,
+		CardHolderName:    userPaymentMethod.CardHolderName,
+		CardLast4:         userPaymentMethod.CardLast4,
+		IsDefault:         userPaymentMethod.IsDefault,
+		CreatedAt:         timestamppb.New(userPaymentMethod.CreatedAt),
+	}
+
+	if userPaymentMethod.CardBrand != nil {
+		res.CardBrand = *userPaymentMethod.CardBrand
+	}
+
+	if userPaymentMethod.CardExpiryMonth != nil {
+		res.CardExpiryMonth = *userPaymentMethod.CardExpiryMonth
+	}
+
+	if userPaymentMethod.CardExpiryYear != nil {
+		res.CardExpiryYear = *userPaymentMethod.CardExpiryYear
+	}
+
+	return res
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+
+	return value != ""
+}
+
+// isValidCardNumber checks length and luhn checksum of card number
+func isValidCardNumber(cardNumber string) bool {
+	if len(cardNumber) < 12 || len(cardNumber) > 19 || !isDigits(cardNumber) {
+		return false
+	}
+
+	sum := 0
+	double := false
+
+	for i := len(cardNumber) - 1; i >= 0; i-- {
+		digit := int(cardNumber[i] - '0')
+
+		if double {
+			digit *= 2
+
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+func cardBrand(cardNumber string) string {
+	switch {
+	case strings.HasPrefix(cardNumber, "9704"):
+		return "napas"
+	case strings.HasPrefix(cardNumber, "4"):
+		return "visa"
+	case strings.HasPrefix(cardNumber, "34"), strings.HasPrefix(cardNumber, "37"):
+		return "amex"
+	case strings.HasPrefix(cardNumber, "35"):
+		return "jcb"
+	case cardNumber[0] == '5' && cardNumber[1] >= '1' && cardNumber[1] <= '5',
+		cardNumber[:4] >= "2221" && cardNumber[:4] <= "2720":
+		return "mastercard"
+	default:
+		return "unknown"
+	}
+}
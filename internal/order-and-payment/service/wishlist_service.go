@@ -0,0 +1,289 @@
+package service
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/grpc/proto/order_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/models"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/repository"
+	"github.com/TienMinh25/ecommerce-platform/internal/order-and-payment/service/dto"
+	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type wishlistService struct {
+	tracer           pkg.Tracer
+	wishlistRepo     repository.IWishlistRepository
+	cartRepo         repository.ICartRepository
+	exchangeRateRepo repository.IExchangeRateRepository
+	partnerClient    partner_proto_gen.PartnerServiceClient
+}
+
+func NewWishlistService(tracer pkg.Tracer, wishlistRepo repository.IWishlistRepository, cartRepo repository.ICartRepository,
+	exchangeRateRepo repository.IExchangeRateRepository, partnerClient partner_proto_gen.PartnerServiceClient) IWishlistService {
+	return &wishlistService{
+		tracer:           tracer,
+		wishlistRepo:     wishlistRepo,
+		cartRepo:         cartRepo,
+		exchangeRateRepo: exchangeRateRepo,
+		partnerClient:    partnerClient,
+	}
+}
+
+func (s *wishlistService) AddItemToWishlist(ctx context.Context, data *order_proto_gen.AddItemToWishlistRequest) (*order_proto_gen.AddItemToWishlistResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "AddItemToWishlist"))
+	defer span.End()
+
+	item, err := s.newWishlistItem(ctx, data.ProductId, data.ProductVariantId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	wishlistItemID, err := s.wishlistRepo.AddWishlistItem(ctx, data.UserId, *item)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.AddItemToWishlistResponse{
+		WishlistItemId: wishlistItemID,
+	}, nil
+}
+
+func (s *wishlistService) GetWishlist(ctx context.Context, data *order_proto_gen.GetWishlistRequest) (*order_proto_gen.GetWishlistResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetWishlist"))
+	defer span.End()
+
+	items, err := s.wishlistRepo.GetWishlistItems(ctx, data.UserId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := &order_proto_gen.GetWishlistResponse{
+		Items: make([]*order_proto_gen.WishlistItemResponse, 0, len(items)),
+	}
+
+	if len(items) == 0 {
+		return res, nil
+	}
+
+	in := make([]*partner_proto_gen.ProductInfoCart, 0, len(items))
+
+	for _, item := range items {
+		in = append(in, &partner_proto_gen.ProductInfoCart{
+			ProductId:        item.ProductID,
+			ProductVariantId: item.ProductVariantID,
+		})
+	}
+
+	resultPartner, err := s.partnerClient.GetProductInfoCart(ctx, &partner_proto_gen.GetProductInfoCartRequest{
+		Request: in,
+	})
+
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	productInfoMap := make(map[string]*partner_proto_gen.ProductInfoCartResponse, len(resultPartner.ProductInfo))
+
+	for _, productInfo := range resultPartner.ProductInfo {
+		productInfoMap[productInfo.ProductVariantId] = productInfo
+	}
+
+	exchangeRates, err := s.exchangeRateRepo.GetExchangeRates(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rates := dto.NewExchangeRates(exchangeRates)
+
+	for _, item := range items {
+		itemRes := &order_proto_gen.WishlistItemResponse{
+			WishlistItemId:   item.ID,
+			ProductId:        item.ProductID,
+			ProductVariantId: item.ProductVariantID,
+			SavedPrice:       dto.ToMoneyProto(item.SavedPrice, item.Currency),
+			AddedAt:          timestamppb.New(item.AddedAt),
+		}
+
+		// products which are not sold anymore are not returned by partner service
+		productInfo, ok := productInfoMap[item.ProductVariantID]
+
+		if ok {
+			price, discountPrice, err := parsePartnerPrices(productInfo.Price, productInfo.DiscountPrice)
+
+			if err != nil {
+				return nil, err
+			}
+
+			currency := productCurrency(productInfo)
+			itemRes.ProductName = productInfo.ProductName
+			itemRes.VariantName = productInfo.VariantName
+			itemRes.ProductVariantThumbnail = productInfo.ProductVariantThumbnail
+			itemRes.Price = dto.ToMoneyProto(price, currency)
+			itemRes.DiscountPrice = dto.ToMoneyProto(discountPrice, currency)
+			itemRes.Available = true
+
+			// current price is compared in currency of saved price, badge is not shown when it can not be converted
+			rate, err := rates.Rate(currency, item.Currency)
+
+			if err == nil {
+				itemRes.PriceDropped = wishlistPrice(price, discountPrice).Convert(rate).Cmp(item.SavedPrice) < 0
+			}
+		}
+
+		res.Items = append(res.Items, itemRes)
+	}
+
+	return res, nil
+}
+
+func (s *wishlistService) RemoveWishlistItems(ctx context.Context, data *order_proto_gen.RemoveWishlistItemsRequest) (*order_proto_gen.RemoveWishlistItemsResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "RemoveWishlistItems"))
+	defer span.End()
+
+	if len(data.WishlistItemIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No wishlist item IDs provided")
+	}
+
+	if err := s.wishlistRepo.DeleteWishlistItems(ctx, data.UserId, data.WishlistItemIds); err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.RemoveWishlistItemsResponse{
+		WishlistItemIds: data.WishlistItemIds,
+	}, nil
+}
+
+func (s *wishlistService) MoveWishlistItemToCart(ctx context.Context, data *order_proto_gen.MoveWishlistItemToCartRequest) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "MoveWishlistItemToCart"))
+	defer span.End()
+
+	if data.Quantity <= 0 {
+		return status.Error(codes.InvalidArgument, "Quantity must be greater than 0")
+	}
+
+	item, err := s.wishlistRepo.GetWishlistItem(ctx, data.UserId, data.WishlistItemId)
+
+	if err != nil {
+		return err
+	}
+
+	price, err := cartUnitPrice(ctx, s.partnerClient, item.ProductVariantID, data.Quantity)
+
+	if err != nil {
+		return err
+	}
+
+	// inventory is checked when item is added to cart, wishlist item is kept when it is not available
+	return s.cartRepo.MoveWishlistItemToCart(ctx, &order_proto_gen.AddItemToCartRequest{
+		UserId:           data.UserId,
+		ProductId:        item.ProductID,
+		ProductVariantId: item.ProductVariantID,
+		Quantity:         data.Quantity,
+	}, price, item.ID)
+}
+
+func (s *wishlistService) MoveCartItemToWishlist(ctx context.Context, data *order_proto_gen.MoveCartItemToWishlistRequest) (*order_proto_gen.MoveCartItemToWishlistResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "MoveCartItemToWishlist"))
+	defer span.End()
+
+	cartItems, err := s.cartRepo.GetCart(ctx, data.UserId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var cartItem *models.CartItem
+
+	for _, item := range cartItems {
+		if item.ID == data.CartItemId {
+			cartItem = item
+			break
+		}
+	}
+
+	if cartItem == nil {
+		return nil, status.Error(codes.NotFound, "Cart item not found")
+	}
+
+	item, err := s.newWishlistItem(ctx, cartItem.ProductID, cartItem.ProductVariantID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	wishlistItemID, err := s.wishlistRepo.MoveCartItemToWishlist(ctx, data.UserId, cartItem.ID, *item)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &order_proto_gen.MoveCartItemToWishlistResponse{
+		WishlistItemId: wishlistItemID,
+	}, nil
+}
+
+// newWishlistItem returns item with current price of product as saved price
+func (s *wishlistService) newWishlistItem(ctx context.Context, productID, productVariantID string) (*models.WishlistItem, error) {
+	resultPartner, err := s.partnerClient.GetProductInfoCart(ctx, &partner_proto_gen.GetProductInfoCartRequest{
+		Request: []*partner_proto_gen.ProductInfoCart{
+			{
+				ProductId:        productID,
+				ProductVariantId: productVariantID,
+			},
+		},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, productInfo := range resultPartner.ProductInfo {
+		if productInfo.ProductVariantId != productVariantID {
+			continue
+		}
+
+		price, discountPrice, err := parsePartnerPrices(productInfo.Price, productInfo.DiscountPrice)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &models.WishlistItem{
+			ProductID:        productInfo.ProductId,
+			ProductVariantID: productInfo.ProductVariantId,
+			SavedPrice:       wishlistPrice(price, discountPrice),
+			Currency:         productCurrency(productInfo),
+		}, nil
+	}
+
+	return nil, status.Error(codes.NotFound, "Product not found")
+}
+
+// wishlistPrice returns price which customer would pay for one unit, discount price is used when it is lower
+func wishlistPrice(price, discountPrice money.Money) money.Money {
+	if discountPrice.IsPositive() && discountPrice.Cmp(price) < 0 {
+		return discountPrice
+	}
+
+	return price
+}
+
+// productCurrency returns currency of product prices, prices of products which have no currency are in base currency
+func productCurrency(productInfo *partner_proto_gen.ProductInfoCartResponse) string {
+	if productInfo.Currency == "" {
+		return common.DefaultCurrency
+	}
+
+	return productInfo.Currency
+}
@@ -0,0 +1,63 @@
+package tax
+
+import (
+	"fmt"
+	"github.com/TienMinh25/ecommerce-platform/internal/env"
+	"github.com/TienMinh25/ecommerce-platform/internal/money"
+)
+
+type taxCalculator struct {
+	// rates is percent of each tax class with 2 decimal places
+	rates            map[string]money.Money
+	defaultClass     string
+	pricesIncludeTax bool
+}
+
+func NewTaxCalculator(envManager *env.EnvManager) (ITaxCalculator, error) {
+	config := envManager.TaxConfig
+	rates := make(map[string]money.Money, len(config.TaxRates))
+
+	for taxClass, rate := range config.TaxRates {
+		if rate < 0 || rate > 100 {
+			return nil, fmt.Errorf("rate %v of tax class %s must be between 0 and 100", rate, taxClass)
+		}
+
+		rates[taxClass] = money.FromFloat(rate)
+	}
+
+	if _, ok := rates[config.TaxDefaultClass]; !ok {
+		return nil, fmt.Errorf("default tax class %s has no rate", config.TaxDefaultClass)
+	}
+
+	return &taxCalculator{
+		rates:            rates,
+		defaultClass:     config.TaxDefaultClass,
+		pricesIncludeTax: config.TaxPricesIncludeTax,
+	}, nil
+}
+
+func (c *taxCalculator) CalculateTax(taxClass string, amount money.Money) money.Money {
+	if !amount.IsPositive() {
+		return money.Zero
+	}
+
+	rate, ok := c.rates[taxClass]
+
+	// products which have tax class out of config are taxed by default class
+	if !ok {
+		rate = c.rates[c.defaultClass]
+	}
+
+	if c.pricesIncludeTax {
+		// amount = net + net * rate / 100, so tax is the part of amount above net
+		hundredPercent := money.FromUnits(100)
+
+		return amount.MulRatio(rate.Minor(), hundredPercent.Add(rate).Minor())
+	}
+
+	return amount.Percent(rate)
+}
+
+func (c *taxCalculator) PricesIncludeTax() bool {
+	return c.pricesIncludeTax
+}
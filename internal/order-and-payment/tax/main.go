@@ -0,0 +1,10 @@
+package tax
+
+import "github.com/TienMinh25/ecommerce-platform/internal/money"
+
+type ITaxCalculator interface {
+	// CalculateTax returns tax of amount which buyer pays for product of tax class, rounded to minor unit
+	CalculateTax(taxClass string, amount money.Money) money.Money
+	// PricesIncludeTax is true when prices of products already contain tax, so tax is not added into total of order
+	PricesIncludeTax() bool
+}
@@ -34,6 +34,11 @@ const (
 	PartnerService_UpdateDocumentSupplier_FullMethodName                    = "/PartnerService/UpdateDocumentSupplier"
 	PartnerService_GetSupplierID_FullMethodName                             = "/PartnerService/GetSupplierID"
 	PartnerService_UpdateQuantityProductVariantWhenConfirmed_FullMethodName = "/PartnerService/UpdateQuantityProductVariantWhenConfirmed"
+	PartnerService_UpdateQuantityProductVariantWhenCancelled_FullMethodName = "/PartnerService/UpdateQuantityProductVariantWhenCancelled"
+	PartnerService_ReserveInventory_FullMethodName                          = "/PartnerService/ReserveInventory"
+	PartnerService_CommitReservation_FullMethodName                         = "/PartnerService/CommitReservation"
+	PartnerService_ReleaseReservation_FullMethodName                        = "/PartnerService/ReleaseReservation"
+	PartnerService_ExtendReservation_FullMethodName                         = "/PartnerService/ExtendReservation"
 )
 
 // PartnerServiceClient is the client API for PartnerService service.
@@ -55,6 +60,11 @@ type PartnerServiceClient interface {
 	UpdateDocumentSupplier(ctx context.Context, in *UpdateDocumentSupplierRequest, opts ...grpc.CallOption) (*UpdateDocumentSupplierResponse, error)
 	GetSupplierID(ctx context.Context, in *GetSupplierIDRequest, opts ...grpc.CallOption) (*GetSupplierIDResponse, error)
 	UpdateQuantityProductVariantWhenConfirmed(ctx context.Context, in *UpdateQuantityProductVariantWhenConfirmedRequest, opts ...grpc.CallOption) (*UpdateQuantityProductVariantWhenConfirmedResponse, error)
+	UpdateQuantityProductVariantWhenCancelled(ctx context.Context, in *UpdateQuantityProductVariantWhenCancelledRequest, opts ...grpc.CallOption) (*UpdateQuantityProductVariantWhenCancelledResponse, error)
+	ReserveInventory(ctx context.Context, in *ReserveInventoryRequest, opts ...grpc.CallOption) (*ReserveInventoryResponse, error)
+	CommitReservation(ctx context.Context, in *CommitReservationRequest, opts ...grpc.CallOption) (*CommitReservationResponse, error)
+	ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*ReleaseReservationResponse, error)
+	ExtendReservation(ctx context.Context, in *ExtendReservationRequest, opts ...grpc.CallOption) (*ExtendReservationResponse, error)
 }
 
 type partnerServiceClient struct {
@@ -215,6 +225,56 @@ func (c *partnerServiceClient) UpdateQuantityProductVariantWhenConfirmed(ctx con
 	return out, nil
 }
 
+func (c *partnerServiceClient) UpdateQuantityProductVariantWhenCancelled(ctx context.Context, in *UpdateQuantityProductVariantWhenCancelledRequest, opts ...grpc.CallOption) (*UpdateQuantityProductVariantWhenCancelledResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateQuantityProductVariantWhenCancelledResponse)
+	err := c.cc.Invoke(ctx, PartnerService_UpdateQuantityProductVariantWhenCancelled_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partnerServiceClient) ReserveInventory(ctx context.Context, in *ReserveInventoryRequest, opts ...grpc.CallOption) (*ReserveInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveInventoryResponse)
+	err := c.cc.Invoke(ctx, PartnerService_ReserveInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partnerServiceClient) CommitReservation(ctx context.Context, in *CommitReservationRequest, opts ...grpc.CallOption) (*CommitReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitReservationResponse)
+	err := c.cc.Invoke(ctx, PartnerService_CommitReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partnerServiceClient) ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*ReleaseReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseReservationResponse)
+	err := c.cc.Invoke(ctx, PartnerService_ReleaseReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *partnerServiceClient) ExtendReservation(ctx context.Context, in *ExtendReservationRequest, opts ...grpc.CallOption) (*ExtendReservationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendReservationResponse)
+	err := c.cc.Invoke(ctx, PartnerService_ExtendReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PartnerServiceServer is the server API for PartnerService service.
 // All implementations must embed UnimplementedPartnerServiceServer
 // for forward compatibility.
@@ -234,6 +294,11 @@ type PartnerServiceServer interface {
 	UpdateDocumentSupplier(context.Context, *UpdateDocumentSupplierRequest) (*UpdateDocumentSupplierResponse, error)
 	GetSupplierID(context.Context, *GetSupplierIDRequest) (*GetSupplierIDResponse, error)
 	UpdateQuantityProductVariantWhenConfirmed(context.Context, *UpdateQuantityProductVariantWhenConfirmedRequest) (*UpdateQuantityProductVariantWhenConfirmedResponse, error)
+	UpdateQuantityProductVariantWhenCancelled(context.Context, *UpdateQuantityProductVariantWhenCancelledRequest) (*UpdateQuantityProductVariantWhenCancelledResponse, error)
+	ReserveInventory(context.Context, *ReserveInventoryRequest) (*ReserveInventoryResponse, error)
+	CommitReservation(context.Context, *CommitReservationRequest) (*CommitReservationResponse, error)
+	ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error)
+	ExtendReservation(context.Context, *ExtendReservationRequest) (*ExtendReservationResponse, error)
 	mustEmbedUnimplementedPartnerServiceServer()
 }
 
@@ -289,6 +354,21 @@ func (UnimplementedPartnerServiceServer) GetSupplierID(context.Context, *GetSupp
 func (UnimplementedPartnerServiceServer) UpdateQuantityProductVariantWhenConfirmed(context.Context, *UpdateQuantityProductVariantWhenConfirmedRequest) (*UpdateQuantityProductVariantWhenConfirmedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuantityProductVariantWhenConfirmed not implemented")
 }
+func (UnimplementedPartnerServiceServer) UpdateQuantityProductVariantWhenCancelled(context.Context, *UpdateQuantityProductVariantWhenCancelledRequest) (*UpdateQuantityProductVariantWhenCancelledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuantityProductVariantWhenCancelled not implemented")
+}
+func (UnimplementedPartnerServiceServer) ReserveInventory(context.Context, *ReserveInventoryRequest) (*ReserveInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveInventory not implemented")
+}
+func (UnimplementedPartnerServiceServer) CommitReservation(context.Context, *CommitReservationRequest) (*CommitReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitReservation not implemented")
+}
+func (UnimplementedPartnerServiceServer) ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReservation not implemented")
+}
+func (UnimplementedPartnerServiceServer) ExtendReservation(context.Context, *ExtendReservationRequest) (*ExtendReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendReservation not implemented")
+}
 func (UnimplementedPartnerServiceServer) mustEmbedUnimplementedPartnerServiceServer() {}
 func (UnimplementedPartnerServiceServer) testEmbeddedByValue()                        {}
 
@@ -580,6 +660,96 @@ func _PartnerService_UpdateQuantityProductVariantWhenConfirmed_Handler(srv inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PartnerService_UpdateQuantityProductVariantWhenCancelled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateQuantityProductVariantWhenCancelledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartnerServiceServer).UpdateQuantityProductVariantWhenCancelled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartnerService_UpdateQuantityProductVariantWhenCancelled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartnerServiceServer).UpdateQuantityProductVariantWhenCancelled(ctx, req.(*UpdateQuantityProductVariantWhenCancelledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartnerService_ReserveInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartnerServiceServer).ReserveInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartnerService_ReserveInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartnerServiceServer).ReserveInventory(ctx, req.(*ReserveInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartnerService_CommitReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartnerServiceServer).CommitReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartnerService_CommitReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartnerServiceServer).CommitReservation(ctx, req.(*CommitReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartnerService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartnerServiceServer).ReleaseReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartnerService_ReleaseReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartnerServiceServer).ReleaseReservation(ctx, req.(*ReleaseReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PartnerService_ExtendReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PartnerServiceServer).ExtendReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PartnerService_ExtendReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PartnerServiceServer).ExtendReservation(ctx, req.(*ExtendReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PartnerService_ServiceDesc is the grpc.ServiceDesc for PartnerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -647,6 +817,26 @@ var PartnerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateQuantityProductVariantWhenConfirmed",
 			Handler:    _PartnerService_UpdateQuantityProductVariantWhenConfirmed_Handler,
 		},
+		{
+			MethodName: "UpdateQuantityProductVariantWhenCancelled",
+			Handler:    _PartnerService_UpdateQuantityProductVariantWhenCancelled_Handler,
+		},
+		{
+			MethodName: "ReserveInventory",
+			Handler:    _PartnerService_ReserveInventory_Handler,
+		},
+		{
+			MethodName: "CommitReservation",
+			Handler:    _PartnerService_CommitReservation_Handler,
+		},
+		{
+			MethodName: "ReleaseReservation",
+			Handler:    _PartnerService_ReleaseReservation_Handler,
+		},
+		{
+			MethodName: "ExtendReservation",
+			Handler:    _PartnerService_ExtendReservation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "main.proto",
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (p *PartnerHandler) ReserveInventory(ctx context.Context, data *partner_proto_gen.ReserveInventoryRequest) (*partner_proto_gen.ReserveInventoryResponse, error) {
+	ctx, span := p.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "ReserveInventory"))
+	defer span.End()
+
+	if len(data.Items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "No items provided")
+	}
+
+	for _, item := range data.Items {
+		if item.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Quantity of product %s must be greater than 0", item.ProductVariantId)
+		}
+	}
+
+	res, err := p.inventoryService.ReserveInventory(ctx, data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (p *PartnerHandler) CommitReservation(ctx context.Context, data *partner_proto_gen.CommitReservationRequest) (*partner_proto_gen.CommitReservationResponse, error) {
+	ctx, span := p.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "CommitReservation"))
+	defer span.End()
+
+	if err := p.inventoryService.CommitReservation(ctx, data); err != nil {
+		return nil, err
+	}
+
+	return &partner_proto_gen.CommitReservationResponse{}, nil
+}
+
+func (p *PartnerHandler) ReleaseReservation(ctx context.Context, data *partner_proto_gen.ReleaseReservationRequest) (*partner_proto_gen.ReleaseReservationResponse, error) {
+	ctx, span := p.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "ReleaseReservation"))
+	defer span.End()
+
+	if len(data.ReservationIds) == 0 && data.SagaId == nil {
+		return &partner_proto_gen.ReleaseReservationResponse{}, nil
+	}
+
+	if err := p.inventoryService.ReleaseReservation(ctx, data); err != nil {
+		return nil, err
+	}
+
+	return &partner_proto_gen.ReleaseReservationResponse{}, nil
+}
+
+func (p *PartnerHandler) ExtendReservation(ctx context.Context, data *partner_proto_gen.ExtendReservationRequest) (*partner_proto_gen.ExtendReservationResponse, error) {
+	ctx, span := p.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "ExtendReservation"))
+	defer span.End()
+
+	if len(data.ReservationIds) == 0 {
+		return &partner_proto_gen.ExtendReservationResponse{}, nil
+	}
+
+	if err := p.inventoryService.ExtendReservation(ctx, data); err != nil {
+		return nil, err
+	}
+
+	return &partner_proto_gen.ExtendReservationResponse{}, nil
+}
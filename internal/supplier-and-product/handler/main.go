@@ -8,18 +8,20 @@ import (
 
 type PartnerHandler struct {
 	partner_proto_gen.UnimplementedPartnerServiceServer
-	tracer          pkg.Tracer
-	cateService     service.ICategoryService
-	productService  service.IProductService
-	supplierService service.ISupplierService
+	tracer           pkg.Tracer
+	cateService      service.ICategoryService
+	productService   service.IProductService
+	supplierService  service.ISupplierService
+	inventoryService service.IInventoryService
 }
 
 func NewPartnerHandler(tracer pkg.Tracer, cateService service.ICategoryService, productService service.IProductService,
-	supplierService service.ISupplierService) *PartnerHandler {
+	supplierService service.ISupplierService, inventoryService service.IInventoryService) *PartnerHandler {
 	return &PartnerHandler{
-		tracer:          tracer,
-		cateService:     cateService,
-		productService:  productService,
-		supplierService: supplierService,
+		tracer:           tracer,
+		cateService:      cateService,
+		productService:   productService,
+		supplierService:  supplierService,
+		inventoryService: inventoryService,
 	}
 }
@@ -90,3 +90,14 @@ func (p *PartnerHandler) UpdateQuantityProductVariantWhenConfirmed(ctx context.C
 
 	return &partner_proto_gen.UpdateQuantityProductVariantWhenConfirmedResponse{}, nil
 }
+
+func (p *PartnerHandler) UpdateQuantityProductVariantWhenCancelled(ctx context.Context, data *partner_proto_gen.UpdateQuantityProductVariantWhenCancelledRequest) (*partner_proto_gen.UpdateQuantityProductVariantWhenCancelledResponse, error) {
+	ctx, span := p.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.HandlerLayer, "UpdateQuantityProductVariantWhenCancelled"))
+	defer span.End()
+
+	if err := p.supplierService.UpdateQuantityProductVariantWhenCancelled(ctx, data); err != nil {
+		return nil, err
+	}
+
+	return &partner_proto_gen.UpdateQuantityProductVariantWhenCancelledResponse{}, nil
+}
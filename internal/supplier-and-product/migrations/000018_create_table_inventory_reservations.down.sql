@@ -0,0 +1,27 @@
+delete from inventory_transactions where transaction_type in ('reserve', 'release');
+
+alter table inventory_transactions
+drop constraint check_transaction_type_inventory_transactions;
+
+alter table inventory_transactions
+add constraint check_transaction_type_inventory_transactions
+check (transaction_type in ('purchase', 'sale', 'return', 'adjustment', 'inventory_count'));
+
+alter table inventory_transactions
+drop constraint fk_reservation_id_inventory_transactions;
+
+alter table inventory_transactions
+    drop column reservation_id;
+
+update inventory_transactions set performed_by = 0 where performed_by is null;
+
+alter table inventory_transactions
+    alter column performed_by set not null;
+
+drop table if exists inventory_reservations;
+
+alter table product_variants
+drop constraint check_reserved_quantity_product_variants;
+
+alter table product_variants
+    drop column reserved_quantity;
@@ -0,0 +1,57 @@
+-- quantity is held for orders which are not confirmed by supplier yet
+alter table product_variants
+    add column reserved_quantity int not null default 0;
+
+alter table product_variants
+add constraint check_reserved_quantity_product_variants
+check (reserved_quantity >= 0 and reserved_quantity <= inventory_quantity);
+
+create table if not exists inventory_reservations (
+    id uuid primary key default gen_random_uuid(),
+    product_variant_id uuid not null,
+    quantity int not null,
+    status varchar(50) not null default 'reserved',
+    expires_at timestamptz, -- null means hold until order item is confirmed or cancelled
+    created_at timestamptz default current_timestamp,
+    updated_at timestamptz default current_timestamp
+);
+
+alter table inventory_reservations
+add constraint fk_product_variant_id_inventory_reservations
+    foreign key (product_variant_id) references
+    product_variants (id) on delete cascade;
+
+alter table inventory_reservations
+add constraint check_quantity_inventory_reservations
+check (quantity > 0);
+
+alter table inventory_reservations
+add constraint check_status_inventory_reservations
+check (status in ('reserved', 'committed', 'released', 'expired'));
+
+create index idx_status_expires_at_inventory_reservations
+on inventory_reservations(status, expires_at);
+
+create trigger set_timestamp_inventory_reservations
+    before update on inventory_reservations
+    for each row
+    execute function update_modified_column();
+
+-- reservations are changed by system, so performed_by can be empty
+alter table inventory_transactions
+    alter column performed_by drop not null;
+
+alter table inventory_transactions
+    add column reservation_id uuid;
+
+alter table inventory_transactions
+add constraint fk_reservation_id_inventory_transactions
+    foreign key (reservation_id) references
+    inventory_reservations (id) on delete set null;
+
+alter table inventory_transactions
+drop constraint check_transaction_type_inventory_transactions;
+
+alter table inventory_transactions
+add constraint check_transaction_type_inventory_transactions
+check (transaction_type in ('purchase', 'sale', 'return', 'adjustment', 'inventory_count', 'reserve', 'release'));
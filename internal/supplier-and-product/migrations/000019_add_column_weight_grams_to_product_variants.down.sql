@@ -0,0 +1,5 @@
+alter table product_variants
+drop constraint if exists check_weight_grams_product_variants;
+
+alter table product_variants
+drop column weight_grams;
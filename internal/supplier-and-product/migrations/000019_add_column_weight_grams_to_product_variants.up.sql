@@ -0,0 +1,7 @@
+-- weight of one unit, shipping fee of order is calculated from total weight of package of each supplier
+alter table product_variants
+add column weight_grams int not null default 0;
+
+alter table product_variants
+add constraint check_weight_grams_product_variants
+check (weight_grams >= 0);
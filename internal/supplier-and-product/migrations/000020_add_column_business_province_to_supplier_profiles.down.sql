@@ -0,0 +1,3 @@
+alter table supplier_profiles
+drop column business_district,
+drop column business_province;
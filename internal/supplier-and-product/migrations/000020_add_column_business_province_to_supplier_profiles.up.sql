@@ -0,0 +1,5 @@
+-- business address lives in api gateway, so its province and district are copied here
+-- to find shipping zone between supplier and buyer. They are null for suppliers registered before
+alter table supplier_profiles
+add column business_province varchar(255),
+add column business_district varchar(255);
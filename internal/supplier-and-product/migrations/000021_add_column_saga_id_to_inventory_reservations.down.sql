@@ -0,0 +1,4 @@
+drop index if exists idx_saga_id_inventory_reservations;
+
+alter table inventory_reservations
+drop column saga_id;
@@ -0,0 +1,7 @@
+-- saga of order service which holds inventory, reserving is retried with the same saga id, so it holds
+-- inventory only once, and compensation can release holds which were never returned to saga
+alter table inventory_reservations
+add column saga_id uuid;
+
+create index idx_saga_id_inventory_reservations
+on inventory_reservations(saga_id);
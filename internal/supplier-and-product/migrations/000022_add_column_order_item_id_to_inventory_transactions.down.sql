@@ -0,0 +1,4 @@
+drop index if exists idx_order_item_id_inventory_transactions;
+
+alter table inventory_transactions
+drop column order_item_id;
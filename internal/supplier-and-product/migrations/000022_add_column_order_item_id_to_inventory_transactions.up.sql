@@ -0,0 +1,8 @@
+-- order item of order service whose stock was changed without reservation, stock change is retried with
+-- the same order item, so it is done only once
+alter table inventory_transactions
+add column order_item_id uuid;
+
+create unique index idx_order_item_id_inventory_transactions
+on inventory_transactions(order_item_id, transaction_type)
+where order_item_id is not null;
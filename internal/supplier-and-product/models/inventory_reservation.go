@@ -0,0 +1,17 @@
+package models
+
+import (
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"time"
+)
+
+type InventoryReservation struct {
+	ID               string
+	ProductVariantID string
+	SagaID           *string
+	Quantity         int64
+	Status           common.ReservationStatus
+	ExpiresAt        *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
@@ -0,0 +1,436 @@
+package repository
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
+	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/models"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"slices"
+)
+
+type inventoryRepository struct {
+	tracer pkg.Tracer
+	db     pkg.Database
+}
+
+func NewInventoryRepository(tracer pkg.Tracer, db pkg.Database) IInventoryRepository {
+	return &inventoryRepository{
+		tracer: tracer,
+		db:     db,
+	}
+}
+
+// ReserveInventory holds inventory of items, reserving again with the same saga id returns reservations of
+// the first call, so saga can retry it after timeout
+func (r *inventoryRepository) ReserveInventory(ctx context.Context, sagaID *string, items []*partner_proto_gen.ReserveInventoryItem,
+	ttlSeconds, performedBy int64) ([]models.InventoryReservation, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "ReserveInventory"))
+	defer span.End()
+
+	// always lock variants in the same order to avoid deadlock between checkouts
+	sortedItems := slices.Clone(items)
+	slices.SortFunc(sortedItems, func(a, b *partner_proto_gen.ReserveInventoryItem) int {
+		if a.ProductVariantId < b.ProductVariantId {
+			return -1
+		}
+
+		if a.ProductVariantId > b.ProductVariantId {
+			return 1
+		}
+
+		return 0
+	})
+
+	reservations := make([]models.InventoryReservation, 0, len(items))
+
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		if sagaID != nil {
+			// retries of the same saga wait here until first call is finished
+			if err := tx.Exec(ctx, `select pg_advisory_xact_lock(hashtext($1))`, *sagaID); err != nil {
+				span.RecordError(err)
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			existing, err := getSagaReservations(ctx, tx, *sagaID)
+
+			if err != nil {
+				span.RecordError(err)
+				return err
+			}
+
+			if len(existing) > 0 {
+				reservations = existing
+				return nil
+			}
+		}
+
+		for _, item := range sortedItems {
+			// only hold what is not held by other orders
+			updateSql := `update product_variants set reserved_quantity = reserved_quantity + $1
+					where id = $2 and is_active = true and inventory_quantity - reserved_quantity >= $1
+					returning inventory_quantity - reserved_quantity`
+
+			var availableQuantity int64
+
+			if err := tx.QueryRow(ctx, updateSql, item.Quantity, item.ProductVariantId).Scan(&availableQuantity); err != nil {
+				span.RecordError(err)
+
+				if errors.Is(err, pgx.ErrNoRows) {
+					return status.Errorf(codes.FailedPrecondition, "Insufficient inventory: product %s", item.ProductVariantId)
+				}
+
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			insertSql := `insert into inventory_reservations (product_variant_id, saga_id, quantity, expires_at)
+					values ($1, $2, $3, case when $4::int > 0 then current_timestamp + $4::int * interval '1 second' end)
+					returning id, status, expires_at, created_at, updated_at`
+
+			reservation := models.InventoryReservation{
+				ProductVariantID: item.ProductVariantId,
+				SagaID:           sagaID,
+				Quantity:         item.Quantity,
+			}
+
+			if err := tx.QueryRow(ctx, insertSql, item.ProductVariantId, sagaID, item.Quantity, ttlSeconds).Scan(&reservation.ID,
+				&reservation.Status, &reservation.ExpiresAt, &reservation.CreatedAt, &reservation.UpdatedAt); err != nil {
+				span.RecordError(err)
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			if err := insertInventoryTransaction(ctx, tx, item.ProductVariantId, &reservation.ID, nil, -item.Quantity,
+				availableQuantity+item.Quantity, availableQuantity, common.InventoryTransactionReserve, &performedBy); err != nil {
+				span.RecordError(err)
+				return err
+			}
+
+			reservations = append(reservations, reservation)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+func (r *inventoryRepository) CommitReservation(ctx context.Context, reservationID string, performedBy int64) error {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "CommitReservation"))
+	defer span.End()
+
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		reservation, err := lockReservation(ctx, tx, reservationID)
+
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		var updateSql string
+
+		switch reservation.Status {
+		case common.ReservationStatusCommitted:
+			return nil
+		case common.ReservationStatusReserved:
+			updateSql = `update product_variants
+					set inventory_quantity = inventory_quantity - $1, reserved_quantity = reserved_quantity - $1
+					where id = $2 and inventory_quantity >= $1 and reserved_quantity >= $1
+					returning inventory_quantity`
+		case common.ReservationStatusExpired:
+			// hold was given back, so take stock again when it is still available
+			updateSql = `update product_variants set inventory_quantity = inventory_quantity - $1
+					where id = $2 and inventory_quantity - reserved_quantity >= $1
+					returning inventory_quantity`
+		default:
+			return status.Errorf(codes.FailedPrecondition, "Reservation with status %v can not be committed", reservation.Status)
+		}
+
+		var newQuantity int64
+
+		if err = tx.QueryRow(ctx, updateSql, reservation.Quantity, reservation.ProductVariantID).Scan(&newQuantity); err != nil {
+			span.RecordError(err)
+
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Errorf(codes.FailedPrecondition, "Insufficient inventory: product %s", reservation.ProductVariantID)
+			}
+
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if err = updateReservationStatus(ctx, tx, reservationID, common.ReservationStatusCommitted); err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		if err = insertInventoryTransaction(ctx, tx, reservation.ProductVariantID, &reservationID, nil, -reservation.Quantity,
+			newQuantity+reservation.Quantity, newQuantity, common.InventoryTransactionSale, &performedBy); err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+// ReleaseReservations releases reservations by id and all reservations of saga when saga id is given
+func (r *inventoryRepository) ReleaseReservations(ctx context.Context, reservationIDs []string, sagaID *string,
+	performedBy *int64) error {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "ReleaseReservations"))
+	defer span.End()
+
+	return r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		sortedIDs := slices.Clone(reservationIDs)
+
+		if sagaID != nil {
+			// wait for reserving of saga which is still running, so its holds are released too
+			if err := tx.Exec(ctx, `select pg_advisory_xact_lock(hashtext($1))`, *sagaID); err != nil {
+				span.RecordError(err)
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			sagaReservations, err := getSagaReservations(ctx, tx, *sagaID)
+
+			if err != nil {
+				span.RecordError(err)
+				return err
+			}
+
+			for _, reservation := range sagaReservations {
+				sortedIDs = append(sortedIDs, reservation.ID)
+			}
+		}
+
+		slices.Sort(sortedIDs)
+		sortedIDs = slices.Compact(sortedIDs)
+
+		for _, reservationID := range sortedIDs {
+			if err := releaseReservation(ctx, tx, reservationID, common.ReservationStatusReleased, performedBy); err != nil {
+				span.RecordError(err)
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *inventoryRepository) ExtendReservations(ctx context.Context, reservationIDs []string, ttlSeconds int64) error {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "ExtendReservations"))
+	defer span.End()
+
+	// expired reservations are not held again here, commit will take stock of them when it is still available
+	updateSql := `update inventory_reservations
+			set expires_at = case when $1::int > 0 then current_timestamp + $1::int * interval '1 second' end
+			where id = any($2) and status = $3`
+
+	if err := r.db.Exec(ctx, updateSql, ttlSeconds, reservationIDs, common.ReservationStatusReserved); err != nil {
+		span.RecordError(err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func (r *inventoryRepository) ReleaseExpiredReservations(ctx context.Context, limit int64) (int, error) {
+	ctx, span := r.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "ReleaseExpiredReservations"))
+	defer span.End()
+
+	var releasedReservations int
+
+	err := r.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		selectSql := `select id from inventory_reservations
+				where status = $1 and expires_at < current_timestamp
+				order by id
+				limit $2
+				for update skip locked`
+
+		rows, err := tx.Query(ctx, selectSql, common.ReservationStatusReserved, limit)
+
+		if err != nil {
+			span.RecordError(err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		reservationIDs := make([]string, 0)
+
+		for rows.Next() {
+			var reservationID string
+
+			if err = rows.Scan(&reservationID); err != nil {
+				rows.Close()
+				span.RecordError(err)
+				return status.Error(codes.Internal, err.Error())
+			}
+
+			reservationIDs = append(reservationIDs, reservationID)
+		}
+
+		rows.Close()
+
+		for _, reservationID := range reservationIDs {
+			if err = releaseReservation(ctx, tx, reservationID, common.ReservationStatusExpired, nil); err != nil {
+				span.RecordError(err)
+				return err
+			}
+		}
+
+		releasedReservations = len(reservationIDs)
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return releasedReservations, nil
+}
+
+// releaseReservation gives back held quantity, or restocks when reservation was already committed
+func releaseReservation(ctx context.Context, tx pkg.Tx, reservationID string, nextStatus common.ReservationStatus,
+	performedBy *int64) error {
+	reservation, err := lockReservation(ctx, tx, reservationID)
+
+	if err != nil {
+		return err
+	}
+
+	var updateSql string
+	var transactionType common.InventoryTransactionType
+
+	switch reservation.Status {
+	case common.ReservationStatusReserved:
+		updateSql = `update product_variants set reserved_quantity = reserved_quantity - $1
+				where id = $2 and reserved_quantity >= $1
+				returning inventory_quantity - reserved_quantity`
+		transactionType = common.InventoryTransactionRelease
+	case common.ReservationStatusCommitted:
+		updateSql = `update product_variants set inventory_quantity = inventory_quantity + $1
+				where id = $2
+				returning inventory_quantity`
+		transactionType = common.InventoryTransactionReturn
+	default:
+		// stock of released or expired reservation was given back before
+		return nil
+	}
+
+	var newQuantity int64
+
+	if err = tx.QueryRow(ctx, updateSql, reservation.Quantity, reservation.ProductVariantID).Scan(&newQuantity); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return status.Errorf(codes.FailedPrecondition, "Reserved quantity of product %s is not enough to release",
+				reservation.ProductVariantID)
+		}
+
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if err = updateReservationStatus(ctx, tx, reservationID, nextStatus); err != nil {
+		return err
+	}
+
+	return insertInventoryTransaction(ctx, tx, reservation.ProductVariantID, &reservationID, nil, reservation.Quantity,
+		newQuantity-reservation.Quantity, newQuantity, transactionType, performedBy)
+}
+
+// getSagaReservations returns reservations which were made by saga
+func getSagaReservations(ctx context.Context, tx pkg.Tx, sagaID string) ([]models.InventoryReservation, error) {
+	selectSql := `select id, product_variant_id, saga_id, quantity, status, expires_at, created_at, updated_at
+			from inventory_reservations
+			where saga_id = $1
+			order by product_variant_id`
+
+	rows, err := tx.Query(ctx, selectSql, sagaID)
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	defer rows.Close()
+
+	reservations := make([]models.InventoryReservation, 0)
+
+	for rows.Next() {
+		var reservation models.InventoryReservation
+
+		if err = rows.Scan(&reservation.ID, &reservation.ProductVariantID, &reservation.SagaID, &reservation.Quantity,
+			&reservation.Status, &reservation.ExpiresAt, &reservation.CreatedAt, &reservation.UpdatedAt); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		reservations = append(reservations, reservation)
+	}
+
+	return reservations, nil
+}
+
+// isOrderItemStockChanged waits for stock change of order item which is still running and reports whether
+// stock of order item was already changed with transaction type, so retried change is not done twice
+func isOrderItemStockChanged(ctx context.Context, tx pkg.Tx, orderItemID string,
+	transactionType common.InventoryTransactionType) (bool, error) {
+	if err := tx.Exec(ctx, `select pg_advisory_xact_lock(hashtext($1))`, orderItemID); err != nil {
+		return false, status.Error(codes.Internal, err.Error())
+	}
+
+	var isChanged bool
+
+	if err := tx.QueryRow(ctx, `select exists(select 1 from inventory_transactions
+			where order_item_id = $1 and transaction_type = $2)`, orderItemID, transactionType).Scan(&isChanged); err != nil {
+		return false, status.Error(codes.Internal, err.Error())
+	}
+
+	return isChanged, nil
+}
+
+func lockReservation(ctx context.Context, tx pkg.Tx, reservationID string) (*models.InventoryReservation, error) {
+	selectSql := `select id, product_variant_id, quantity, status, expires_at
+			from inventory_reservations
+			where id = $1
+			for update`
+
+	var reservation models.InventoryReservation
+
+	if err := tx.QueryRow(ctx, selectSql, reservationID).Scan(&reservation.ID, &reservation.ProductVariantID,
+		&reservation.Quantity, &reservation.Status, &reservation.ExpiresAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Error(codes.NotFound, "Reservation not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &reservation, nil
+}
+
+func updateReservationStatus(ctx context.Context, tx pkg.Tx, reservationID string, nextStatus common.ReservationStatus) error {
+	if err := tx.Exec(ctx, `update inventory_reservations set status = $1 where id = $2`, nextStatus, reservationID); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+// insertInventoryTransaction saves change of stock, performedBy is nil when stock is changed by system
+func insertInventoryTransaction(ctx context.Context, tx pkg.Tx, productVariantID string, reservationID, orderItemID *string,
+	quantityChange, previousQuantity, newQuantity int64, transactionType common.InventoryTransactionType,
+	performedBy *int64) error {
+	insertSql := `insert into inventory_transactions (product_variant_id, reservation_id, order_item_id, quantity_change,
+			previous_quantity, new_quantity, transaction_type, performed_by)
+			values ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	if err := tx.Exec(ctx, insertSql, productVariantID, reservationID, orderItemID, quantityChange, previousQuantity,
+		newQuantity, transactionType, performedBy); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
@@ -22,6 +22,15 @@ type IProductRepository interface {
 	GetProductInfoForCart(ctx context.Context, prodIds []string, prodVariantIds []string) (map[string]models.Product, []models.ProductVariant, error)
 	GetProdInfoForPayment(ctx context.Context, data *partner_proto_gen.GetProdInfoForPaymentRequest) (*partner_proto_gen.GetProdInfoForPaymentResponse, error)
 	UpdateQuantityProductVariantWhenConfirmed(ctx context.Context, data *partner_proto_gen.UpdateQuantityProductVariantWhenConfirmedRequest) error
+	UpdateQuantityProductVariantWhenCancelled(ctx context.Context, data *partner_proto_gen.UpdateQuantityProductVariantWhenCancelledRequest) error
+}
+
+type IInventoryRepository interface {
+	ReserveInventory(ctx context.Context, sagaID *string, items []*partner_proto_gen.ReserveInventoryItem, ttlSeconds, performedBy int64) ([]models.InventoryReservation, error)
+	CommitReservation(ctx context.Context, reservationID string, performedBy int64) error
+	ReleaseReservations(ctx context.Context, reservationIDs []string, sagaID *string, performedBy *int64) error
+	ExtendReservations(ctx context.Context, reservationIDs []string, ttlSeconds int64) error
+	ReleaseExpiredReservations(ctx context.Context, limit int64) (int, error)
 }
 
 type ISupplierProfileRepository interface {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/Masterminds/squirrel"
+	"github.com/TienMinh25/ecommerce-platform/internal/common"
 	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
 	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/models"
 	"github.com/TienMinh25/ecommerce-platform/pkg"
@@ -60,22 +61,36 @@ func (p *productRepository) GetProducts(ctx context.Context, data *partner_proto
 }
 
 func (p *productRepository) getProductsByConditions(ctx context.Context, data *partner_proto_gen.GetProductsRequest) ([]models.Product, error) {
+	// prices of variants are converted into base currency to find the lowest one,
+	// then it is converted into currency of request
 	query := `
-	WITH pvprice as (
-		SELECT MIN(price) as price, MIN(discount_price) as discount_price, product_id, currency
-		from product_variants
-		group by product_id, currency	
+	WITH rates as (
+		SELECT currency, rate::numeric as rate
+		from unnest($1::text[], $2::text[]) as r(currency, rate)
+	), pvprice as (
+		SELECT MIN(pv.price * r.rate) as price, MIN(pv.discount_price * r.rate) as discount_price, pv.product_id
+		from product_variants pv
+		inner join rates r on r.currency = pv.currency
+		group by pv.product_id
 	)
 	SELECT p.id, p.name, p.image_url, p.average_rating, p.total_reviews, p.category_id,
-	pv.price, COALESCE(pv.discount_price, 0), pv.currency
+	round(pv.price / $3::numeric, 2), COALESCE(round(pv.discount_price / $3::numeric, 2), 0)
 	FROM products p
 	INNER JOIN pvprice pv
 	ON pv.product_id = p.id
 	WHERE p.status = 'active'
 	`
 
-	args := []interface{}{}
-	argIndex := 1
+	currencies := make([]string, 0, len(data.ExchangeRates))
+	rates := make([]string, 0, len(data.ExchangeRates))
+
+	for currency, rate := range data.ExchangeRates {
+		currencies = append(currencies, currency)
+		rates = append(rates, rate)
+	}
+
+	args := []interface{}{currencies, rates, data.ExchangeRates[data.Currency]}
+	argIndex := 4
 
 	// add condition if having keyword for search
 	if data.Keyword != nil {
@@ -126,11 +141,11 @@ func (p *productRepository) getProductsByConditions(ctx context.Context, data *p
 		prodVariant := new(models.ProductVariant)
 
 		if err = rows.Scan(&product.ID, &product.Name, &product.ImageURL, &product.AverageRating,
-			&product.TotalReviews, &product.CategoryID, &prodVariant.Price, &prodVariant.DiscountPrice,
-			&prodVariant.Currency); err != nil {
+			&product.TotalReviews, &product.CategoryID, &prodVariant.Price, &prodVariant.DiscountPrice); err != nil {
 			return nil, status.Errorf(codes.Internal, "error scanning products: %s", err.Error())
 		}
 
+		prodVariant.Currency = data.Currency
 		product.ProductVariant = []*models.ProductVariant{prodVariant}
 		products = append(products, product)
 	}
@@ -322,7 +337,7 @@ func (p *productRepository) GetVariantsByProductID(ctx context.Context, productI
 	// Một query duy nhất lấy tất cả thông tin cần thiết
 	queryBuilder := squirrel.Select(
 		"pv.id", "pv.sku", "pv.variant_name", "pv.price", "coalesce(pv.discount_price, 0)",
-		"pv.inventory_quantity", "pv.is_default", "pv.shipping_class",
+		"pv.inventory_quantity - pv.reserved_quantity", "pv.is_default", "pv.shipping_class",
 		"pv.image_url", "pv.alt_text", "pv.currency",
 		"ad.name AS attribute_name", "ao.option_value AS attribute_value",
 	).
@@ -473,7 +488,7 @@ func (p *productRepository) CheckAvailableProd(ctx context.Context, prodVariantI
 	ctx, span := p.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "CheckAvailableProd"))
 	defer span.End()
 
-	sqlSelect, args, err := squirrel.Select("inventory_quantity").From("product_variants").
+	sqlSelect, args, err := squirrel.Select("inventory_quantity - reserved_quantity").From("product_variants").
 		Where(squirrel.Eq{"id": prodVariantID}).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
@@ -603,10 +618,12 @@ func (p *productRepository) GetProdInfoForPayment(ctx context.Context, data *par
 		requestMap[item.ProductVariantId] = item.Quantity
 	}
 
-	querySelect, args, err := squirrel.Select("pv.id", "pv.price", "coalesce(pv.discount_price, 0)",
-		"pv.inventory_quantity", "p.tax_class", "p.supplier_id").
+	querySelect, args, err := squirrel.Select("pv.id", "pv.price::text", "coalesce(pv.discount_price, 0)::text",
+		"pv.inventory_quantity - pv.reserved_quantity", "p.tax_class", "p.supplier_id", "p.category_id",
+		"pv.shipping_class", "pv.weight_grams", "sp.business_province", "sp.business_district", "pv.currency", "p.id").
 		From("product_variants pv").
 		InnerJoin("products p on p.id = pv.product_id").
+		InnerJoin("supplier_profiles sp on sp.id = p.supplier_id").
 		Where(squirrel.Eq{"pv.id": variantIDs}).
 		Where(squirrel.Eq{"pv.is_active": true}).
 		PlaceholderFormat(squirrel.Dollar).ToSql()
@@ -630,20 +647,28 @@ func (p *productRepository) GetProdInfoForPayment(ctx context.Context, data *par
 
 	for rows.Next() {
 		var (
-			variantID     string
-			originalPrice float64
-			discountPrice float64
-			inventory     int64
-			taxClass      string
-			supplierID    int64
+			variantID        string
+			originalPrice    string
+			discountPrice    string
+			inventory        int64
+			taxClass         string
+			supplierID       int64
+			categoryID       int64
+			shippingClass    string
+			weightGrams      int64
+			supplierProvince *string
+			supplierDistrict *string
+			currency         string
+			productID        string
 		)
 
-		if err = rows.Scan(&variantID, &originalPrice, &discountPrice, &inventory, &taxClass, &supplierID); err != nil {
+		if err = rows.Scan(&variantID, &originalPrice, &discountPrice, &inventory, &taxClass, &supplierID, &categoryID,
+			&shippingClass, &weightGrams, &supplierProvince, &supplierDistrict, &currency, &productID); err != nil {
 			span.RecordError(err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 
-		if inventory < requestMap[variantID] {
+		if inventory < requestMap[variantID] && !data.IncludeUnavailable {
 			unavailableItems = append(unavailableItems,
 				fmt.Sprintf("Product %s: requested %d, available %d", variantID, requestMap[variantID], inventory))
 			continue
@@ -655,6 +680,14 @@ func (p *productRepository) GetProdInfoForPayment(ctx context.Context, data *par
 			DiscountUnitPrice: discountPrice,
 			TaxClass:          taxClass,
 			SupplierId:        supplierID,
+			CategoryId:        categoryID,
+			ShippingClass:     shippingClass,
+			WeightGrams:       weightGrams,
+			SupplierProvince:  supplierProvince,
+			SupplierDistrict:  supplierDistrict,
+			AvailableQuantity: inventory,
+			Currency:          currency,
+			ProductId:         productID,
 		})
 	}
 
@@ -663,7 +696,7 @@ func (p *productRepository) GetProdInfoForPayment(ctx context.Context, data *par
 			"Insufficient inventory: %s", strings.Join(unavailableItems, "; "))
 	}
 
-	if len(result.Items) != len(data.Items) {
+	if len(result.Items) != len(data.Items) && !data.IncludeUnavailable {
 		return nil, status.Error(codes.FailedPrecondition, "Items is not active, please try again!")
 	}
 
@@ -674,19 +707,86 @@ func (p *productRepository) UpdateQuantityProductVariantWhenConfirmed(ctx contex
 	ctx, span := p.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "UpdateQuantityProductVariantWhenConfirmed"))
 	defer span.End()
 
-	selectSql := `select inventory_quantity from product_variants where id = $1`
-	var oldQuantity int64
-	if err := p.db.QueryRow(ctx, selectSql, data.ProductVariantId).Scan(&oldQuantity); err != nil {
-		span.RecordError(err)
-		return status.Error(codes.Internal, err.Error())
-	}
+	// used for order items which were created before reservation, so quantity held by other orders is not taken
+	return p.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		if data.OrderItemId != nil {
+			isChanged, err := isOrderItemStockChanged(ctx, tx, *data.OrderItemId, common.InventoryTransactionSale)
 
-	updateSql := `update product_variants set inventory_quantity = $1 where id = $2`
+			if err != nil {
+				span.RecordError(err)
+				return err
+			}
 
-	if err := p.db.Exec(ctx, updateSql, oldQuantity-data.Quantity, data.ProductVariantId); err != nil {
-		span.RecordError(err)
-		return status.Error(codes.Internal, err.Error())
-	}
+			if isChanged {
+				return nil
+			}
+		}
+
+		updateSql := `update product_variants set inventory_quantity = inventory_quantity - $1
+				where id = $2 and inventory_quantity - reserved_quantity >= $1
+				returning inventory_quantity`
+
+		var newQuantity int64
+
+		if err := tx.QueryRow(ctx, updateSql, data.Quantity, data.ProductVariantId).Scan(&newQuantity); err != nil {
+			span.RecordError(err)
+
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Errorf(codes.FailedPrecondition, "Insufficient inventory: product %s", data.ProductVariantId)
+			}
+
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if err := insertInventoryTransaction(ctx, tx, data.ProductVariantId, nil, data.OrderItemId, -data.Quantity,
+			newQuantity+data.Quantity, newQuantity, common.InventoryTransactionSale, nil); err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+func (p *productRepository) UpdateQuantityProductVariantWhenCancelled(ctx context.Context, data *partner_proto_gen.UpdateQuantityProductVariantWhenCancelledRequest) error {
+	ctx, span := p.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.RepositoryLayer, "UpdateQuantityProductVariantWhenCancelled"))
+	defer span.End()
+
+	return p.db.BeginTxFunc(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted}, func(tx pkg.Tx) error {
+		if data.OrderItemId != nil {
+			isChanged, err := isOrderItemStockChanged(ctx, tx, *data.OrderItemId, common.InventoryTransactionReturn)
+
+			if err != nil {
+				span.RecordError(err)
+				return err
+			}
+
+			if isChanged {
+				return nil
+			}
+		}
+
+		updateSql := `update product_variants set inventory_quantity = inventory_quantity + $1 where id = $2
+				returning inventory_quantity`
+
+		var newQuantity int64
+
+		if err := tx.QueryRow(ctx, updateSql, data.Quantity, data.ProductVariantId).Scan(&newQuantity); err != nil {
+			span.RecordError(err)
+
+			if errors.Is(err, pgx.ErrNoRows) {
+				return status.Error(codes.NotFound, "Product variant not found")
+			}
+
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if err := insertInventoryTransaction(ctx, tx, data.ProductVariantId, nil, data.OrderItemId, data.Quantity,
+			newQuantity-data.Quantity, newQuantity, common.InventoryTransactionReturn, nil); err != nil {
+			span.RecordError(err)
+			return err
+		}
 
-	return nil
+		return nil
+	})
 }
@@ -163,9 +163,10 @@ func (s *supplierProfileRepository) RegisterSupplier(ctx context.Context, data *
 		if supplierID == nil {
 			insertSupplierProfile, args, err := pgBuilder.Insert("supplier_profiles").
 				Columns("user_id", "company_name", "contact_phone", "description",
-					"logo_url", "business_address_id", "tax_id", "status").
+					"logo_url", "business_address_id", "tax_id", "status", "business_province", "business_district").
 				Values(data.UserId, data.CompanyName, data.ContactPhone, data.Description,
-					data.LogoCompanyUrl, data.BusinessAddressId, data.TaxId, common.SupplierProfileStatusPending).
+					data.LogoCompanyUrl, data.BusinessAddressId, data.TaxId, common.SupplierProfileStatusPending,
+					data.BusinessProvince, data.BusinessDistrict).
 				Suffix("returning id").
 				ToSql()
 
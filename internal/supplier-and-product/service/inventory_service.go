@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/grpc/proto/partner_proto_gen"
+	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/repository"
+	"github.com/TienMinh25/ecommerce-platform/pkg"
+	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"log"
+)
+
+type inventoryService struct {
+	tracer        pkg.Tracer
+	inventoryRepo repository.IInventoryRepository
+}
+
+// releaseExpiredReservationsBatchSize limits number of reservations released in one tick
+const releaseExpiredReservationsBatchSize = 200
+
+func NewInventoryService(tracer pkg.Tracer, inventoryRepo repository.IInventoryRepository) IInventoryService {
+	return &inventoryService{
+		tracer:        tracer,
+		inventoryRepo: inventoryRepo,
+	}
+}
+
+func (s *inventoryService) ReserveInventory(ctx context.Context, data *partner_proto_gen.ReserveInventoryRequest) (*partner_proto_gen.ReserveInventoryResponse, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "ReserveInventory"))
+	defer span.End()
+
+	reservations, err := s.inventoryRepo.ReserveInventory(ctx, data.SagaId, data.Items, data.TtlSeconds, data.PerformedBy)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := &partner_proto_gen.ReserveInventoryResponse{
+		Reservations: make([]*partner_proto_gen.InventoryReservationResponse, 0, len(reservations)),
+	}
+
+	for _, reservation := range reservations {
+		result.Reservations = append(result.Reservations, &partner_proto_gen.InventoryReservationResponse{
+			ReservationId:    reservation.ID,
+			ProductVariantId: reservation.ProductVariantID,
+			Quantity:         reservation.Quantity,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *inventoryService) CommitReservation(ctx context.Context, data *partner_proto_gen.CommitReservationRequest) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "CommitReservation"))
+	defer span.End()
+
+	if err := s.inventoryRepo.CommitReservation(ctx, data.ReservationId, data.PerformedBy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *inventoryService) ReleaseReservation(ctx context.Context, data *partner_proto_gen.ReleaseReservationRequest) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "ReleaseReservation"))
+	defer span.End()
+
+	if err := s.inventoryRepo.ReleaseReservations(ctx, data.ReservationIds, data.SagaId, data.PerformedBy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *inventoryService) ExtendReservation(ctx context.Context, data *partner_proto_gen.ExtendReservationRequest) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "ExtendReservation"))
+	defer span.End()
+
+	if err := s.inventoryRepo.ExtendReservations(ctx, data.ReservationIds, data.TtlSeconds); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *inventoryService) ReleaseExpiredReservations(ctx context.Context) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "ReleaseExpiredReservations"))
+	defer span.End()
+
+	for {
+		released, err := s.inventoryRepo.ReleaseExpiredReservations(ctx, releaseExpiredReservationsBatchSize)
+
+		if err != nil {
+			return err
+		}
+
+		if released > 0 {
+			log.Printf("Released %d expired inventory reservations", released)
+		}
+
+		if released < releaseExpiredReservationsBatchSize {
+			return nil
+		}
+	}
+}
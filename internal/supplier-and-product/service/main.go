@@ -18,6 +18,14 @@ type IProductService interface {
 	GetProdInfoForPayment(ctx context.Context, data *partner_proto_gen.GetProdInfoForPaymentRequest) (*partner_proto_gen.GetProdInfoForPaymentResponse, error)
 }
 
+type IInventoryService interface {
+	ReserveInventory(ctx context.Context, data *partner_proto_gen.ReserveInventoryRequest) (*partner_proto_gen.ReserveInventoryResponse, error)
+	CommitReservation(ctx context.Context, data *partner_proto_gen.CommitReservationRequest) error
+	ReleaseReservation(ctx context.Context, data *partner_proto_gen.ReleaseReservationRequest) error
+	ExtendReservation(ctx context.Context, data *partner_proto_gen.ExtendReservationRequest) error
+	ReleaseExpiredReservations(ctx context.Context) error
+}
+
 type ISupplierService interface {
 	GetSupplierInfoForOrders(ctx context.Context, supplierIDs []int64) (*partner_proto_gen.GetSupplierInfoForOrderResponse, error)
 	RegisterSupplier(ctx context.Context, data *partner_proto_gen.RegisterSupplierRequest) error
@@ -27,4 +35,5 @@ type ISupplierService interface {
 	UpdateDocumentSupplier(ctx context.Context, data *partner_proto_gen.UpdateDocumentSupplierRequest) (*partner_proto_gen.UpdateDocumentSupplierResponse, error)
 	GetSupplierID(ctx context.Context, userID int64) (*partner_proto_gen.GetSupplierIDResponse, error)
 	UpdateQuantityProductVariantWhenConfirmed(ctx context.Context, data *partner_proto_gen.UpdateQuantityProductVariantWhenConfirmedRequest) error
+	UpdateQuantityProductVariantWhenCancelled(ctx context.Context, data *partner_proto_gen.UpdateQuantityProductVariantWhenCancelledRequest) error
 }
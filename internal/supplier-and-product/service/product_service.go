@@ -7,8 +7,11 @@ import (
 	"github.com/TienMinh25/ecommerce-platform/internal/supplier-and-product/repository"
 	"github.com/TienMinh25/ecommerce-platform/pkg"
 	"github.com/TienMinh25/ecommerce-platform/third_party/tracing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"math"
+	"strconv"
 	"sync"
 )
 
@@ -33,6 +36,10 @@ func (p *productService) GetProducts(ctx context.Context, data *partner_proto_ge
 	ctx, span := p.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetProducts"))
 	defer span.End()
 
+	if _, ok := data.ExchangeRates[data.Currency]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "Exchange rate of currency %s is required", data.Currency)
+	}
+
 	products, totalItems, err := p.repo.GetProducts(ctx, data)
 
 	if err != nil {
@@ -360,8 +367,8 @@ func (p *productService) GetProductInfoForCart(ctx context.Context, data *partne
 			ProductId:               prodVariant.ProductID,
 			ProductVariantId:        prodVariant.ID,
 			ProductName:             mapProds[prodVariant.ProductID].Name,
-			Price:                   prodVariant.Price,
-			DiscountPrice:           prodVariant.DiscountPrice,
+			Price:                   formatPrice(prodVariant.Price),
+			DiscountPrice:           formatPrice(prodVariant.DiscountPrice),
 			ProductVariantThumbnail: prodVariant.ImageURL,
 			ProductVariantAlt:       prodVariant.ALTText,
 			Currency:                prodVariant.Currency,
@@ -374,6 +381,12 @@ func (p *productService) GetProductInfoForCart(ctx context.Context, data *partne
 	}, nil
 }
 
+// formatPrice writes price of numeric(14,2) column as decimal text, it has at most 14 significant digits
+// so float64 holds it closely enough that rounding to 2 decimal places gives back exact value
+func formatPrice(price float64) string {
+	return strconv.FormatFloat(price, 'f', 2, 64)
+}
+
 func (p *productService) GetProdInfoForPayment(ctx context.Context, data *partner_proto_gen.GetProdInfoForPaymentRequest) (*partner_proto_gen.GetProdInfoForPaymentResponse, error) {
 	ctx, span := p.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "GetProdInfoForPayment"))
 	defer span.End()
@@ -198,3 +198,14 @@ func (s *supplierService) UpdateQuantityProductVariantWhenConfirmed(ctx context.
 
 	return nil
 }
+
+func (s *supplierService) UpdateQuantityProductVariantWhenCancelled(ctx context.Context, data *partner_proto_gen.UpdateQuantityProductVariantWhenCancelledRequest) error {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.ServiceLayer, "UpdateQuantityProductVariantWhenCancelled"))
+	defer span.End()
+
+	if err := s.productRepo.UpdateQuantityProductVariantWhenCancelled(ctx, data); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"crypto/rand"
 	"fmt"
 	"time"
 )
@@ -19,3 +20,27 @@ func GenerateCouponCodeWithMillis() string {
 		now.Nanosecond()/1000000,
 	)
 }
+
+// couponCodeAlphabet has no 0, O, 1, I, so codes are easy to type
+const couponCodeAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+// GenerateRandomCouponCode returns prefix followed by length random characters, e.g. SALE-7KQ2MZ9P
+func GenerateRandomCouponCode(prefix string, length int) (string, error) {
+	randomBytes := make([]byte, length)
+
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+
+	code := make([]byte, length)
+
+	for idx, b := range randomBytes {
+		code[idx] = couponCodeAlphabet[int(b)%len(couponCodeAlphabet)]
+	}
+
+	if prefix == "" {
+		return string(code), nil
+	}
+
+	return fmt.Sprintf("%s-%s", prefix, code), nil
+}
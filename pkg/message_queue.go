@@ -18,6 +18,9 @@ type Subscriber interface {
 type Publisher interface {
 	// Produce Publishes a message to a specified topic, returning an error if the operation fails.
 	Produce(ctx context.Context, topic string, request []byte) error
+
+	// ProduceWithKey Publishes a message with key to a specified topic, messages with same key keep their order.
+	ProduceWithKey(ctx context.Context, topic string, key []byte, request []byte) error
 }
 
 type HandlerFunc func(ctx context.Context, message interface{}) error
@@ -17,6 +17,10 @@ type Storage interface {
 	// GenerateUploadPresignedURL generates a pre-signed URL for uploading to the
 	// specified bucket (or default bucket if empty)
 	GenerateUploadPresignedURL(ctx context.Context, objectName, bucket string) (string, error)
+
+	// GenerateDownloadPresignedURL generates a pre-signed URL for downloading object of private
+	// bucket (or default bucket if empty)
+	GenerateDownloadPresignedURL(ctx context.Context, objectName, bucket string) (string, error)
 }
 
 type UploadInput struct {
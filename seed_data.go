@@ -937,6 +937,7 @@ func seedPaymentMethods(ctx context.Context, db *pgxpool.Pool) {
 	}{
 		{"Thanh toán khi nhận hàng (COD)", "cod"},
 		{"Thanh toán qua MoMo", "momo"},
+		{"Thanh toán qua VNPay", "vnpay"},
 	}
 
 	for _, method := range methods {
@@ -3617,13 +3618,13 @@ func seedOrders(ctx context.Context, db *pgxpool.Pool, userIDs []int64) {
 					var userPaymentMethodID int64
 					err := db.QueryRow(ctx, `
 						INSERT INTO user_payment_methods (
-							user_id, payment_method_id, is_default, card_holder_name, card_number
+							user_id, payment_method_id, is_default, card_holder_name, provider_token, card_last4
 						)
-						VALUES ($1, $2, $3, $4, $5)
+						VALUES ($1, $2, $3, $4, $5, $6)
 						ON CONFLICT (user_id, payment_method_id) 
 						DO UPDATE SET is_default = $3
 						RETURNING id;
-					`, userID, paymentMethodID, true, address.recipientName, "XXXX-XXXX-XXXX-XXXX").Scan(&userPaymentMethodID)
+					`, userID, paymentMethodID, true, address.recipientName, "seed-token", "0000").Scan(&userPaymentMethodID)
 
 					if err != nil {
 						log.Printf("Error inserting user payment method: %v", err)
@@ -147,6 +147,19 @@ func (q *queue) Produce(ctx context.Context, topic string, payload []byte) error
 	ctx, span := q.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.KafkaLayer, "Produce"))
 	defer span.End()
 
+	return q.produce(ctx, topic, nil, payload)
+}
+
+// ProduceWithKey implements pkg.Queue.
+// message with same key goes to same partition, so consumer receives them in order they were produced
+func (q *queue) ProduceWithKey(ctx context.Context, topic string, key []byte, payload []byte) error {
+	ctx, span := q.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.KafkaLayer, "ProduceWithKey"))
+	defer span.End()
+
+	return q.produce(ctx, topic, key, payload)
+}
+
+func (q *queue) produce(ctx context.Context, topic string, key []byte, payload []byte) error {
 	// begin transaction
 	err := q.producer.BeginTransaction()
 
@@ -161,6 +174,7 @@ func (q *queue) Produce(ctx context.Context, topic string, payload []byte) error
 			Topic:     &topic,
 			Partition: kafkaconfluent.PartitionAny,
 		},
+		Key:   key,
 		Value: payload,
 	}
 
@@ -55,7 +55,7 @@ func NewStorage(env *env.EnvManager, tracer pkg.Tracer) (pkg.Storage, error) {
 			return nil, errors.Wrap(err, "minioClient.MakeBucket")
 		}
 
-		log.Println("policy: %v", env.MinioConfig.MinioBucketAvatarsPolicy)
+		log.Printf("policy: %v", env.MinioConfig.MinioBucketAvatarsPolicy)
 		err = minioClient.SetBucketPolicy(ctx, env.MinioConfig.MinioBucketAvatars, env.MinioConfig.MinioBucketAvatarsPolicy)
 
 		if err != nil {
@@ -195,3 +195,22 @@ func (s *storage) GenerateUploadPresignedURL(ctx context.Context, objectName, bu
 
 	return presignedURL.String(), nil
 }
+
+// GenerateDownloadPresignedURL implements pkg.Storage interface with bucket support
+func (s *storage) GenerateDownloadPresignedURL(ctx context.Context, objectName, bucket string) (string, error) {
+	ctx, span := s.tracer.StartFromContext(ctx, tracing.GetSpanName(tracing.InfraLayer, "minio.GenerateDownloadPresignedURL"))
+	defer span.End()
+
+	bucketName := s.getBucketName(bucket)
+
+	presignedURL, err := s.client.PresignedGetObject(ctx, bucketName, objectName, time.Hour, nil)
+
+	if err != nil {
+		return "", utils.TechnicalError{
+			Message: fmt.Sprintf("Failed to generate presigned URL: %v", err),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+
+	return presignedURL.String(), nil
+}